@@ -4,6 +4,9 @@ import (
 	"go.uber.org/zap"
 	"test_task_avito/backend/config"
 	"test_task_avito/backend/internal/app"
+
+	// База часовых поясов встроена в бинарник: в runtime-образе alpine ее нет, а графики пользователей задаются в IANA
+	_ "time/tzdata"
 )
 
 func main() {
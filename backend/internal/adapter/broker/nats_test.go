@@ -0,0 +1,77 @@
+package broker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	natsserver "github.com/nats-io/nats-server/v2/test"
+	"github.com/nats-io/nats.go"
+)
+
+// runServer запускает сервер NATS на свободном порту; сервер останавливается по окончании теста
+func runServer(t *testing.T) *server.Server {
+	t.Helper()
+	opts := natsserver.DefaultTestOptions
+	opts.Port = server.RANDOM_PORT
+	s := natsserver.RunServer(&opts)
+	t.Cleanup(s.Shutdown)
+	return s
+}
+
+func TestNATSBrokerPublish(t *testing.T) {
+	s := runServer(t)
+
+	subscriber, err := nats.Connect(s.ClientURL())
+	if err != nil {
+		t.Fatalf("connect subscriber: %v", err)
+	}
+	defer subscriber.Close()
+	subscription, err := subscriber.SubscribeSync("reviews.backend.>")
+	if err != nil {
+		t.Fatalf("subscribe: %v", err)
+	}
+	if err := subscriber.Flush(); err != nil {
+		t.Fatalf("flush subscription: %v", err)
+	}
+
+	broker, err := NewNATSBroker(s.ClientURL(), "test")
+	if err != nil {
+		t.Fatalf("NewNATSBroker: %v", err)
+	}
+	defer broker.Close()
+
+	if err := broker.Publish(context.Background(), "reviews.backend.pr.created", "event-1", []byte(`{"id":"event-1"}`)); err != nil {
+		t.Fatalf("publish: %v", err)
+	}
+
+	msg, err := subscription.NextMsg(5 * time.Second)
+	if err != nil {
+		t.Fatalf("receive: %v", err)
+	}
+	if msg.Subject != "reviews.backend.pr.created" || string(msg.Data) != `{"id":"event-1"}` {
+		t.Errorf("unexpected message %s %s", msg.Subject, msg.Data)
+	}
+	if id := msg.Header.Get(nats.MsgIdHdr); id != "event-1" {
+		t.Errorf("%s = %q, want event-1", nats.MsgIdHdr, id)
+	}
+}
+
+func TestNATSBrokerPublishWithoutServerFails(t *testing.T) {
+	s := runServer(t)
+
+	broker, err := NewNATSBroker(s.ClientURL(), "test")
+	if err != nil {
+		t.Fatalf("NewNATSBroker: %v", err)
+	}
+	defer broker.Close()
+	s.Shutdown()
+
+	// Без буфера переподключения публикация не теряется молча, а возвращает ошибку для повтора outbox
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := broker.Publish(ctx, "reviews.backend.pr.created", "event-1", []byte(`{}`)); err == nil {
+		t.Fatal("expected an error while the server is down")
+	}
+}
@@ -0,0 +1,80 @@
+package codehost
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"test_task_avito/backend/internal/port"
+	"time"
+)
+
+var _ port.CodeHostClient = (*GitHubClient)(nil)
+
+// defaultTimeout ограничение времени одного запроса к API
+const defaultTimeout = 10 * time.Second
+
+// GitHubClient запрашивает ревью в PR через REST API GitHub
+type GitHubClient struct {
+	baseURL string
+	token   string
+	client  *http.Client
+}
+
+// NewGitHubClient создает новый экземпляр GitHubClient; baseURL — адрес API (GitHub Enterprise или тестовый сервер),
+// token — токен с правом записи в pull request'ы
+func NewGitHubClient(baseURL, token string) *GitHubClient {
+	return &GitHubClient{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		token:   token,
+		client:  &http.Client{Timeout: defaultTimeout},
+	}
+}
+
+func (c *GitHubClient) RequestReviewers(ctx context.Context, repository string, number int, logins []string) error {
+	return c.requestedReviewers(ctx, http.MethodPost, repository, number, logins)
+}
+
+func (c *GitHubClient) RemoveRequestedReviewers(ctx context.Context, repository string, number int, logins []string) error {
+	return c.requestedReviewers(ctx, http.MethodDelete, repository, number, logins)
+}
+
+// requestedReviewers вызывает /repos/{owner}/{repo}/pulls/{number}/requested_reviewers
+func (c *GitHubClient) requestedReviewers(ctx context.Context, method, repository string, number int, logins []string) error {
+	body, err := json.Marshal(map[string][]string{"reviewers": logins})
+	if err != nil {
+		return err
+	}
+
+	url := c.baseURL + "/repos/" + repository + "/pulls/" + strconv.Itoa(number) + "/requested_reviewers"
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var apiErr struct {
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(respBody, &apiErr) == nil && apiErr.Message != "" {
+			return fmt.Errorf("github %s %s: %s: %s", method, url, resp.Status, apiErr.Message)
+		}
+		return fmt.Errorf("github %s %s: %s", method, url, resp.Status)
+	}
+	return nil
+}
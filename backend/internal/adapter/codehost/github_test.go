@@ -0,0 +1,109 @@
+package codehost
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// recordedRequest запрос, принятый тестовым API
+type recordedRequest struct {
+	method string
+	path   string
+	header http.Header
+	body   map[string][]string
+}
+
+// newTestAPI запускает тестовый API GitHub, отвечающий status и body на каждый запрос
+func newTestAPI(t *testing.T, status int, body string) (*httptest.Server, *[]recordedRequest) {
+	t.Helper()
+	var requests []recordedRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := recordedRequest{method: r.Method, path: r.URL.Path, header: r.Header.Clone()}
+		if err := json.NewDecoder(r.Body).Decode(&request.body); err != nil {
+			t.Errorf("decode body: %v", err)
+		}
+		requests = append(requests, request)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestGitHubClientRequestedReviewers(t *testing.T) {
+	tests := []struct {
+		name   string
+		call   func(c *GitHubClient) error
+		method string
+	}{
+		{
+			name: "request",
+			call: func(c *GitHubClient) error {
+				return c.RequestReviewers(context.Background(), "org/repo", 7, []string{"octocat"})
+			},
+			method: http.MethodPost,
+		},
+		{
+			name: "remove",
+			call: func(c *GitHubClient) error {
+				return c.RemoveRequestedReviewers(context.Background(), "org/repo", 7, []string{"octocat"})
+			},
+			method: http.MethodDelete,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, requests := newTestAPI(t, http.StatusOK, `{}`)
+
+			if err := tt.call(NewGitHubClient(server.URL+"/", "token-1")); err != nil {
+				t.Fatalf("call: %v", err)
+			}
+
+			if len(*requests) != 1 {
+				t.Fatalf("expected one request, got %d", len(*requests))
+			}
+			request := (*requests)[0]
+			if request.method != tt.method || request.path != "/repos/org/repo/pulls/7/requested_reviewers" {
+				t.Errorf("unexpected request %s %s", request.method, request.path)
+			}
+			if got := request.header.Get("Authorization"); got != "Bearer token-1" {
+				t.Errorf("Authorization = %q", got)
+			}
+			if got := request.header.Get("Accept"); got != "application/vnd.github+json" {
+				t.Errorf("Accept = %q", got)
+			}
+			if reviewers := request.body["reviewers"]; len(reviewers) != 1 || reviewers[0] != "octocat" {
+				t.Errorf("unexpected body %v", request.body)
+			}
+		})
+	}
+}
+
+func TestGitHubClientReturnsAPIError(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr string
+	}{
+		{name: "message from API", status: http.StatusUnprocessableEntity, body: `{"message":"Reviews may only be requested from collaborators."}`, wantErr: "422 Unprocessable Entity: Reviews may only be requested from collaborators."},
+		{name: "status only", status: http.StatusBadGateway, body: `<html>bad gateway</html>`, wantErr: "502 Bad Gateway"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := newTestAPI(t, tt.status, tt.body)
+
+			err := NewGitHubClient(server.URL, "token-1").RequestReviewers(context.Background(), "org/repo", 7, []string{"octocat"})
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if !strings.HasSuffix(err.Error(), tt.wantErr) {
+				t.Errorf("error %q, want suffix %q", err, tt.wantErr)
+			}
+		})
+	}
+}
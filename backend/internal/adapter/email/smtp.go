@@ -0,0 +1,106 @@
+package email
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/smtp"
+	"strconv"
+	"test_task_avito/backend/internal/port"
+	"test_task_avito/backend/pkg/mail"
+	"time"
+)
+
+var _ port.MailSender = (*SMTPSender)(nil)
+
+// defaultTimeout ограничение времени отправки одного письма
+const defaultTimeout = 30 * time.Second
+
+// SMTPSender отправляет письма через SMTP-сервер. Если сервер поддерживает STARTTLS, соединение шифруется;
+// пароль без шифрования передается только на localhost.
+type SMTPSender struct {
+	host     string
+	port     int
+	username string
+	password string
+	from     string
+	// rootCAs корневые сертификаты для проверки сервера при STARTTLS (nil — системные)
+	rootCAs *x509.CertPool
+}
+
+// NewSMTPSender создает новый экземпляр SMTPSender; пустой username отключает аутентификацию
+func NewSMTPSender(host string, port int, username, password, from string) *SMTPSender {
+	return &SMTPSender{
+		host:     host,
+		port:     port,
+		username: username,
+		password: password,
+		from:     from,
+	}
+}
+
+// Send отправляет письмо; отказ сервера (код 4xx или 5xx) возвращается ошибкой
+func (s *SMTPSender) Send(ctx context.Context, to, subject, text, html string) error {
+	payload, err := mail.Message{
+		From:    s.from,
+		To:      to,
+		Subject: subject,
+		Date:    time.Now(),
+		Text:    text,
+		HTML:    html,
+	}.Encode()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(s.host, strconv.Itoa(s.port)))
+	if err != nil {
+		return err
+	}
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: s.host, RootCAs: s.rootCAs}); err != nil {
+			return err
+		}
+	}
+	if s.username != "" {
+		if err := client.Auth(smtp.PlainAuth("", s.username, s.password, s.host)); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(s.from); err != nil {
+		return err
+	}
+	if err := client.Rcpt(to); err != nil {
+		return err
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(payload); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
@@ -0,0 +1,116 @@
+package email
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"test_task_avito/backend/pkg/mail/mailtest"
+	"testing"
+)
+
+// newTestSender создает отправителя, подключенного к тестовому серверу
+func newTestSender(t *testing.T, server *mailtest.Server, username string) *SMTPSender {
+	t.Helper()
+	host, portValue, err := net.SplitHostPort(server.Addr)
+	if err != nil {
+		t.Fatalf("split address: %v", err)
+	}
+	port, err := strconv.Atoi(portValue)
+	if err != nil {
+		t.Fatalf("parse port: %v", err)
+	}
+	return NewSMTPSender(host, port, username, "secret", "reviews@example.com")
+}
+
+func TestSMTPSenderSend(t *testing.T) {
+	tests := []struct {
+		name     string
+		username string
+	}{
+		{name: "without authentication"},
+		{name: "with authentication on localhost", username: "robot"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, err := mailtest.NewServer()
+			if err != nil {
+				t.Fatalf("NewServer: %v", err)
+			}
+			defer server.Close()
+
+			err = newTestSender(t, server, tt.username).Send(context.Background(), "alice@example.com", "Review digest", "text body", "<p>html body</p>")
+			if err != nil {
+				t.Fatalf("send: %v", err)
+			}
+
+			messages := server.Messages()
+			if len(messages) != 1 {
+				t.Fatalf("expected one message, got %d", len(messages))
+			}
+			message := messages[0]
+			if message.From != "reviews@example.com" || len(message.To) != 1 || message.To[0] != "alice@example.com" {
+				t.Errorf("unexpected envelope %+v", message)
+			}
+			if message.Username != tt.username || message.TLS {
+				t.Errorf("username %q, TLS %v", message.Username, message.TLS)
+			}
+			data := string(message.Data)
+			for _, want := range []string{"Subject: Review digest", "text body", "<p>html body</p>"} {
+				if !strings.Contains(data, want) {
+					t.Errorf("message does not contain %q:\n%s", want, data)
+				}
+			}
+		})
+	}
+}
+
+func TestSMTPSenderUsesStartTLS(t *testing.T) {
+	server, err := mailtest.NewTLSServer()
+	if err != nil {
+		t.Fatalf("NewTLSServer: %v", err)
+	}
+	defer server.Close()
+	sender := newTestSender(t, server, "robot")
+	sender.rootCAs = server.CertPool()
+
+	if err := sender.Send(context.Background(), "alice@example.com", "Review digest", "text", ""); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+
+	messages := server.Messages()
+	if len(messages) != 1 || !messages[0].TLS || messages[0].Username != "robot" {
+		t.Fatalf("expected one authenticated message over TLS, got %+v", messages)
+	}
+}
+
+func TestSMTPSenderRejectsUntrustedCertificate(t *testing.T) {
+	server, err := mailtest.NewTLSServer()
+	if err != nil {
+		t.Fatalf("NewTLSServer: %v", err)
+	}
+	defer server.Close()
+
+	// Сервер предлагает STARTTLS, поэтому письмо не уходит открытым текстом, если сертификат не прошел проверку
+	err = newTestSender(t, server, "robot").Send(context.Background(), "alice@example.com", "Review digest", "text", "")
+	if err == nil {
+		t.Fatal("expected a certificate error")
+	}
+	if len(server.Messages()) != 0 {
+		t.Fatalf("message must not be delivered, got %d", len(server.Messages()))
+	}
+}
+
+func TestSMTPSenderReturnsServerRejection(t *testing.T) {
+	server, err := mailtest.NewServer()
+	if err != nil {
+		t.Fatalf("NewServer: %v", err)
+	}
+	defer server.Close()
+	server.FailNext(1)
+
+	err = newTestSender(t, server, "").Send(context.Background(), "alice@example.com", "Review digest", "text", "")
+	if err == nil || !strings.Contains(err.Error(), "451") {
+		t.Fatalf("expected 451 error, got %v", err)
+	}
+}
@@ -0,0 +1,24 @@
+package eventsink
+
+import (
+	"context"
+	"test_task_avito/backend/internal/entity"
+	"test_task_avito/backend/internal/port"
+)
+
+var _ port.EventPublisher = (*BrokerSink)(nil)
+
+// BrokerSink публикует доменные события в брокер сообщений.
+// Повтор события публикуется с тем же ID сообщения.
+type BrokerSink struct {
+	broker port.BrokerUseCase
+}
+
+// NewBrokerSink создает новый экземпляр BrokerSink
+func NewBrokerSink(broker port.BrokerUseCase) *BrokerSink {
+	return &BrokerSink{broker: broker}
+}
+
+func (s *BrokerSink) Publish(ctx context.Context, event *entity.Event) error {
+	return s.broker.Publish(ctx, event)
+}
@@ -0,0 +1,24 @@
+package eventsink
+
+import (
+	"context"
+	"test_task_avito/backend/internal/entity"
+	"test_task_avito/backend/internal/port"
+)
+
+var _ port.EventPublisher = (*ChatSink)(nil)
+
+// ChatSink ставит уведомления ревьюверов о назначениях в очередь отправки в чаты команд.
+// Повтор события не создает нового сообщения.
+type ChatSink struct {
+	notifications port.NotificationUseCase
+}
+
+// NewChatSink создает новый экземпляр ChatSink
+func NewChatSink(notifications port.NotificationUseCase) *ChatSink {
+	return &ChatSink{notifications: notifications}
+}
+
+func (s *ChatSink) Publish(ctx context.Context, event *entity.Event) error {
+	return s.notifications.Notify(ctx, event)
+}
@@ -0,0 +1,24 @@
+package eventsink
+
+import (
+	"context"
+	"test_task_avito/backend/internal/entity"
+	"test_task_avito/backend/internal/port"
+)
+
+var _ port.EventPublisher = (*CodeHostSink)(nil)
+
+// CodeHostSink ставит изменения ревьюверов PR хостингов кода в очередь передачи на хостинг.
+// Повтор события не создает новых изменений.
+type CodeHostSink struct {
+	sync port.CodeHostSyncUseCase
+}
+
+// NewCodeHostSink создает новый экземпляр CodeHostSink
+func NewCodeHostSink(sync port.CodeHostSyncUseCase) *CodeHostSink {
+	return &CodeHostSink{sync: sync}
+}
+
+func (s *CodeHostSink) Publish(ctx context.Context, event *entity.Event) error {
+	return s.sync.Enqueue(ctx, event)
+}
@@ -0,0 +1,30 @@
+package eventsink
+
+import (
+	"context"
+	"test_task_avito/backend/internal/entity"
+	"test_task_avito/backend/internal/port"
+
+	"go.uber.org/zap"
+)
+
+var _ port.EventPublisher = (*LogSink)(nil)
+
+// LogSink записывает доменные события в журнал приложения
+type LogSink struct {
+	logger *zap.Logger
+}
+
+// NewLogSink создает новый экземпляр LogSink
+func NewLogSink(logger *zap.Logger) *LogSink {
+	return &LogSink{logger: logger}
+}
+
+func (s *LogSink) Publish(_ context.Context, event *entity.Event) error {
+	s.logger.Info("domain event",
+		zap.String("event_id", event.ID),
+		zap.String("type", string(event.Type)),
+		zap.Time("occurred_at", event.OccurredAt),
+		zap.Any("data", event.Data))
+	return nil
+}
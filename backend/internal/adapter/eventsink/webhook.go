@@ -0,0 +1,24 @@
+package eventsink
+
+import (
+	"context"
+	"test_task_avito/backend/internal/entity"
+	"test_task_avito/backend/internal/port"
+)
+
+var _ port.EventPublisher = (*WebhookSink)(nil)
+
+// WebhookSink ставит доменные события в очередь доставки вебхуков подписчикам.
+// Повтор события для той же подписки не создает новую доставку.
+type WebhookSink struct {
+	webhooks port.WebhookUseCase
+}
+
+// NewWebhookSink создает новый экземпляр WebhookSink
+func NewWebhookSink(webhooks port.WebhookUseCase) *WebhookSink {
+	return &WebhookSink{webhooks: webhooks}
+}
+
+func (s *WebhookSink) Publish(ctx context.Context, event *entity.Event) error {
+	return s.webhooks.Enqueue(ctx, event)
+}
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"strings"
 	entity2 "test_task_avito/backend/internal/entity"
@@ -14,9 +15,17 @@ import (
 
 // Проверка реализации интерфейсов
 var (
-	_ port.TeamRepository        = (*PostgresRepository)(nil)
-	_ port.UserRepository        = (*PostgresRepository)(nil)
-	_ port.PullRequestRepository = (*PostgresRepository)(nil)
+	_ port.TeamRepository            = (*PostgresRepository)(nil)
+	_ port.UserRepository            = (*PostgresRepository)(nil)
+	_ port.PullRequestRepository     = (*PostgresRepository)(nil)
+	_ port.JobLocker                 = (*PostgresRepository)(nil)
+	_ port.WebhookRepository         = (*PostgresRepository)(nil)
+	_ port.OutboxRepository          = (*PostgresRepository)(nil)
+	_ port.CodeHostAccountRepository = (*PostgresRepository)(nil)
+	_ port.CodeHostProjectRepository = (*PostgresRepository)(nil)
+	_ port.CodeHostSyncRepository    = (*PostgresRepository)(nil)
+	_ port.ChatRepository            = (*PostgresRepository)(nil)
+	_ port.DigestRepository          = (*PostgresRepository)(nil)
 )
 
 // PostgresRepository объединяет все репозитории
@@ -101,12 +110,180 @@ func (r *PostgresRepository) TeamExists(ctx context.Context, teamName string) (b
 	return exists, err
 }
 
-func (r *PostgresRepository) BulkDeactivateUsersByTeam(ctx context.Context, teamName string) (int64, error) {
-	result, err := r.db.ExecContext(ctx, "UPDATE users SET is_active = false, updated_at = CURRENT_TIMESTAMP WHERE team_name = $1 AND is_active = true", teamName)
+func (r *PostgresRepository) BulkDeactivateUsersByTeam(ctx context.Context, teamName string, events ...*entity2.Event) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, "UPDATE users SET is_active = false, updated_at = CURRENT_TIMESTAMP WHERE team_name = $1 AND is_active = true", teamName)
+	if err != nil {
+		return 0, err
+	}
+	deactivated, err := result.RowsAffected()
 	if err != nil {
 		return 0, err
 	}
-	return result.RowsAffected()
+
+	if err := insertOutboxEvents(ctx, tx, events); err != nil {
+		return 0, err
+	}
+
+	return deactivated, tx.Commit()
+}
+
+func (r *PostgresRepository) GetTeamSettings(ctx context.Context, teamName string) (*entity2.TeamSettings, error) {
+	exists, err := r.TeamExists(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, entity2.NewDomainError(entity2.ErrorCodeNotFound, "team not found")
+	}
+
+	settings := &entity2.TeamSettings{
+		TeamName:          teamName,
+		SelectionMode:     entity2.SelectionModeRandom,
+		PairHistoryWindow: entity2.DefaultPairHistoryWindow,
+	}
+	err = r.db.QueryRowContext(ctx,
+		`SELECT default_max_open_reviews, selection_mode, COALESCE(required_reviewer_team, ''), COALESCE(min_reviewer_level, ''),
+		        pair_history_window, review_sla_hours, stale_reassign_hours, stale_escalation_hours, prefer_working_hours
+		 FROM team_settings WHERE team_name = $1`,
+		teamName).Scan(&settings.DefaultMaxOpenReviews, &settings.SelectionMode, &settings.RequiredReviewerTeam, &settings.MinReviewerLevel,
+		&settings.PairHistoryWindow, &settings.ReviewSLAHours, &settings.StaleReassignHours, &settings.StaleEscalationHours,
+		&settings.PreferWorkingHours)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+func (r *PostgresRepository) SaveTeamSettings(ctx context.Context, settings *entity2.TeamSettings) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO team_settings (team_name, default_max_open_reviews, selection_mode, required_reviewer_team, min_reviewer_level,
+		                            pair_history_window, review_sla_hours, stale_reassign_hours, stale_escalation_hours,
+		                            prefer_working_hours, updated_at)
+		 VALUES ($1, $2, $3, NULLIF($4, ''), NULLIF($5, ''), $6, $7, $8, $9, $10, CURRENT_TIMESTAMP)
+		 ON CONFLICT (team_name)
+		 DO UPDATE SET default_max_open_reviews = EXCLUDED.default_max_open_reviews, selection_mode = EXCLUDED.selection_mode,
+		               required_reviewer_team = EXCLUDED.required_reviewer_team, min_reviewer_level = EXCLUDED.min_reviewer_level,
+		               pair_history_window = EXCLUDED.pair_history_window, review_sla_hours = EXCLUDED.review_sla_hours,
+		               stale_reassign_hours = EXCLUDED.stale_reassign_hours, stale_escalation_hours = EXCLUDED.stale_escalation_hours,
+		               prefer_working_hours = EXCLUDED.prefer_working_hours, updated_at = CURRENT_TIMESTAMP`,
+		settings.TeamName, settings.DefaultMaxOpenReviews, settings.SelectionMode, settings.RequiredReviewerTeam, settings.MinReviewerLevel,
+		settings.PairHistoryWindow, settings.ReviewSLAHours, settings.StaleReassignHours, settings.StaleEscalationHours,
+		settings.PreferWorkingHours)
+	return err
+}
+
+func (r *PostgresRepository) GetTeamCodeowners(ctx context.Context, teamName string) (string, error) {
+	var content string
+	err := r.db.QueryRowContext(ctx, "SELECT content FROM team_codeowners WHERE team_name = $1", teamName).Scan(&content)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return content, err
+}
+
+func (r *PostgresRepository) SaveTeamCodeowners(ctx context.Context, teamName, content string) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO team_codeowners (team_name, content, updated_at)
+		 VALUES ($1, $2, CURRENT_TIMESTAMP)
+		 ON CONFLICT (team_name)
+		 DO UPDATE SET content = EXCLUDED.content, updated_at = CURRENT_TIMESTAMP`,
+		teamName, content)
+	return err
+}
+
+func (r *PostgresRepository) GetTeamHolidays(ctx context.Context, teamName string) ([]entity2.TeamHoliday, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT holiday_date, name FROM team_holidays WHERE team_name = $1 ORDER BY holiday_date",
+		teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	holidays := make([]entity2.TeamHoliday, 0)
+	for rows.Next() {
+		var date time.Time
+		var holiday entity2.TeamHoliday
+		if err := rows.Scan(&date, &holiday.Name); err != nil {
+			return nil, err
+		}
+		holiday.Date = date.Format(entity2.HolidayDateLayout)
+		holidays = append(holidays, holiday)
+	}
+
+	return holidays, rows.Err()
+}
+
+func (r *PostgresRepository) SetTeamHolidays(ctx context.Context, teamName string, holidays []entity2.TeamHoliday) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM team_holidays WHERE team_name = $1", teamName); err != nil {
+		return err
+	}
+
+	for _, holiday := range holidays {
+		_, err := tx.ExecContext(ctx,
+			"INSERT INTO team_holidays (team_name, holiday_date, name) VALUES ($1, $2, $3)",
+			teamName, holiday.Date, holiday.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// userColumns колонки users в порядке, ожидаемом scanUser
+const userColumns = "user_id, username, team_name, is_active, max_open_reviews, review_weight, level, timezone, work_start_minute, work_end_minute"
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanUser(row rowScanner) (*entity2.User, error) {
+	var user entity2.User
+	var maxOpenReviews, workStart, workEnd sql.NullInt32
+	var timezone sql.NullString
+	if err := row.Scan(&user.UserID, &user.Username, &user.TeamName, &user.IsActive, &maxOpenReviews, &user.ReviewWeight, &user.Level,
+		&timezone, &workStart, &workEnd); err != nil {
+		return nil, err
+	}
+	if maxOpenReviews.Valid {
+		limit := int(maxOpenReviews.Int32)
+		user.MaxOpenReviews = &limit
+	}
+	if timezone.Valid && workStart.Valid && workEnd.Valid {
+		user.Schedule = &entity2.WorkSchedule{
+			Timezone:  timezone.String,
+			WorkStart: int(workStart.Int32),
+			WorkEnd:   int(workEnd.Int32),
+		}
+	}
+	return &user, nil
+}
+
+func scanUsers(rows *sql.Rows) ([]*entity2.User, error) {
+	var users []*entity2.User
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+
+	return users, rows.Err()
 }
 
 // UserRepository реализация
@@ -122,25 +299,24 @@ func (r *PostgresRepository) CreateOrUpdateUser(ctx context.Context, user *entit
 }
 
 func (r *PostgresRepository) GetUser(ctx context.Context, userID string) (*entity2.User, error) {
-	var user entity2.User
-	err := r.db.QueryRowContext(ctx,
-		"SELECT user_id, username, team_name, is_active FROM users WHERE user_id = $1",
-		userID).Scan(&user.UserID, &user.Username, &user.TeamName, &user.IsActive)
+	user, err := scanUser(r.db.QueryRowContext(ctx,
+		"SELECT "+userColumns+" FROM users WHERE user_id = $1",
+		userID))
 	if err == sql.ErrNoRows {
 		return nil, entity2.NewDomainError(entity2.ErrorCodeNotFound, "user not found")
 	}
 	if err != nil {
 		return nil, err
 	}
-	return &user, nil
+	return user, nil
 }
 
 func (r *PostgresRepository) GetActiveUsersByTeam(ctx context.Context, teamName string, excludeUserID string) ([]*entity2.User, error) {
-	query := "SELECT user_id, username, team_name, is_active FROM users WHERE team_name = $1 AND is_active = true"
-	args := []interface{}{teamName}
+	query := "SELECT " + userColumns + " FROM users WHERE team_name = $1 AND is_active = true AND " + notOnLeaveCondition("$2")
+	args := []interface{}{teamName, time.Now().UTC()}
 
 	if excludeUserID != "" {
-		query += " AND user_id != $2"
+		query += " AND user_id != $3"
 		args = append(args, excludeUserID)
 	}
 
@@ -152,16 +328,7 @@ func (r *PostgresRepository) GetActiveUsersByTeam(ctx context.Context, teamName
 	}
 	defer rows.Close()
 
-	var users []*entity2.User
-	for rows.Next() {
-		var user entity2.User
-		if err := rows.Scan(&user.UserID, &user.Username, &user.TeamName, &user.IsActive); err != nil {
-			return nil, err
-		}
-		users = append(users, &user)
-	}
-
-	return users, rows.Err()
+	return scanUsers(rows)
 }
 
 func (r *PostgresRepository) UpdateUserIsActive(ctx context.Context, userID string, isActive bool) error {
@@ -183,37 +350,112 @@ func (r *PostgresRepository) UpdateUserIsActive(ctx context.Context, userID stri
 	return nil
 }
 
+func (r *PostgresRepository) UpdateUserMaxOpenReviews(ctx context.Context, userID string, maxOpenReviews *int) error {
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE users SET max_open_reviews = $1, updated_at = CURRENT_TIMESTAMP WHERE user_id = $2",
+		maxOpenReviews, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return entity2.NewDomainError(entity2.ErrorCodeNotFound, "user not found")
+	}
+
+	return nil
+}
+
+func (r *PostgresRepository) UpdateUserLevel(ctx context.Context, userID string, level entity2.UserLevel) error {
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE users SET level = $1, updated_at = CURRENT_TIMESTAMP WHERE user_id = $2",
+		level, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return entity2.NewDomainError(entity2.ErrorCodeNotFound, "user not found")
+	}
+
+	return nil
+}
+
+func (r *PostgresRepository) UpdateUserSchedule(ctx context.Context, userID string, schedule *entity2.WorkSchedule) error {
+	var timezone sql.NullString
+	var workStart, workEnd sql.NullInt32
+	if schedule != nil {
+		timezone = sql.NullString{String: schedule.Timezone, Valid: true}
+		workStart = sql.NullInt32{Int32: int32(schedule.WorkStart), Valid: true}
+		workEnd = sql.NullInt32{Int32: int32(schedule.WorkEnd), Valid: true}
+	}
+
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE users SET timezone = $1, work_start_minute = $2, work_end_minute = $3, updated_at = CURRENT_TIMESTAMP
+		 WHERE user_id = $4`,
+		timezone, workStart, workEnd, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return entity2.NewDomainError(entity2.ErrorCodeNotFound, "user not found")
+	}
+
+	return nil
+}
+
+func (r *PostgresRepository) UpdateUserReviewWeight(ctx context.Context, userID string, weight float64) error {
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE users SET review_weight = $1, updated_at = CURRENT_TIMESTAMP WHERE user_id = $2",
+		weight, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return entity2.NewDomainError(entity2.ErrorCodeNotFound, "user not found")
+	}
+
+	return nil
+}
+
 func (r *PostgresRepository) GetUsersByTeam(ctx context.Context, teamName string) ([]*entity2.User, error) {
 	rows, err := r.db.QueryContext(ctx,
-		"SELECT user_id, username, team_name, is_active FROM users WHERE team_name = $1 ORDER BY user_id",
+		"SELECT "+userColumns+" FROM users WHERE team_name = $1 ORDER BY user_id",
 		teamName)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var users []*entity2.User
-	for rows.Next() {
-		var user entity2.User
-		if err := rows.Scan(&user.UserID, &user.Username, &user.TeamName, &user.IsActive); err != nil {
-			return nil, err
-		}
-		users = append(users, &user)
-	}
-
-	return users, rows.Err()
+	return scanUsers(rows)
 }
 
 func (r *PostgresRepository) GetAllActiveUsers(ctx context.Context, excludeIDs []string) ([]*entity2.User, error) {
-	query := "SELECT user_id, username, team_name, is_active FROM users WHERE is_active = true"
-	var args []interface{}
+	query := "SELECT " + userColumns + " FROM users WHERE is_active = true AND " + notOnLeaveCondition("$1")
+	args := []interface{}{time.Now().UTC()}
 
 	if len(excludeIDs) > 0 {
 		placeholders := make([]string, len(excludeIDs))
-		args = make([]interface{}, len(excludeIDs))
 		for i, id := range excludeIDs {
-			placeholders[i] = fmt.Sprintf("$%d", i+1)
-			args[i] = id
+			placeholders[i] = fmt.Sprintf("$%d", i+2)
+			args = append(args, id)
 		}
 		query += fmt.Sprintf(" AND user_id NOT IN (%s)", strings.Join(placeholders, ","))
 	}
@@ -226,40 +468,48 @@ func (r *PostgresRepository) GetAllActiveUsers(ctx context.Context, excludeIDs [
 	}
 	defer rows.Close()
 
-	var users []*entity2.User
-	for rows.Next() {
-		var user entity2.User
-		if err := rows.Scan(&user.UserID, &user.Username, &user.TeamName, &user.IsActive); err != nil {
-			return nil, err
-		}
-		users = append(users, &user)
+	return scanUsers(rows)
+}
+
+func (r *PostgresRepository) GetAllUsers(ctx context.Context) ([]*entity2.User, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT "+userColumns+" FROM users ORDER BY user_id")
+	if err != nil {
+		return nil, err
 	}
+	defer rows.Close()
 
-	return users, rows.Err()
+	return scanUsers(rows)
 }
 
-// PullRequestRepository реализация
-func (r *PostgresRepository) CreatePullRequest(ctx context.Context, pr *entity2.PullRequest) error {
+// notOnLeaveCondition исключает пользователей, у которых в указанный момент действует окно недоступности
+func notOnLeaveCondition(atPlaceholder string) string {
+	return fmt.Sprintf(`NOT EXISTS (
+		SELECT 1 FROM user_availability ua
+		WHERE ua.user_id = users.user_id AND ua.starts_at <= %[1]s AND ua.ends_at > %[1]s)`, atPlaceholder)
+}
+
+func (r *PostgresRepository) SetUserSkills(ctx context.Context, userID string, tags []string) error {
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback()
 
-	now := time.Now()
-	_, err = tx.ExecContext(ctx,
-		`INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, status, created_at)
-		 VALUES ($1, $2, $3, $4, $5)`,
-		pr.PullRequestID, pr.PullRequestName, pr.AuthorID, pr.Status, now)
+	var exists bool
+	err = tx.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM users WHERE user_id = $1)", userID).Scan(&exists)
 	if err != nil {
 		return err
 	}
+	if !exists {
+		return entity2.NewDomainError(entity2.ErrorCodeNotFound, "user not found")
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM user_skills WHERE user_id = $1", userID); err != nil {
+		return err
+	}
 
-	// Добавляем ревьюверов
-	for _, reviewerID := range pr.AssignedReviewers {
-		_, err = tx.ExecContext(ctx,
-			"INSERT INTO pull_request_reviewers (pull_request_id, reviewer_id) VALUES ($1, $2)",
-			pr.PullRequestID, reviewerID)
+	for _, tag := range tags {
+		_, err = tx.ExecContext(ctx, "INSERT INTO user_skills (user_id, tag) VALUES ($1, $2)", userID, tag)
 		if err != nil {
 			return err
 		}
@@ -268,186 +518,1402 @@ func (r *PostgresRepository) CreatePullRequest(ctx context.Context, pr *entity2.
 	return tx.Commit()
 }
 
-func (r *PostgresRepository) GetPullRequest(ctx context.Context, prID string) (*entity2.PullRequest, error) {
-	var pr entity2.PullRequest
-	var createdAt, mergedAt sql.NullTime
+func (r *PostgresRepository) GetUserSkills(ctx context.Context, userIDs []string) (map[string][]string, error) {
+	skills := make(map[string][]string, len(userIDs))
+	if len(userIDs) == 0 {
+		return skills, nil
+	}
 
-	err := r.db.QueryRowContext(ctx,
-		`SELECT pull_request_id, pull_request_name, author_id, status, created_at, merged_at
-		 FROM pull_requests WHERE pull_request_id = $1`,
-		prID).Scan(&pr.PullRequestID, &pr.PullRequestName, &pr.AuthorID, &pr.Status, &createdAt, &mergedAt)
-	if err == sql.ErrNoRows {
-		return nil, entity2.NewDomainError(entity2.ErrorCodeNotFound, "pull request not found")
+	placeholders := make([]string, len(userIDs))
+	args := make([]interface{}, len(userIDs))
+	for i, id := range userIDs {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = id
 	}
+
+	query := fmt.Sprintf("SELECT user_id, tag FROM user_skills WHERE user_id IN (%s) ORDER BY user_id, tag",
+		strings.Join(placeholders, ","))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
-	if createdAt.Valid {
-		pr.CreatedAt = &createdAt.Time
+	for rows.Next() {
+		var userID, tag string
+		if err := rows.Scan(&userID, &tag); err != nil {
+			return nil, err
+		}
+		skills[userID] = append(skills[userID], tag)
 	}
-	if mergedAt.Valid {
-		pr.MergedAt = &mergedAt.Time
+
+	return skills, rows.Err()
+}
+
+func (r *PostgresRepository) SaveReviewerExclusion(ctx context.Context, exclusion *entity2.ReviewerExclusion) error {
+	return r.db.QueryRowContext(ctx,
+		`INSERT INTO reviewer_exclusions (user_id, excluded_user_id, reason)
+		 VALUES (LEAST($1, $2), GREATEST($1, $2), $3)
+		 ON CONFLICT (user_id, excluded_user_id)
+		 DO UPDATE SET reason = EXCLUDED.reason
+		 RETURNING created_at`,
+		exclusion.UserID, exclusion.ExcludedUserID, exclusion.Reason).Scan(&exclusion.CreatedAt)
+}
+
+func (r *PostgresRepository) DeleteReviewerExclusion(ctx context.Context, userID, excludedUserID string) (*entity2.ReviewerExclusion, error) {
+	exclusion := &entity2.ReviewerExclusion{
+		UserID:         userID,
+		ExcludedUserID: excludedUserID,
+	}
+	err := r.db.QueryRowContext(ctx,
+		`DELETE FROM reviewer_exclusions
+		 WHERE user_id = LEAST($1, $2) AND excluded_user_id = GREATEST($1, $2)
+		 RETURNING reason, created_at`,
+		userID, excludedUserID).Scan(&exclusion.Reason, &exclusion.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, entity2.NewDomainError(entity2.ErrorCodeNotFound, "exclusion not found")
+	}
+	if err != nil {
+		return nil, err
 	}
+	return exclusion, nil
+}
 
-	// Получаем ревьюверов
+func (r *PostgresRepository) GetReviewerExclusions(ctx context.Context, userID string) ([]*entity2.ReviewerExclusion, error) {
 	rows, err := r.db.QueryContext(ctx,
-		"SELECT reviewer_id FROM pull_request_reviewers WHERE pull_request_id = $1 ORDER BY reviewer_id",
-		prID)
+		`SELECT CASE WHEN user_id = $1 THEN excluded_user_id ELSE user_id END AS other_user_id, reason, created_at
+		 FROM reviewer_exclusions
+		 WHERE user_id = $1 OR excluded_user_id = $1
+		 ORDER BY other_user_id`,
+		userID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
+	var exclusions []*entity2.ReviewerExclusion
 	for rows.Next() {
-		var reviewerID string
-		if err := rows.Scan(&reviewerID); err != nil {
+		exclusion := &entity2.ReviewerExclusion{UserID: userID}
+		if err := rows.Scan(&exclusion.ExcludedUserID, &exclusion.Reason, &exclusion.CreatedAt); err != nil {
 			return nil, err
 		}
-		pr.AssignedReviewers = append(pr.AssignedReviewers, reviewerID)
+		exclusions = append(exclusions, exclusion)
 	}
 
-	return &pr, rows.Err()
+	return exclusions, rows.Err()
 }
 
-func (r *PostgresRepository) PRExists(ctx context.Context, prID string) (bool, error) {
-	var exists bool
-	err := r.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM pull_requests WHERE pull_request_id = $1)", prID).Scan(&exists)
-	return exists, err
+func (r *PostgresRepository) CreateUserAvailability(ctx context.Context, availability *entity2.UserAvailability) error {
+	err := r.db.QueryRowContext(ctx,
+		`INSERT INTO user_availability (user_id, starts_at, ends_at, reason)
+		 VALUES ($1, $2, $3, $4)
+		 RETURNING id`,
+		availability.UserID, availability.StartsAt.UTC(), availability.EndsAt.UTC(), availability.Reason).Scan(&availability.ID)
+	return err
 }
 
-func (r *PostgresRepository) UpdatePullRequestStatus(ctx context.Context, prID string, status entity2.PullRequestStatus, mergedAt *time.Time) error {
-	var err error
-	if mergedAt != nil {
-		_, err = r.db.ExecContext(ctx,
-			"UPDATE pull_requests SET status = $1, merged_at = $2 WHERE pull_request_id = $3",
-			status, mergedAt, prID)
-	} else {
-		_, err = r.db.ExecContext(ctx,
-			"UPDATE pull_requests SET status = $1 WHERE pull_request_id = $2",
-			status, prID)
-	}
+// UpsertUserAvailability при изменении периода сбрасывает отметку о переназначении, чтобы окно обработалось заново
+func (r *PostgresRepository) UpsertUserAvailability(ctx context.Context, availability *entity2.UserAvailability) error {
+	err := r.db.QueryRowContext(ctx,
+		`INSERT INTO user_availability (user_id, starts_at, ends_at, reason, external_id)
+		 VALUES ($1, $2, $3, $4, $5)
+		 ON CONFLICT (user_id, external_id) WHERE external_id IS NOT NULL
+		 DO UPDATE SET starts_at = EXCLUDED.starts_at, ends_at = EXCLUDED.ends_at, reason = EXCLUDED.reason,
+		               reviews_reassigned_at = CASE
+		                   WHEN user_availability.starts_at = EXCLUDED.starts_at AND user_availability.ends_at = EXCLUDED.ends_at
+		                   THEN user_availability.reviews_reassigned_at
+		               END
+		 RETURNING id`,
+		availability.UserID, availability.StartsAt.UTC(), availability.EndsAt.UTC(), availability.Reason, availability.ExternalID).Scan(&availability.ID)
 	return err
 }
 
-func (r *PostgresRepository) UpdatePullRequestReviewers(ctx context.Context, prID string, reviewers []string) error {
-	tx, err := r.db.BeginTx(ctx, nil)
+func (r *PostgresRepository) GetStartedAvailabilities(ctx context.Context, at time.Time) ([]*entity2.UserAvailability, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT id, user_id, starts_at, ends_at, reason
+		 FROM user_availability
+		 WHERE reviews_reassigned_at IS NULL AND starts_at <= $1 AND ends_at > $1
+		 ORDER BY starts_at`,
+		at.UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var availabilities []*entity2.UserAvailability
+	for rows.Next() {
+		var availability entity2.UserAvailability
+		if err := rows.Scan(&availability.ID, &availability.UserID, &availability.StartsAt, &availability.EndsAt, &availability.Reason); err != nil {
+			return nil, err
+		}
+		availabilities = append(availabilities, &availability)
+	}
+
+	return availabilities, rows.Err()
+}
+
+func (r *PostgresRepository) MarkAvailabilityProcessed(ctx context.Context, availabilityID int64, at time.Time) error {
+	_, err := r.db.ExecContext(ctx,
+		"UPDATE user_availability SET reviews_reassigned_at = $1 WHERE id = $2",
+		at.UTC(), availabilityID)
+	return err
+}
+
+// PullRequestRepository реализация
+func (r *PostgresRepository) CreatePullRequest(ctx context.Context, pr *entity2.PullRequest, events ...*entity2.Event) error {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback()
 
-	// Удаляем старых ревьюверов
-	_, err = tx.ExecContext(ctx, "DELETE FROM pull_request_reviewers WHERE pull_request_id = $1", prID)
+	now := time.Now()
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, team_name, status, created_at)
+		 VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6)`,
+		pr.PullRequestID, pr.PullRequestName, pr.AuthorID, pr.TeamName, pr.Status, now)
 	if err != nil {
 		return err
 	}
 
-	// Добавляем новых ревьюверов
-	for _, reviewerID := range reviewers {
+	// Добавляем ревьюверов
+	for _, reviewerID := range pr.AssignedReviewers {
 		_, err = tx.ExecContext(ctx,
 			"INSERT INTO pull_request_reviewers (pull_request_id, reviewer_id) VALUES ($1, $2)",
-			prID, reviewerID)
+			pr.PullRequestID, reviewerID)
+		if err != nil {
+			return err
+		}
+	}
+	if err := insertReviewerHistory(ctx, tx, pr.PullRequestID, pr.AssignedReviewers); err != nil {
+		return err
+	}
+
+	// Добавляем требуемые навыки
+	for _, tag := range pr.RequiredTags {
+		_, err = tx.ExecContext(ctx,
+			"INSERT INTO pull_request_tags (pull_request_id, tag) VALUES ($1, $2)",
+			pr.PullRequestID, tag)
 		if err != nil {
 			return err
 		}
 	}
 
+	if err := insertOutboxEvents(ctx, tx, events); err != nil {
+		return err
+	}
+
 	return tx.Commit()
 }
 
-func (r *PostgresRepository) GetPullRequestsByReviewer(ctx context.Context, userID string) ([]*entity2.PullRequest, error) {
+func (r *PostgresRepository) GetPullRequest(ctx context.Context, prID string) (*entity2.PullRequest, error) {
+	var pr entity2.PullRequest
+	var createdAt, mergedAt sql.NullTime
+
+	err := r.db.QueryRowContext(ctx,
+		`SELECT pull_request_id, pull_request_name, author_id, COALESCE(team_name, ''), status, created_at, merged_at
+		 FROM pull_requests WHERE pull_request_id = $1`,
+		prID).Scan(&pr.PullRequestID, &pr.PullRequestName, &pr.AuthorID, &pr.TeamName, &pr.Status, &createdAt, &mergedAt)
+	if err == sql.ErrNoRows {
+		return nil, entity2.NewDomainError(entity2.ErrorCodeNotFound, "pull request not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if createdAt.Valid {
+		pr.CreatedAt = &createdAt.Time
+	}
+	if mergedAt.Valid {
+		pr.MergedAt = &mergedAt.Time
+	}
+
+	// Получаем ревьюверов
 	rows, err := r.db.QueryContext(ctx,
-		`SELECT pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status, pr.created_at, pr.merged_at
-		 FROM pull_requests pr
-		 INNER JOIN pull_request_reviewers prr ON pr.pull_request_id = prr.pull_request_id
-		 WHERE prr.reviewer_id = $1
-		 ORDER BY pr.created_at DESC`,
-		userID)
+		"SELECT reviewer_id FROM pull_request_reviewers WHERE pull_request_id = $1 ORDER BY reviewer_id",
+		prID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var prs []*entity2.PullRequest
 	for rows.Next() {
-		var pr entity2.PullRequest
-		var createdAt, mergedAt sql.NullTime
-
-		if err := rows.Scan(&pr.PullRequestID, &pr.PullRequestName, &pr.AuthorID, &pr.Status, &createdAt, &mergedAt); err != nil {
+		var reviewerID string
+		if err := rows.Scan(&reviewerID); err != nil {
 			return nil, err
 		}
+		pr.AssignedReviewers = append(pr.AssignedReviewers, reviewerID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
 
-		if createdAt.Valid {
-			pr.CreatedAt = &createdAt.Time
+	// Получаем требуемые навыки
+	tagRows, err := r.db.QueryContext(ctx,
+		"SELECT tag FROM pull_request_tags WHERE pull_request_id = $1 ORDER BY tag",
+		prID)
+	if err != nil {
+		return nil, err
+	}
+	defer tagRows.Close()
+
+	for tagRows.Next() {
+		var tag string
+		if err := tagRows.Scan(&tag); err != nil {
+			return nil, err
 		}
-		if mergedAt.Valid {
-			pr.MergedAt = &mergedAt.Time
+		pr.RequiredTags = append(pr.RequiredTags, tag)
+	}
+	if err := tagRows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Получаем отказы от ревью
+	declineRows, err := r.db.QueryContext(ctx,
+		"SELECT user_id, reason, declined_at FROM pull_request_declines WHERE pull_request_id = $1 ORDER BY declined_at, user_id",
+		prID)
+	if err != nil {
+		return nil, err
+	}
+	defer declineRows.Close()
+
+	for declineRows.Next() {
+		var decline entity2.ReviewDecline
+		if err := declineRows.Scan(&decline.UserID, &decline.Reason, &decline.DeclinedAt); err != nil {
+			return nil, err
 		}
+		pr.Declines = append(pr.Declines, decline)
+	}
+	if err := declineRows.Err(); err != nil {
+		return nil, err
+	}
 
-		prs = append(prs, &pr)
+	// Получаем эскалацию
+	var escalation entity2.ReviewEscalation
+	err = r.db.QueryRowContext(ctx,
+		"SELECT user_id, escalated_at FROM pull_request_escalations WHERE pull_request_id = $1",
+		prID).Scan(&escalation.UserID, &escalation.EscalatedAt)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	if err == nil {
+		pr.Escalation = &escalation
 	}
 
-	return prs, rows.Err()
+	return &pr, nil
 }
 
-func (r *PostgresRepository) GetOpenPullRequestsByReviewers(ctx context.Context, reviewerIDs []string) ([]string, error) {
-	if len(reviewerIDs) == 0 {
-		return nil, nil
+func (r *PostgresRepository) SaveReviewEscalation(ctx context.Context, prID string, reviewers []string, escalation *entity2.ReviewEscalation, events ...*entity2.Event) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
 	}
+	defer tx.Rollback()
 
-	placeholders := make([]string, len(reviewerIDs))
-	args := make([]interface{}, len(reviewerIDs))
-	for i, id := range reviewerIDs {
-		placeholders[i] = fmt.Sprintf("$%d", i+1)
-		args[i] = id
+	// Эскалация записывается первой: повторная эскалация PR нарушает уникальность и откатывает всю транзакцию
+	err = tx.QueryRowContext(ctx,
+		`INSERT INTO pull_request_escalations (pull_request_id, user_id)
+		 VALUES ($1, $2)
+		 RETURNING escalated_at`,
+		prID, escalation.UserID).Scan(&escalation.EscalatedAt)
+	if err != nil {
+		return err
 	}
 
-	query := fmt.Sprintf(`SELECT DISTINCT pr.pull_request_id
-		FROM pull_requests pr
-		INNER JOIN pull_request_reviewers prr ON pr.pull_request_id = prr.pull_request_id
-		WHERE pr.status = 'OPEN' AND prr.reviewer_id IN (%s)`, strings.Join(placeholders, ","))
+	if err := setPullRequestReviewers(ctx, tx, prID, reviewers); err != nil {
+		return err
+	}
 
-	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err := insertOutboxEvents(ctx, tx, events); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *PostgresRepository) GetOpenPullRequestsWithoutEscalation(ctx context.Context) ([]*entity2.PullRequest, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT pr.pull_request_id, pr.pull_request_name, pr.author_id, COALESCE(pr.team_name, ''), pr.status, pr.created_at
+		 FROM pull_requests pr
+		 WHERE pr.status = 'OPEN'
+		   AND NOT EXISTS (SELECT 1 FROM pull_request_escalations e WHERE e.pull_request_id = pr.pull_request_id)
+		 ORDER BY pr.created_at, pr.pull_request_id`)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var prIDs []string
+	var prs []*entity2.PullRequest
 	for rows.Next() {
-		var prID string
-		if err := rows.Scan(&prID); err != nil {
+		var pr entity2.PullRequest
+		var createdAt sql.NullTime
+		if err := rows.Scan(&pr.PullRequestID, &pr.PullRequestName, &pr.AuthorID, &pr.TeamName, &pr.Status, &createdAt); err != nil {
 			return nil, err
 		}
-		prIDs = append(prIDs, prID)
+		if createdAt.Valid {
+			pr.CreatedAt = &createdAt.Time
+		}
+		prs = append(prs, &pr)
 	}
 
-	return prIDs, rows.Err()
+	return prs, rows.Err()
 }
 
-func (r *PostgresRepository) GetReviewerStats(ctx context.Context) ([]entity2.ReviewerStat, int64, error) {
-	rows, err := r.db.QueryContext(ctx, `
-		SELECT reviewer_id, COUNT(*) AS reviews_count
-		FROM pull_request_reviewers
-		GROUP BY reviewer_id
-		ORDER BY reviews_count DESC`)
+func (r *PostgresRepository) SaveReviewDecline(ctx context.Context, prID string, reviewers []string, decline *entity2.ReviewDecline, events ...*entity2.Event) error {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		return nil, 0, err
+		return err
 	}
-	defer rows.Close()
+	defer tx.Rollback()
 
-	var stats []entity2.ReviewerStat
-	var total int64
+	if err := setPullRequestReviewers(ctx, tx, prID, reviewers); err != nil {
+		return err
+	}
+
+	err = tx.QueryRowContext(ctx,
+		`INSERT INTO pull_request_declines (pull_request_id, user_id, reason)
+		 VALUES ($1, $2, $3)
+		 ON CONFLICT (pull_request_id, user_id)
+		 DO UPDATE SET reason = EXCLUDED.reason, declined_at = CURRENT_TIMESTAMP
+		 RETURNING declined_at`,
+		prID, decline.UserID, decline.Reason).Scan(&decline.DeclinedAt)
+	if err != nil {
+		return err
+	}
+
+	if err := insertOutboxEvents(ctx, tx, events); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *PostgresRepository) PRExists(ctx context.Context, prID string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM pull_requests WHERE pull_request_id = $1)", prID).Scan(&exists)
+	return exists, err
+}
+
+func (r *PostgresRepository) UpdatePullRequestStatus(ctx context.Context, prID string, status entity2.PullRequestStatus, mergedAt *time.Time, events ...*entity2.Event) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if mergedAt != nil {
+		_, err = tx.ExecContext(ctx,
+			"UPDATE pull_requests SET status = $1, merged_at = $2 WHERE pull_request_id = $3",
+			status, mergedAt, prID)
+	} else {
+		_, err = tx.ExecContext(ctx,
+			"UPDATE pull_requests SET status = $1 WHERE pull_request_id = $2",
+			status, prID)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := insertOutboxEvents(ctx, tx, events); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *PostgresRepository) UpdatePullRequestReviewers(ctx context.Context, prID string, reviewers []string, events ...*entity2.Event) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := setPullRequestReviewers(ctx, tx, prID, reviewers); err != nil {
+		return err
+	}
+
+	if err := insertOutboxEvents(ctx, tx, events); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
 
+// setPullRequestReviewers приводит список ревьюверов PR к reviewers в транзакции tx
+func setPullRequestReviewers(ctx context.Context, tx *sql.Tx, prID string, reviewers []string) error {
+	// Оставшиеся ревьюверы сохраняют исходный момент назначения, от которого считается SLA
+	rows, err := tx.QueryContext(ctx, "SELECT reviewer_id FROM pull_request_reviewers WHERE pull_request_id = $1", prID)
+	if err != nil {
+		return err
+	}
+	var current []string
 	for rows.Next() {
-		var stat entity2.ReviewerStat
-		if err := rows.Scan(&stat.UserID, &stat.ReviewsCount); err != nil {
-			return nil, 0, err
+		var reviewerID string
+		if err := rows.Scan(&reviewerID); err != nil {
+			rows.Close()
+			return err
 		}
-		stats = append(stats, stat)
-		total += stat.ReviewsCount
+		current = append(current, reviewerID)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
 	}
 
-	return stats, total, rows.Err()
+	keep := make(map[string]bool, len(reviewers))
+	for _, reviewerID := range reviewers {
+		keep[reviewerID] = true
+	}
+
+	// Удаляем снятых ревьюверов
+	for _, reviewerID := range current {
+		if keep[reviewerID] {
+			continue
+		}
+		_, err = tx.ExecContext(ctx,
+			"DELETE FROM pull_request_reviewers WHERE pull_request_id = $1 AND reviewer_id = $2",
+			prID, reviewerID)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Добавляем новых ревьюверов
+	for _, reviewerID := range reviewers {
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO pull_request_reviewers (pull_request_id, reviewer_id) VALUES ($1, $2)
+			 ON CONFLICT DO NOTHING`,
+			prID, reviewerID)
+		if err != nil {
+			return err
+		}
+	}
+	return insertReviewerHistory(ctx, tx, prID, reviewers)
+}
+
+// insertReviewerHistory записывает назначения в историю ревьюверов PR; повторное назначение не дублируется
+func insertReviewerHistory(ctx context.Context, tx *sql.Tx, prID string, reviewers []string) error {
+	for _, reviewerID := range reviewers {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO pull_request_reviewer_history (pull_request_id, reviewer_id) VALUES ($1, $2)
+			 ON CONFLICT DO NOTHING`,
+			prID, reviewerID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *PostgresRepository) GetPullRequestParticipants(ctx context.Context, prIDs []string) (map[string]*entity2.PullRequest, error) {
+	prs := make(map[string]*entity2.PullRequest, len(prIDs))
+	if len(prIDs) == 0 {
+		return prs, nil
+	}
+
+	placeholders := make([]string, len(prIDs))
+	args := make([]interface{}, len(prIDs))
+	for i, id := range prIDs {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = id
+	}
+
+	query := fmt.Sprintf(`SELECT pr.pull_request_id, pr.author_id, COALESCE(pr.team_name, ''), prr.reviewer_id
+		FROM pull_requests pr
+		LEFT JOIN pull_request_reviewers prr ON pr.pull_request_id = prr.pull_request_id
+		WHERE pr.pull_request_id IN (%s)
+		ORDER BY pr.pull_request_id, prr.reviewer_id`, strings.Join(placeholders, ","))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var prID, authorID, teamName string
+		var reviewerID sql.NullString
+		if err := rows.Scan(&prID, &authorID, &teamName, &reviewerID); err != nil {
+			return nil, err
+		}
+		pr, ok := prs[prID]
+		if !ok {
+			pr = &entity2.PullRequest{PullRequestID: prID, AuthorID: authorID, TeamName: teamName}
+			prs[prID] = pr
+		}
+		if reviewerID.Valid {
+			pr.AssignedReviewers = append(pr.AssignedReviewers, reviewerID.String)
+		}
+	}
+
+	return prs, rows.Err()
+}
+
+func (r *PostgresRepository) GetPullRequestsByReviewer(ctx context.Context, userID string) ([]*entity2.PullRequest, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status, pr.created_at, pr.merged_at
+		 FROM pull_requests pr
+		 INNER JOIN pull_request_reviewers prr ON pr.pull_request_id = prr.pull_request_id
+		 WHERE prr.reviewer_id = $1
+		 ORDER BY pr.created_at DESC`,
+		userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var prs []*entity2.PullRequest
+	for rows.Next() {
+		var pr entity2.PullRequest
+		var createdAt, mergedAt sql.NullTime
+
+		if err := rows.Scan(&pr.PullRequestID, &pr.PullRequestName, &pr.AuthorID, &pr.Status, &createdAt, &mergedAt); err != nil {
+			return nil, err
+		}
+
+		if createdAt.Valid {
+			pr.CreatedAt = &createdAt.Time
+		}
+		if mergedAt.Valid {
+			pr.MergedAt = &mergedAt.Time
+		}
+
+		prs = append(prs, &pr)
+	}
+
+	return prs, rows.Err()
+}
+
+// reviewAssignmentQuery выбирает назначения ревьюверов вместе с командой PR (команда автора, если команда PR удалена)
+const reviewAssignmentQuery = `SELECT pr.pull_request_id, pr.pull_request_name, pr.author_id, COALESCE(pr.team_name, u.team_name), pr.status,
+	       prr.reviewer_id, prr.assigned_at, e.user_id IS NOT NULL
+	FROM pull_request_reviewers prr
+	INNER JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+	INNER JOIN users u ON u.user_id = pr.author_id
+	LEFT JOIN pull_request_escalations e ON e.pull_request_id = pr.pull_request_id AND e.user_id = prr.reviewer_id`
+
+func (r *PostgresRepository) GetReviewAssignmentsByReviewer(ctx context.Context, userID string) ([]*entity2.ReviewAssignment, error) {
+	return r.queryReviewAssignments(ctx,
+		reviewAssignmentQuery+" WHERE prr.reviewer_id = $1 ORDER BY pr.created_at DESC", userID)
+}
+
+func (r *PostgresRepository) GetOpenReviewAssignments(ctx context.Context) ([]*entity2.ReviewAssignment, error) {
+	return r.queryReviewAssignments(ctx,
+		reviewAssignmentQuery+" WHERE pr.status = 'OPEN' ORDER BY prr.assigned_at, pr.pull_request_id, prr.reviewer_id")
+}
+
+func (r *PostgresRepository) queryReviewAssignments(ctx context.Context, query string, args ...interface{}) ([]*entity2.ReviewAssignment, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var assignments []*entity2.ReviewAssignment
+	for rows.Next() {
+		var a entity2.ReviewAssignment
+		if err := rows.Scan(&a.PullRequestID, &a.PullRequestName, &a.AuthorID, &a.TeamName, &a.Status,
+			&a.ReviewerID, &a.AssignedAt, &a.Escalated); err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, &a)
+	}
+
+	return assignments, rows.Err()
+}
+
+func (r *PostgresRepository) GetOpenPullRequestsByReviewers(ctx context.Context, reviewerIDs []string) ([]string, error) {
+	if len(reviewerIDs) == 0 {
+		return nil, nil
+	}
+
+	placeholders := make([]string, len(reviewerIDs))
+	args := make([]interface{}, len(reviewerIDs))
+	for i, id := range reviewerIDs {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = id
+	}
+
+	query := fmt.Sprintf(`SELECT DISTINCT pr.pull_request_id
+		FROM pull_requests pr
+		INNER JOIN pull_request_reviewers prr ON pr.pull_request_id = prr.pull_request_id
+		WHERE pr.status = 'OPEN' AND prr.reviewer_id IN (%s)`, strings.Join(placeholders, ","))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var prIDs []string
+	for rows.Next() {
+		var prID string
+		if err := rows.Scan(&prID); err != nil {
+			return nil, err
+		}
+		prIDs = append(prIDs, prID)
+	}
+
+	return prIDs, rows.Err()
+}
+
+func (r *PostgresRepository) GetOpenReviewCounts(ctx context.Context, userIDs []string) (map[string]int, error) {
+	counts := make(map[string]int, len(userIDs))
+	if len(userIDs) == 0 {
+		return counts, nil
+	}
+
+	placeholders := make([]string, len(userIDs))
+	args := make([]interface{}, len(userIDs))
+	for i, id := range userIDs {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = id
+	}
+
+	query := fmt.Sprintf(`SELECT prr.reviewer_id, COUNT(*)
+		FROM pull_request_reviewers prr
+		INNER JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+		WHERE pr.status = 'OPEN' AND prr.reviewer_id IN (%s)
+		GROUP BY prr.reviewer_id`, strings.Join(placeholders, ","))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var userID string
+		var count int
+		if err := rows.Scan(&userID, &count); err != nil {
+			return nil, err
+		}
+		counts[userID] = count
+	}
+
+	return counts, rows.Err()
+}
+
+func (r *PostgresRepository) GetRecentReviewerCounts(ctx context.Context, authorID string, lastPRs int) (map[string]int, error) {
+	counts := make(map[string]int)
+	if lastPRs <= 0 {
+		return counts, nil
+	}
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT prr.reviewer_id, COUNT(*)
+		 FROM (
+		     SELECT pull_request_id FROM pull_requests
+		     WHERE author_id = $1
+		     ORDER BY created_at DESC
+		     LIMIT $2
+		 ) recent
+		 INNER JOIN pull_request_reviewer_history prr ON prr.pull_request_id = recent.pull_request_id
+		 GROUP BY prr.reviewer_id`,
+		authorID, lastPRs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var reviewerID string
+		var count int
+		if err := rows.Scan(&reviewerID, &count); err != nil {
+			return nil, err
+		}
+		counts[reviewerID] = count
+	}
+
+	return counts, rows.Err()
+}
+
+func (r *PostgresRepository) GetReviewerStats(ctx context.Context) ([]entity2.ReviewerStat, int64, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT reviewer_id, COUNT(*) AS reviews_count
+		FROM pull_request_reviewers
+		GROUP BY reviewer_id
+		ORDER BY reviews_count DESC`)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var stats []entity2.ReviewerStat
+	var total int64
+
+	for rows.Next() {
+		var stat entity2.ReviewerStat
+		if err := rows.Scan(&stat.UserID, &stat.ReviewsCount); err != nil {
+			return nil, 0, err
+		}
+		stats = append(stats, stat)
+		total += stat.ReviewsCount
+	}
+
+	return stats, total, rows.Err()
+}
+
+// WebhookRepository реализация
+func (r *PostgresRepository) CreateWebhookSubscription(ctx context.Context, subscription *entity2.WebhookSubscription) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx,
+		"INSERT INTO webhook_subscriptions (url, secret) VALUES ($1, $2) RETURNING id, created_at",
+		subscription.URL, subscription.Secret).Scan(&subscription.ID, &subscription.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	for _, eventType := range subscription.EventTypes {
+		_, err := tx.ExecContext(ctx,
+			"INSERT INTO webhook_subscription_events (subscription_id, event_type) VALUES ($1, $2)",
+			subscription.ID, string(eventType))
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *PostgresRepository) DeleteWebhookSubscription(ctx context.Context, id int64) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM webhook_subscriptions WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return entity2.NewDomainError(entity2.ErrorCodeNotFound, "webhook subscription not found")
+	}
+
+	return nil
+}
+
+func (r *PostgresRepository) GetWebhookSubscriptions(ctx context.Context) ([]*entity2.WebhookSubscription, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT s.id, s.url, s.secret, s.created_at, e.event_type
+		 FROM webhook_subscriptions s
+		 JOIN webhook_subscription_events e ON e.subscription_id = s.id
+		 ORDER BY s.id, e.event_type`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	subscriptions := make([]*entity2.WebhookSubscription, 0)
+	var current *entity2.WebhookSubscription
+	for rows.Next() {
+		var subscription entity2.WebhookSubscription
+		var eventType string
+		if err := rows.Scan(&subscription.ID, &subscription.URL, &subscription.Secret, &subscription.CreatedAt, &eventType); err != nil {
+			return nil, err
+		}
+		if current == nil || current.ID != subscription.ID {
+			current = &subscription
+			subscriptions = append(subscriptions, current)
+		}
+		current.EventTypes = append(current.EventTypes, entity2.EventType(eventType))
+	}
+
+	return subscriptions, rows.Err()
+}
+
+func (r *PostgresRepository) CreateWebhookDeliveries(ctx context.Context, deliveries []*entity2.WebhookDelivery) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, delivery := range deliveries {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, payload, status, next_attempt_at)
+			 VALUES ($1, $2, $3, $4, $5, $6)
+			 ON CONFLICT (subscription_id, event_id) DO NOTHING`,
+			delivery.SubscriptionID, delivery.EventID, string(delivery.EventType), string(delivery.Payload),
+			string(delivery.Status), delivery.NextAttemptAt.UTC())
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// webhookDeliveryQuery выборка доставок вместе с адресом и секретом подписки
+const webhookDeliveryQuery = `SELECT d.id, d.subscription_id, s.url, s.secret, d.event_id, d.event_type, d.payload, d.status,
+       d.attempts, d.next_attempt_at, d.last_error, d.created_at, d.delivered_at
+FROM webhook_deliveries d
+JOIN webhook_subscriptions s ON s.id = d.subscription_id
+`
+
+func scanWebhookDelivery(row rowScanner) (*entity2.WebhookDelivery, error) {
+	var delivery entity2.WebhookDelivery
+	var eventType, payload, status string
+	var deliveredAt sql.NullTime
+	if err := row.Scan(&delivery.ID, &delivery.SubscriptionID, &delivery.URL, &delivery.Secret, &delivery.EventID, &eventType,
+		&payload, &status, &delivery.Attempts, &delivery.NextAttemptAt, &delivery.LastError, &delivery.CreatedAt, &deliveredAt); err != nil {
+		return nil, err
+	}
+	delivery.EventType = entity2.EventType(eventType)
+	delivery.Payload = []byte(payload)
+	delivery.Status = entity2.WebhookDeliveryStatus(status)
+	if deliveredAt.Valid {
+		delivery.DeliveredAt = &deliveredAt.Time
+	}
+	return &delivery, nil
+}
+
+func (r *PostgresRepository) queryWebhookDeliveries(ctx context.Context, query string, args ...interface{}) ([]*entity2.WebhookDelivery, error) {
+	rows, err := r.db.QueryContext(ctx, webhookDeliveryQuery+query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deliveries := make([]*entity2.WebhookDelivery, 0)
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, rows.Err()
+}
+
+func (r *PostgresRepository) GetDueWebhookDeliveries(ctx context.Context, at time.Time, limit int) ([]*entity2.WebhookDelivery, error) {
+	return r.queryWebhookDeliveries(ctx,
+		`WHERE d.status = 'pending' AND d.next_attempt_at <= $1
+		 ORDER BY d.next_attempt_at, d.id
+		 LIMIT $2`,
+		at.UTC(), limit)
+}
+
+func (r *PostgresRepository) GetWebhookDelivery(ctx context.Context, id int64) (*entity2.WebhookDelivery, error) {
+	delivery, err := scanWebhookDelivery(r.db.QueryRowContext(ctx, webhookDeliveryQuery+"WHERE d.id = $1", id))
+	if err == sql.ErrNoRows {
+		return nil, entity2.NewDomainError(entity2.ErrorCodeNotFound, "webhook delivery not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+func (r *PostgresRepository) GetDeadWebhookDeliveries(ctx context.Context, limit int) ([]*entity2.WebhookDelivery, error) {
+	return r.queryWebhookDeliveries(ctx,
+		`WHERE d.status = 'dead'
+		 ORDER BY d.created_at DESC, d.id DESC
+		 LIMIT $1`,
+		limit)
+}
+
+func (r *PostgresRepository) UpdateWebhookDelivery(ctx context.Context, delivery *entity2.WebhookDelivery) error {
+	var deliveredAt interface{}
+	if delivery.DeliveredAt != nil {
+		deliveredAt = delivery.DeliveredAt.UTC()
+	}
+	_, err := r.db.ExecContext(ctx,
+		`UPDATE webhook_deliveries
+		 SET status = $2, attempts = $3, next_attempt_at = $4, last_error = $5, delivered_at = $6
+		 WHERE id = $1`,
+		delivery.ID, string(delivery.Status), delivery.Attempts, delivery.NextAttemptAt.UTC(), delivery.LastError, deliveredAt)
+	return err
+}
+
+// CodeHostAccountRepository реализация
+func (r *PostgresRepository) SetCodeHostAccount(ctx context.Context, account *entity2.CodeHostAccount) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO code_host_accounts (provider, login, user_id)
+		 VALUES ($1, $2, $3)
+		 ON CONFLICT (provider, login) DO UPDATE SET user_id = EXCLUDED.user_id`,
+		string(account.Provider), account.Login, account.UserID)
+	return err
+}
+
+func (r *PostgresRepository) DeleteCodeHostAccount(ctx context.Context, provider entity2.CodeHostProvider, login string) error {
+	result, err := r.db.ExecContext(ctx,
+		"DELETE FROM code_host_accounts WHERE provider = $1 AND login = $2",
+		string(provider), login)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return entity2.NewDomainError(entity2.ErrorCodeNotFound, "code host account not found")
+	}
+
+	return nil
+}
+
+func (r *PostgresRepository) GetCodeHostAccounts(ctx context.Context, provider entity2.CodeHostProvider) ([]*entity2.CodeHostAccount, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT login, user_id FROM code_host_accounts WHERE provider = $1 ORDER BY login",
+		string(provider))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	accounts := make([]*entity2.CodeHostAccount, 0)
+	for rows.Next() {
+		account := &entity2.CodeHostAccount{Provider: provider}
+		if err := rows.Scan(&account.Login, &account.UserID); err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, account)
+	}
+
+	return accounts, rows.Err()
+}
+
+func (r *PostgresRepository) GetUserIDByCodeHostLogin(ctx context.Context, provider entity2.CodeHostProvider, login string) (string, error) {
+	var userID string
+	err := r.db.QueryRowContext(ctx,
+		"SELECT user_id FROM code_host_accounts WHERE provider = $1 AND login = $2",
+		string(provider), login).Scan(&userID)
+	if err == sql.ErrNoRows {
+		return "", entity2.NewDomainError(entity2.ErrorCodeNotFound, "code host account not found")
+	}
+	return userID, err
+}
+
+func (r *PostgresRepository) GetCodeHostLogin(ctx context.Context, provider entity2.CodeHostProvider, userID string) (string, error) {
+	var login string
+	err := r.db.QueryRowContext(ctx,
+		"SELECT login FROM code_host_accounts WHERE provider = $1 AND user_id = $2 ORDER BY login LIMIT 1",
+		string(provider), userID).Scan(&login)
+	if err == sql.ErrNoRows {
+		return "", entity2.NewDomainError(entity2.ErrorCodeNotFound, "code host account not found")
+	}
+	return login, err
+}
+
+// CodeHostProjectRepository реализация
+func (r *PostgresRepository) SetCodeHostProject(ctx context.Context, project *entity2.CodeHostProject) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO code_host_projects (provider, repository, team_name)
+		 VALUES ($1, $2, $3)
+		 ON CONFLICT (provider, repository) DO UPDATE SET team_name = EXCLUDED.team_name`,
+		string(project.Provider), project.Repository, project.TeamName)
+	return err
+}
+
+func (r *PostgresRepository) DeleteCodeHostProject(ctx context.Context, provider entity2.CodeHostProvider, repository string) error {
+	result, err := r.db.ExecContext(ctx,
+		"DELETE FROM code_host_projects WHERE provider = $1 AND repository = $2",
+		string(provider), repository)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return entity2.NewDomainError(entity2.ErrorCodeNotFound, "code host project not found")
+	}
+
+	return nil
+}
+
+func (r *PostgresRepository) GetCodeHostProjects(ctx context.Context, provider entity2.CodeHostProvider) ([]*entity2.CodeHostProject, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT repository, team_name FROM code_host_projects WHERE provider = $1 ORDER BY repository",
+		string(provider))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	projects := make([]*entity2.CodeHostProject, 0)
+	for rows.Next() {
+		project := &entity2.CodeHostProject{Provider: provider}
+		if err := rows.Scan(&project.Repository, &project.TeamName); err != nil {
+			return nil, err
+		}
+		projects = append(projects, project)
+	}
+
+	return projects, rows.Err()
+}
+
+func (r *PostgresRepository) GetCodeHostProjectTeam(ctx context.Context, provider entity2.CodeHostProvider, repository string) (string, error) {
+	var teamName string
+	err := r.db.QueryRowContext(ctx,
+		"SELECT team_name FROM code_host_projects WHERE provider = $1 AND repository = $2",
+		string(provider), repository).Scan(&teamName)
+	if err == sql.ErrNoRows {
+		return "", entity2.NewDomainError(entity2.ErrorCodeNotFound, "code host project not found")
+	}
+	return teamName, err
+}
+
+// CodeHostSyncRepository реализация
+func (r *PostgresRepository) CreateCodeHostReviewerSyncs(ctx context.Context, syncs []*entity2.CodeHostReviewerSync) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, sync := range syncs {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO code_host_reviewer_syncs
+			     (event_id, pull_request_id, provider, repository, number, operation, user_id, login, status, next_attempt_at)
+			 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			 ON CONFLICT (event_id, operation, user_id) DO NOTHING`,
+			sync.EventID, sync.PullRequestID, string(sync.Provider), sync.Repository, sync.Number,
+			string(sync.Operation), sync.UserID, sync.Login, string(sync.Status), sync.NextAttemptAt.UTC())
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *PostgresRepository) GetDueCodeHostReviewerSyncs(ctx context.Context, at time.Time, limit int) ([]*entity2.CodeHostReviewerSync, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT id, event_id, pull_request_id, provider, repository, number, operation, user_id, login,
+		        status, attempts, next_attempt_at, last_error
+		 FROM code_host_reviewer_syncs
+		 WHERE status = 'pending' AND next_attempt_at <= $1
+		 ORDER BY next_attempt_at, id
+		 LIMIT $2`,
+		at.UTC(), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	syncs := make([]*entity2.CodeHostReviewerSync, 0)
+	for rows.Next() {
+		var sync entity2.CodeHostReviewerSync
+		var provider, operation, status string
+		if err := rows.Scan(&sync.ID, &sync.EventID, &sync.PullRequestID, &provider, &sync.Repository, &sync.Number,
+			&operation, &sync.UserID, &sync.Login, &status, &sync.Attempts, &sync.NextAttemptAt, &sync.LastError); err != nil {
+			return nil, err
+		}
+		sync.Provider = entity2.CodeHostProvider(provider)
+		sync.Operation = entity2.CodeHostReviewOperation(operation)
+		sync.Status = entity2.CodeHostSyncStatus(status)
+		syncs = append(syncs, &sync)
+	}
+
+	return syncs, rows.Err()
+}
+
+func (r *PostgresRepository) UpdateCodeHostReviewerSync(ctx context.Context, sync *entity2.CodeHostReviewerSync) error {
+	_, err := r.db.ExecContext(ctx,
+		`UPDATE code_host_reviewer_syncs
+		 SET status = $2, attempts = $3, next_attempt_at = $4, last_error = $5
+		 WHERE id = $1`,
+		sync.ID, string(sync.Status), sync.Attempts, sync.NextAttemptAt.UTC(), sync.LastError)
+	return err
+}
+
+// ChatRepository реализация
+func (r *PostgresRepository) SetChatChannel(ctx context.Context, channel *entity2.ChatChannel) error {
+	templates, err := json.Marshal(channel.Templates)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(ctx,
+		`INSERT INTO chat_channels (team_name, webhook_url, templates)
+		 VALUES ($1, $2, $3)
+		 ON CONFLICT (team_name) DO UPDATE SET webhook_url = EXCLUDED.webhook_url, templates = EXCLUDED.templates`,
+		channel.TeamName, channel.WebhookURL, string(templates))
+	return err
+}
+
+func (r *PostgresRepository) GetChatChannel(ctx context.Context, teamName string) (*entity2.ChatChannel, error) {
+	channel := &entity2.ChatChannel{TeamName: teamName}
+	var templates string
+	err := r.db.QueryRowContext(ctx,
+		"SELECT webhook_url, templates FROM chat_channels WHERE team_name = $1",
+		teamName).Scan(&channel.WebhookURL, &templates)
+	if err == sql.ErrNoRows {
+		return nil, entity2.NewDomainError(entity2.ErrorCodeNotFound, "chat channel not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(templates), &channel.Templates); err != nil {
+		return nil, err
+	}
+	return channel, nil
+}
+
+func (r *PostgresRepository) DeleteChatChannel(ctx context.Context, teamName string) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM chat_channels WHERE team_name = $1", teamName)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return entity2.NewDomainError(entity2.ErrorCodeNotFound, "chat channel not found")
+	}
+
+	return nil
+}
+
+func (r *PostgresRepository) CreateChatMessages(ctx context.Context, messages []*entity2.ChatMessage) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, message := range messages {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO chat_messages (dedup_key, team_name, kind, url, payload, status, next_attempt_at)
+			 VALUES ($1, $2, $3, $4, $5, $6, $7)
+			 ON CONFLICT (dedup_key) DO NOTHING`,
+			message.DedupKey, message.TeamName, string(message.Kind), message.URL, string(message.Payload),
+			string(message.Status), message.NextAttemptAt.UTC())
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *PostgresRepository) GetDueChatMessages(ctx context.Context, at time.Time, limit int) ([]*entity2.ChatMessage, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT id, dedup_key, team_name, kind, url, payload, status, attempts, next_attempt_at, last_error
+		 FROM chat_messages
+		 WHERE status = 'pending' AND next_attempt_at <= $1
+		 ORDER BY next_attempt_at, id
+		 LIMIT $2`,
+		at.UTC(), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages := make([]*entity2.ChatMessage, 0)
+	for rows.Next() {
+		var message entity2.ChatMessage
+		var kind, payload, status string
+		if err := rows.Scan(&message.ID, &message.DedupKey, &message.TeamName, &kind, &message.URL, &payload,
+			&status, &message.Attempts, &message.NextAttemptAt, &message.LastError); err != nil {
+			return nil, err
+		}
+		message.Kind = entity2.NotificationKind(kind)
+		message.Payload = []byte(payload)
+		message.Status = entity2.ChatMessageStatus(status)
+		messages = append(messages, &message)
+	}
+
+	return messages, rows.Err()
+}
+
+func (r *PostgresRepository) UpdateChatMessage(ctx context.Context, message *entity2.ChatMessage) error {
+	_, err := r.db.ExecContext(ctx,
+		`UPDATE chat_messages
+		 SET status = $2, attempts = $3, next_attempt_at = $4, last_error = $5
+		 WHERE id = $1`,
+		message.ID, string(message.Status), message.Attempts, message.NextAttemptAt.UTC(), message.LastError)
+	return err
+}
+
+// DigestRepository реализация
+func (r *PostgresRepository) SetDigestSettings(ctx context.Context, settings *entity2.DigestSettings) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO user_digests (user_id, email, enabled)
+		 VALUES ($1, $2, $3)
+		 ON CONFLICT (user_id) DO UPDATE SET email = EXCLUDED.email, enabled = EXCLUDED.enabled`,
+		settings.UserID, settings.Email, settings.Enabled)
+	return err
+}
+
+func (r *PostgresRepository) GetDigestSettings(ctx context.Context, userID string) (*entity2.DigestSettings, error) {
+	settings := &entity2.DigestSettings{UserID: userID}
+	err := r.db.QueryRowContext(ctx,
+		`SELECT email, enabled, COALESCE(TO_CHAR(last_sent_on, 'YYYY-MM-DD'), '')
+		 FROM user_digests WHERE user_id = $1`,
+		userID).Scan(&settings.Email, &settings.Enabled, &settings.LastSentOn)
+	if err == sql.ErrNoRows {
+		return nil, entity2.NewDomainError(entity2.ErrorCodeNotFound, "digest settings not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+func (r *PostgresRepository) GetDigestRecipients(ctx context.Context) ([]*entity2.DigestSettings, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT d.user_id, d.email, d.enabled, COALESCE(TO_CHAR(d.last_sent_on, 'YYYY-MM-DD'), '')
+		 FROM user_digests d
+		 JOIN users u ON u.user_id = d.user_id
+		 WHERE d.enabled AND d.email <> '' AND u.is_active
+		 ORDER BY d.user_id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	recipients := make([]*entity2.DigestSettings, 0)
+	for rows.Next() {
+		var settings entity2.DigestSettings
+		if err := rows.Scan(&settings.UserID, &settings.Email, &settings.Enabled, &settings.LastSentOn); err != nil {
+			return nil, err
+		}
+		recipients = append(recipients, &settings)
+	}
+
+	return recipients, rows.Err()
+}
+
+func (r *PostgresRepository) MarkDigestSent(ctx context.Context, userID, date string) error {
+	_, err := r.db.ExecContext(ctx,
+		"UPDATE user_digests SET last_sent_on = $2::date WHERE user_id = $1",
+		userID, date)
+	return err
+}
+
+// insertOutboxEvents сохраняет события в outbox в транзакции изменения, которое их породило
+func insertOutboxEvents(ctx context.Context, tx *sql.Tx, events []*entity2.Event) error {
+	for _, event := range events {
+		payload, err := json.Marshal(event.Data)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO outbox_events (event_id, event_type, payload, occurred_at)
+			 VALUES ($1, $2, $3, $4)`,
+			event.ID, string(event.Type), string(payload), event.OccurredAt.UTC())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OutboxRepository реализация
+func (r *PostgresRepository) GetPendingOutboxEvents(ctx context.Context, limit int) ([]*entity2.OutboxRecord, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT id, event_id, event_type, payload, occurred_at, attempts, last_error, next_attempt_at, published_at, failed_at
+		 FROM outbox_events
+		 WHERE published_at IS NULL AND failed_at IS NULL
+		 ORDER BY id
+		 LIMIT $1`,
+		limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanOutboxRecords(rows)
+}
+
+func (r *PostgresRepository) GetOutboxEventsAfter(ctx context.Context, afterID int64, limit int) ([]*entity2.OutboxRecord, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT id, event_id, event_type, payload, occurred_at, attempts, last_error, next_attempt_at, published_at, failed_at
+		 FROM outbox_events
+		 WHERE id > $1
+		 ORDER BY id
+		 LIMIT $2`,
+		afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanOutboxRecords(rows)
+}
+
+func (r *PostgresRepository) GetLastOutboxEventID(ctx context.Context) (int64, error) {
+	var id int64
+	err := r.db.QueryRowContext(ctx, "SELECT COALESCE(MAX(id), 0) FROM outbox_events").Scan(&id)
+	return id, err
+}
+
+// scanOutboxRecords читает записи outbox из результата запроса и закрывает его
+func scanOutboxRecords(rows *sql.Rows) ([]*entity2.OutboxRecord, error) {
+	defer rows.Close()
+
+	records := make([]*entity2.OutboxRecord, 0)
+	for rows.Next() {
+		record := &entity2.OutboxRecord{Event: &entity2.Event{}}
+		var eventType, payload string
+		var nextAttemptAt, publishedAt, failedAt sql.NullTime
+		if err := rows.Scan(&record.ID, &record.Event.ID, &eventType, &payload, &record.Event.OccurredAt,
+			&record.Attempts, &record.LastError, &nextAttemptAt, &publishedAt, &failedAt); err != nil {
+			return nil, err
+		}
+		if nextAttemptAt.Valid {
+			record.NextAttemptAt = &nextAttemptAt.Time
+		}
+		if publishedAt.Valid {
+			record.PublishedAt = &publishedAt.Time
+		}
+		if failedAt.Valid {
+			record.FailedAt = &failedAt.Time
+		}
+		record.Event.Type = entity2.EventType(eventType)
+		if err := json.Unmarshal([]byte(payload), &record.Event.Data); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	return records, rows.Err()
+}
+
+func (r *PostgresRepository) UpdateOutboxEvent(ctx context.Context, record *entity2.OutboxRecord) error {
+	_, err := r.db.ExecContext(ctx,
+		`UPDATE outbox_events SET attempts = $2, last_error = $3, next_attempt_at = $4, published_at = $5, failed_at = $6
+		 WHERE id = $1`,
+		record.ID, record.Attempts, record.LastError, utcOrNull(record.NextAttemptAt), utcOrNull(record.PublishedAt), utcOrNull(record.FailedAt))
+	return err
+}
+
+// utcOrNull возвращает время в UTC для записи в колонку TIMESTAMP или NULL
+func utcOrNull(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return t.UTC()
+}
+
+// TryRunExclusive выполняет job под транзакционной advisory-блокировкой Postgres.
+// Транзакция держится открытой, пока выполняется job, и откатывается после него, освобождая блокировку
+// (в том числе при разрыве соединения или отмене контекста).
+func (r *PostgresRepository) TryRunExclusive(ctx context.Context, name string, job func(ctx context.Context) error) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	var locked bool
+	if err := tx.QueryRowContext(ctx, "SELECT pg_try_advisory_xact_lock(hashtext($1))", name).Scan(&locked); err != nil {
+		return false, err
+	}
+	if !locked {
+		return false, nil
+	}
+
+	return true, job(ctx)
 }
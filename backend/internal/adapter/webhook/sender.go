@@ -0,0 +1,55 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"test_task_avito/backend/internal/port"
+	"test_task_avito/backend/pkg/netguard"
+	"time"
+)
+
+var _ port.WebhookSender = (*HTTPSender)(nil)
+
+// defaultTimeout ограничение времени одного запроса к подписчику
+const defaultTimeout = 10 * time.Second
+
+// HTTPSender отправляет вебхуки POST-запросами
+type HTTPSender struct {
+	client *http.Client
+}
+
+// NewHTTPSender создает новый экземпляр HTTPSender. Без allowPrivateTargets запросы к внутренним адресам
+// (loopback, частные сети) отклоняются при подключении.
+func NewHTTPSender(allowPrivateTargets bool) *HTTPSender {
+	client := &http.Client{Timeout: defaultTimeout}
+	if !allowPrivateTargets {
+		client.Transport = netguard.NewTransport()
+	}
+	return &HTTPSender{client: client}
+}
+
+// Send отправляет body на url; ответ вне диапазона 2xx считается ошибкой
+func (s *HTTPSender) Send(ctx context.Context, url string, body []byte, headers map[string]string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
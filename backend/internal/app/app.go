@@ -11,11 +11,20 @@ import (
 	"os/signal"
 	"syscall"
 	"test_task_avito/backend/config"
+	"test_task_avito/backend/internal/adapter/broker"
+	"test_task_avito/backend/internal/adapter/codehost"
+	"test_task_avito/backend/internal/adapter/email"
 	"test_task_avito/backend/internal/adapter/repository/postgres"
+	"test_task_avito/backend/internal/adapter/webhook"
+	"test_task_avito/backend/internal/entity"
 	"test_task_avito/backend/internal/input/http/gen"
 	"test_task_avito/backend/internal/input/http/handler"
+	"test_task_avito/backend/internal/input/http/integration"
+	"test_task_avito/backend/internal/input/http/stream"
+	"test_task_avito/backend/internal/port"
 	usecase2 "test_task_avito/backend/internal/usecase"
 	"test_task_avito/backend/pkg/migration"
+	"test_task_avito/backend/pkg/random"
 	"time"
 
 	"go.uber.org/zap"
@@ -56,13 +65,122 @@ func Start(cfg config.Config, logger *zap.Logger) error {
 	// Создаем репозитории
 	repo := postgres.NewPostgresRepository(db)
 
+	// Общий источник случайных чисел для подбора ревьюверов; фиксированный seed делает назначения воспроизводимыми
+	rnd := random.NewFromTime()
+	if cfg.RandomSeed != 0 {
+		rnd = random.New(cfg.RandomSeed)
+		logger.Info("Using fixed random seed", zap.Int64("seed", cfg.RandomSeed))
+	}
+
 	// Создаем use cases
-	teamUseCase := usecase2.NewTeamUseCase(repo, repo, repo)
-	userUseCase := usecase2.NewUserUseCase(repo, repo)
-	prUseCase := usecase2.NewPullRequestUseCase(repo, repo, repo)
+	webhookUseCase := usecase2.NewWebhookUseCase(repo, webhook.NewHTTPSender(cfg.AllowPrivateWebhookTargets), cfg.AllowPrivateWebhookTargets)
+	teamUseCase := usecase2.NewTeamUseCase(repo, repo, repo, rnd)
+	prUseCase := usecase2.NewPullRequestUseCase(repo, repo, repo, rnd)
+	userUseCase := usecase2.NewUserUseCase(repo, repo, repo, prUseCase, cfg.AvailabilityCalendarPath)
+	codeHostUseCase := usecase2.NewCodeHostUseCase(repo, repo, repo, repo, prUseCase)
+
+	// Ревьюверы передаются только на хостинги, для которых настроен доступ к API
+	codeHostClients := make(map[entity.CodeHostProvider]port.CodeHostClient)
+	if cfg.GitHubToken != "" {
+		codeHostClients[entity.CodeHostGitHub] = codehost.NewGitHubClient(cfg.GitHubAPIURL, cfg.GitHubToken)
+	}
+	codeHostSyncUseCase := usecase2.NewCodeHostSyncUseCase(repo, repo, repo, codeHostClients)
+	notificationUseCase := usecase2.NewNotificationUseCase(repo, repo, repo, repo, prUseCase, webhook.NewHTTPSender(cfg.AllowPrivateWebhookTargets), cfg.AllowPrivateWebhookTargets)
+	mailSender := email.NewSMTPSender(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword, cfg.SMTPFrom)
+	digestUseCase := usecase2.NewDigestUseCase(repo, repo, repo, repo, mailSender, cfg.DigestHour)
+
+	// Фоновые задачи останавливаются вместе с сервером
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+
+	// Переназначаем ревью пользователей, у которых началось окно недоступности
+	go runPeriodically(workersCtx, cfg.AvailabilityCheckInterval, exclusive(repo, logger, "availability-reassign", func(ctx context.Context) error {
+		reassigned, err := userUseCase.ReassignStartedAbsences(ctx)
+		if err != nil {
+			return err
+		}
+		if reassigned > 0 {
+			logger.Info("reassigned reviews of absent users", zap.Int64("reassigned", reassigned))
+		}
+		return nil
+	}))
+
+	// Заменяем простаивающих ревьюверов и эскалируем давно открытые PR
+	go runPeriodically(workersCtx, cfg.StaleReviewCheckInterval, exclusive(repo, logger, "stale-reviews", func(ctx context.Context) error {
+		result, err := prUseCase.ProcessStaleReviews(ctx)
+		if result != nil && (result.Reassigned > 0 || result.Escalated > 0) {
+			logger.Info("processed stale reviews",
+				zap.Int64("reassigned", result.Reassigned), zap.Int64("escalated", result.Escalated))
+		}
+		return err
+	}))
+
+	// Брокер сообщений подключается, только если задан его адрес
+	var brokerUseCase port.BrokerUseCase
+	if cfg.NATSURL != "" {
+		natsBroker, err := broker.NewNATSBroker(cfg.NATSURL, "pr-reviewer-service")
+		if err != nil {
+			logger.Fatal("failed to connect to NATS", zap.Error(err))
+		}
+		defer natsBroker.Close()
+		brokerUseCase = usecase2.NewBrokerUseCase(repo, repo, natsBroker, cfg.NATSSubjectPrefix)
+	}
+
+	// Публикуем события из outbox в настроенные приемники
+	sinks, err := eventSinks(cfg.EventSinks, webhookUseCase, codeHostSyncUseCase, notificationUseCase, brokerUseCase, logger)
+	if err != nil {
+		logger.Fatal("invalid event sinks", zap.Error(err))
+	}
+	relayUseCase := usecase2.NewEventRelayUseCase(repo, sinks...)
+	go runPeriodically(workersCtx, cfg.EventRelayInterval, exclusive(repo, logger, "event-relay", func(ctx context.Context) error {
+		result, err := relayUseCase.RelayPending(ctx)
+		if result != nil && result.Dead > 0 {
+			logger.Warn("outbox events exhausted publish attempts", zap.Int("dead", result.Dead))
+		}
+		return err
+	}))
+
+	// Отправляем вебхуки, время попытки которых наступило
+	go runPeriodically(workersCtx, cfg.WebhookDeliveryInterval, exclusive(repo, logger, "webhook-deliveries", func(ctx context.Context) error {
+		result, err := webhookUseCase.DeliverPending(ctx)
+		if result != nil && result.Dead > 0 {
+			logger.Warn("webhook deliveries exhausted retries", zap.Int("dead", result.Dead))
+		}
+		return err
+	}))
+
+	// Запрашиваем ревью на хостингах кода у назначенных и замененных ревьюверов
+	go runPeriodically(workersCtx, cfg.CodeHostSyncInterval, exclusive(repo, logger, "code-host-sync", func(ctx context.Context) error {
+		result, err := codeHostSyncUseCase.SyncPending(ctx)
+		if result != nil && result.Dead > 0 {
+			logger.Warn("code host reviewer sync exhausted retries", zap.Int("dead", result.Dead))
+		}
+		return err
+	}))
+
+	// Уведомляем ревьюверов о просроченных ревью и отправляем сообщения в чаты команд
+	go runPeriodically(workersCtx, cfg.OverdueNotifyInterval, exclusive(repo, logger, "overdue-notifications", notificationUseCase.NotifyOverdue))
+	go runPeriodically(workersCtx, cfg.ChatDeliveryInterval, exclusive(repo, logger, "chat-messages", func(ctx context.Context) error {
+		result, err := notificationUseCase.DeliverPending(ctx)
+		if result != nil && result.Dead > 0 {
+			logger.Warn("chat messages exhausted retries", zap.Int("dead", result.Dead))
+		}
+		return err
+	}))
+
+	// Отправляем ежедневные сводки ревью; без SMTP-сервера доступен только предпросмотр
+	if cfg.SMTPHost != "" {
+		go runPeriodically(workersCtx, cfg.DigestCheckInterval, exclusive(repo, logger, "daily-digests", func(ctx context.Context) error {
+			result, err := digestUseCase.SendDue(ctx)
+			if result != nil && result.Sent > 0 {
+				logger.Info("sent review digests", zap.Int("sent", result.Sent))
+			}
+			return err
+		}))
+	}
 
 	// Создаем handler
-	h := handler.NewHandler(teamUseCase, userUseCase, prUseCase)
+	h := handler.NewHandler(teamUseCase, userUseCase, prUseCase, webhookUseCase, codeHostUseCase, notificationUseCase, digestUseCase)
 
 	// Создаем strict handler
 	strictHandler := gen.NewStrictHandler(h, nil)
@@ -75,6 +193,18 @@ func Start(cfg config.Config, logger *zap.Logger) error {
 	// Регистрируем routes
 	gen.HandlerFromMux(strictHandler, r)
 
+	// Вебхуки хостингов кода проверяют подпись или токен по сырому запросу, поэтому обрабатываются вне сгенерированного сервера
+	if cfg.GitHubWebhookSecret != "" {
+		r.Post("/integrations/github/webhook", integration.NewGitHubHandler(codeHostUseCase, cfg.GitHubWebhookSecret, logger).ServeHTTP)
+	}
+	if cfg.GitLabWebhookToken != "" {
+		r.Post("/integrations/gitlab/webhook", integration.NewGitLabHandler(codeHostUseCase, cfg.GitLabWebhookToken, logger).ServeHTTP)
+	}
+
+	// Поток событий пишется в ответ частями, поэтому обрабатывается вне сгенерированного сервера
+	eventsHandler := stream.NewEventsHandler(usecase2.NewEventStreamUseCase(repo, repo, repo, repo), cfg.EventStreamPollInterval, logger)
+	r.Get("/events/stream", eventsHandler.ServeHTTP)
+
 	// Добавляем health check
 	r.Get("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -86,6 +216,7 @@ func Start(cfg config.Config, logger *zap.Logger) error {
 		Addr:    cfg.HTTPPort,
 		Handler: r,
 	}
+	srv.RegisterOnShutdown(eventsHandler.Shutdown)
 
 	// Graceful shutdown
 	go func() {
@@ -101,6 +232,7 @@ func Start(cfg config.Config, logger *zap.Logger) error {
 	<-quit
 
 	logger.Info("Shutting down server...")
+	stopWorkers()
 
 	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -114,6 +246,37 @@ func Start(cfg config.Config, logger *zap.Logger) error {
 	return nil
 }
 
+// runPeriodically вызывает job с заданным интервалом до отмены контекста
+func runPeriodically(ctx context.Context, interval time.Duration, job func(ctx context.Context)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		job(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// exclusive оборачивает фоновую задачу блокировкой, чтобы при нескольких репликах сервиса
+// каждый запуск выполнялся только одной из них
+func exclusive(locker port.JobLocker, logger *zap.Logger, name string, job func(ctx context.Context) error) func(ctx context.Context) {
+	return func(ctx context.Context) {
+		ran, err := locker.TryRunExclusive(ctx, name, job)
+		if err != nil {
+			logger.Warn("background job failed", zap.String("job", name), zap.Error(err))
+			return
+		}
+		if !ran {
+			logger.Debug("background job is running on another replica", zap.String("job", name))
+		}
+	}
+}
+
 func loggingMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
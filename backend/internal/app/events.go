@@ -0,0 +1,40 @@
+package app
+
+import (
+	"fmt"
+	"test_task_avito/backend/internal/adapter/eventsink"
+	"test_task_avito/backend/internal/port"
+
+	"go.uber.org/zap"
+)
+
+// eventSinks создает приемники событий outbox по именам из конфигурации
+func eventSinks(names []string, webhooks port.WebhookUseCase, codeHostSync port.CodeHostSyncUseCase, notifications port.NotificationUseCase, broker port.BrokerUseCase, logger *zap.Logger) ([]port.EventPublisher, error) {
+	sinks := make([]port.EventPublisher, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		switch name {
+		case "webhook":
+			sinks = append(sinks, eventsink.NewWebhookSink(webhooks))
+		case "codehost":
+			sinks = append(sinks, eventsink.NewCodeHostSink(codeHostSync))
+		case "chat":
+			sinks = append(sinks, eventsink.NewChatSink(notifications))
+		case "nats":
+			if broker == nil {
+				return nil, fmt.Errorf("event sink %q requires NATS_URL", name)
+			}
+			sinks = append(sinks, eventsink.NewBrokerSink(broker))
+		case "log":
+			sinks = append(sinks, eventsink.NewLogSink(logger))
+		default:
+			return nil, fmt.Errorf("unknown event sink %q", name)
+		}
+	}
+	return sinks, nil
+}
@@ -0,0 +1,31 @@
+package entity
+
+import "time"
+
+// UserAvailability представляет окно недоступности пользователя (отпуск, больничный и т.п.)
+type UserAvailability struct {
+	ID       int64
+	UserID   string
+	StartsAt time.Time
+	EndsAt   time.Time
+	Reason   string
+	// ExternalID идентификатор события во внешнем календаре (пустой для окон, заданных вручную)
+	ExternalID string
+}
+
+// ActiveAt проверяет, попадает ли момент времени в окно недоступности
+func (a *UserAvailability) ActiveAt(t time.Time) bool {
+	return !t.Before(a.StartsAt) && t.Before(a.EndsAt)
+}
+
+// AvailabilityImportResult результат импорта окон недоступности из календаря
+type AvailabilityImportResult struct {
+	// Imported количество созданных или обновленных окон
+	Imported int64
+	// Skipped количество событий, не являющихся отсутствием или уже завершившихся
+	Skipped int64
+	// UnmatchedEvents события отсутствия, для которых не найден пользователь или не удалось определить время
+	UnmatchedEvents []string
+	// ReassignedPRs количество переназначенных открытых PR
+	ReassignedPRs int64
+}
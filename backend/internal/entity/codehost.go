@@ -0,0 +1,179 @@
+package entity
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CodeHostProvider внешний хостинг кода, из которого принимаются события PR
+type CodeHostProvider string
+
+const (
+	CodeHostGitHub CodeHostProvider = "github"
+	CodeHostGitLab CodeHostProvider = "gitlab"
+)
+
+// Valid проверяет, что хостинг поддерживается
+func (p CodeHostProvider) Valid() bool {
+	return p == CodeHostGitHub || p == CodeHostGitLab
+}
+
+// CodeHostAccount связь логина на хостинге кода с пользователем сервиса
+type CodeHostAccount struct {
+	Provider CodeHostProvider
+	// Login логин на хостинге в нижнем регистре
+	Login  string
+	UserID string
+}
+
+// NormalizeCodeHostLogin приводит логин к виду, в котором он хранится: логины хостингов не зависят от регистра
+func NormalizeCodeHostLogin(login string) string {
+	return strings.ToLower(strings.TrimSpace(login))
+}
+
+// CodeHostProject проект (репозиторий) хостинга кода, PR которого назначаются по правилам команды
+type CodeHostProject struct {
+	Provider CodeHostProvider
+	// Repository полное имя репозитория в нижнем регистре
+	Repository string
+	TeamName   string
+}
+
+// NormalizeCodeHostRepository приводит имя репозитория к виду, в котором оно хранится
+func NormalizeCodeHostRepository(repository string) string {
+	return strings.ToLower(strings.Trim(strings.TrimSpace(repository), "/"))
+}
+
+// CodeHostAction действие с PR на хостинге кода, приведенное к операциям сервиса
+type CodeHostAction string
+
+const (
+	// CodeHostActionOpened PR открыт (черновики пропускаются до готовности к ревью)
+	CodeHostActionOpened CodeHostAction = "opened"
+	// CodeHostActionReadyForReview черновик переведен в готовый к ревью
+	CodeHostActionReadyForReview CodeHostAction = "ready_for_review"
+	// CodeHostActionMerged PR слит
+	CodeHostActionMerged CodeHostAction = "merged"
+	// CodeHostActionClosed PR закрыт без слияния
+	CodeHostActionClosed CodeHostAction = "closed"
+	// CodeHostActionReopened закрытый PR снова открыт
+	CodeHostActionReopened CodeHostAction = "reopened"
+)
+
+// CodeHostPullRequestEvent событие PR, полученное от хостинга кода
+type CodeHostPullRequestEvent struct {
+	Provider CodeHostProvider
+	Action   CodeHostAction
+	// Repository полное имя репозитория (owner/name)
+	Repository  string
+	Number      int
+	Title       string
+	AuthorLogin string
+	Draft       bool
+}
+
+// PullRequestID идентификатор PR в сервисе: <репозиторий>#<номер> для GitHub и <проект>!<номер> для GitLab,
+// как ссылки на PR в самих хостингах
+func (e *CodeHostPullRequestEvent) PullRequestID() string {
+	separator := "#"
+	if e.Provider == CodeHostGitLab {
+		separator = "!"
+	}
+	return e.Repository + separator + strconv.Itoa(e.Number)
+}
+
+// ParseCodeHostPullRequestID разбирает ID PR, созданного по событию хостинга кода, на хостинг, репозиторий и номер.
+// Для остальных ID возвращает ok = false.
+func ParseCodeHostPullRequestID(prID string) (provider CodeHostProvider, repository string, number int, ok bool) {
+	i := strings.LastIndexAny(prID, "#!")
+	if i <= 0 {
+		return "", "", 0, false
+	}
+	number, err := strconv.Atoi(prID[i+1:])
+	if err != nil || number <= 0 {
+		return "", "", 0, false
+	}
+	repository = prID[:i]
+	if !strings.Contains(repository, "/") {
+		return "", "", 0, false
+	}
+
+	provider = CodeHostGitHub
+	if prID[i] == '!' {
+		provider = CodeHostGitLab
+	}
+	return provider, repository, number, true
+}
+
+// CodeHostEventOutcome результат обработки события хостинга кода
+type CodeHostEventOutcome string
+
+const (
+	CodeHostEventCreated  CodeHostEventOutcome = "created"
+	CodeHostEventMerged   CodeHostEventOutcome = "merged"
+	CodeHostEventClosed   CodeHostEventOutcome = "closed"
+	CodeHostEventReopened CodeHostEventOutcome = "reopened"
+	// CodeHostEventIgnored событие не меняет состояние сервиса (черновик, неизвестный автор, повтор)
+	CodeHostEventIgnored CodeHostEventOutcome = "ignored"
+)
+
+// CodeHostEventResult итог обработки события хостинга кода
+type CodeHostEventResult struct {
+	Outcome       CodeHostEventOutcome
+	PullRequestID string
+	// Reason причина, по которой событие пропущено
+	Reason string
+}
+
+// CodeHostReviewOperation изменение запросов ревью в PR на хостинге кода
+type CodeHostReviewOperation string
+
+const (
+	// CodeHostReviewRequest запросить ревью у пользователя
+	CodeHostReviewRequest CodeHostReviewOperation = "request"
+	// CodeHostReviewRemove снять запрос ревью с пользователя
+	CodeHostReviewRemove CodeHostReviewOperation = "remove"
+)
+
+// CodeHostSyncStatus состояние передачи изменения ревьюверов на хостинг кода
+type CodeHostSyncStatus string
+
+const (
+	// CodeHostSyncPending изменение ожидает очередной попытки
+	CodeHostSyncPending CodeHostSyncStatus = "pending"
+	// CodeHostSyncDone изменение применено на хостинге или больше не актуально
+	CodeHostSyncDone CodeHostSyncStatus = "done"
+	// CodeHostSyncDead попытки исчерпаны
+	CodeHostSyncDead CodeHostSyncStatus = "dead"
+)
+
+// CodeHostReviewerSync изменение ревьюверов PR сервиса, которое нужно повторить на хостинге кода
+type CodeHostReviewerSync struct {
+	ID int64
+	// EventID доменное событие, породившее изменение
+	EventID       string
+	PullRequestID string
+	Provider      CodeHostProvider
+	Repository    string
+	Number        int
+	Operation     CodeHostReviewOperation
+	UserID        string
+	// Login логин пользователя на хостинге на момент события
+	Login         string
+	Status        CodeHostSyncStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+}
+
+// CodeHostSyncResult итог одного прохода передачи изменений ревьюверов на хостинг кода
+type CodeHostSyncResult struct {
+	Done int
+	// Skipped изменения, ставшие неактуальными (ревьювер снова заменен, PR закрыт или удален)
+	Skipped int
+	// Retried изменения, отложенные до следующей попытки
+	Retried int
+	// Dead изменения, исчерпавшие попытки
+	Dead int
+}
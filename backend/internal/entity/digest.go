@@ -0,0 +1,55 @@
+package entity
+
+import "time"
+
+// DigestSettings настройки ежедневной сводки ревью пользователя
+type DigestSettings struct {
+	UserID string
+	// Email адрес для сводки (пустой — сводка не отправляется)
+	Email string
+	// Enabled false — пользователь отказался от сводки
+	Enabled bool
+	// LastSentOn местная дата последней сводки в формате HolidayDateLayout (пустая — сводка не отправлялась)
+	LastSentOn string
+}
+
+// DigestSettingsUpdate частичное обновление настроек сводки (nil — значение не меняется)
+type DigestSettingsUpdate struct {
+	// Email пустая строка удаляет адрес
+	Email   *string
+	Enabled *bool
+}
+
+// Apply применяет обновление к настройкам
+func (s *DigestSettings) Apply(update DigestSettingsUpdate) {
+	if update.Email != nil {
+		s.Email = *update.Email
+	}
+	if update.Enabled != nil {
+		s.Enabled = *update.Enabled
+	}
+}
+
+// Digest сводка открытых ревью пользователя
+type Digest struct {
+	UserID string
+	Email  string
+	// Date местная дата сводки по часовому поясу пользователя в формате HolidayDateLayout
+	Date        string
+	GeneratedAt time.Time
+	// Reviews назначения в открытых PR: сначала просроченные, затем по времени назначения
+	Reviews []*ReviewAssignment
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// DigestResult результат прохода отправки сводок
+type DigestResult struct {
+	// Sent отправленные сводки
+	Sent int
+	// Skipped сводки без открытых ревью, которые не отправлялись
+	Skipped int
+	// Failed сводки, которые не удалось отправить; повторяются при следующем проходе в тот же день
+	Failed int
+}
@@ -4,12 +4,15 @@ package entity
 type ErrorCode string
 
 const (
-	ErrorCodeTeamExists  ErrorCode = "TEAM_EXISTS"
-	ErrorCodePRExists    ErrorCode = "PR_EXISTS"
-	ErrorCodePRMerged    ErrorCode = "PR_MERGED"
-	ErrorCodeNotAssigned ErrorCode = "NOT_ASSIGNED"
-	ErrorCodeNoCandidate ErrorCode = "NO_CANDIDATE"
-	ErrorCodeNotFound    ErrorCode = "NOT_FOUND"
+	ErrorCodeTeamExists      ErrorCode = "TEAM_EXISTS"
+	ErrorCodePRExists        ErrorCode = "PR_EXISTS"
+	ErrorCodePRMerged        ErrorCode = "PR_MERGED"
+	ErrorCodePRClosed        ErrorCode = "PR_CLOSED"
+	ErrorCodeNotAssigned     ErrorCode = "NOT_ASSIGNED"
+	ErrorCodeAlreadyAssigned ErrorCode = "ALREADY_ASSIGNED"
+	ErrorCodeNoCandidate     ErrorCode = "NO_CANDIDATE"
+	ErrorCodeNotFound        ErrorCode = "NOT_FOUND"
+	ErrorCodeInvalidInput    ErrorCode = "INVALID_INPUT"
 )
 
 // DomainError представляет доменную ошибку
@@ -0,0 +1,65 @@
+package entity
+
+import "time"
+
+// EventType тип доменного события
+type EventType string
+
+const (
+	// EventPullRequestCreated PR создан и ревьюверы назначены
+	EventPullRequestCreated EventType = "pr.created"
+	// EventReviewerAssigned пользователь назначен ревьювером PR (при создании, вручную или эскалацией)
+	EventReviewerAssigned EventType = "reviewer.assigned"
+	// EventReviewerReassigned ревьювер PR заменен другим
+	EventReviewerReassigned EventType = "reviewer.reassigned"
+	// EventReviewerRemoved ревьювер снят с PR вручную без замены
+	EventReviewerRemoved EventType = "reviewer.removed"
+	// EventPullRequestMerged PR переведен в MERGED
+	EventPullRequestMerged EventType = "pr.merged"
+	// EventPullRequestClosed PR закрыт без слияния
+	EventPullRequestClosed EventType = "pr.closed"
+	// EventPullRequestReopened закрытый PR снова открыт
+	EventPullRequestReopened EventType = "pr.reopened"
+	// EventTeamDeactivated участники команды массово деактивированы
+	EventTeamDeactivated EventType = "team.deactivated"
+)
+
+// EventTypes все типы доменных событий
+var EventTypes = []EventType{
+	EventPullRequestCreated,
+	EventReviewerAssigned,
+	EventReviewerReassigned,
+	EventReviewerRemoved,
+	EventPullRequestMerged,
+	EventPullRequestClosed,
+	EventPullRequestReopened,
+	EventTeamDeactivated,
+}
+
+// Valid проверяет, что тип события известен
+func (t EventType) Valid() bool {
+	for _, known := range EventTypes {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Event доменное событие
+type Event struct {
+	// ID уникальный идентификатор события, по нему получатели отбрасывают повторы
+	ID         string
+	Type       EventType
+	OccurredAt time.Time
+	// Data содержимое события; ключи в snake_case, как в API
+	Data map[string]interface{}
+}
+
+// EventFilter отбор событий потока (пустое поле — без отбора)
+type EventFilter struct {
+	// TeamName события, затрагивающие команду или ее участников
+	TeamName string
+	// UserID события, затрагивающие пользователя как автора или ревьювера PR
+	UserID string
+}
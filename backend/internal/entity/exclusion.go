@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+// ReviewerExclusion запрет назначать двух пользователей ревьюверами PR друг друга.
+// Запрет симметричен: UserID — пользователь, с точки зрения которого он рассматривается.
+type ReviewerExclusion struct {
+	UserID         string
+	ExcludedUserID string
+	Reason         string
+	CreatedAt      time.Time
+}
@@ -0,0 +1,45 @@
+package entity
+
+// UserLevel уровень (грейд) пользователя
+type UserLevel string
+
+const (
+	// UserLevelJunior младший разработчик
+	UserLevelJunior UserLevel = "junior"
+	// UserLevelMiddle разработчик (уровень по умолчанию)
+	UserLevelMiddle UserLevel = "middle"
+	// UserLevelSenior старший разработчик
+	UserLevelSenior UserLevel = "senior"
+	// UserLevelLead ведущий разработчик
+	UserLevelLead UserLevel = "lead"
+)
+
+// userLevelRanks порядок уровней от младшего к старшему
+var userLevelRanks = map[UserLevel]int{
+	UserLevelJunior: 1,
+	UserLevelMiddle: 2,
+	UserLevelSenior: 3,
+	UserLevelLead:   4,
+}
+
+// Valid проверяет, что уровень известен
+func (l UserLevel) Valid() bool {
+	_, ok := userLevelRanks[l]
+	return ok
+}
+
+// AtLeast проверяет, что уровень не ниже min
+func (l UserLevel) AtLeast(min UserLevel) bool {
+	return userLevelRanks[l] >= userLevelRanks[min]
+}
+
+// RequiredReviewerLevel возвращает уровень, которого должен достигать хотя бы один ревьювер PR
+// автора authorLevel при минимальном уровне команды teamMin (пусто — правило не действует).
+// PR джуниора всегда требует ревьювера не ниже middle.
+func RequiredReviewerLevel(authorLevel, teamMin UserLevel) UserLevel {
+	required := teamMin
+	if authorLevel == UserLevelJunior && !required.AtLeast(UserLevelMiddle) {
+		required = UserLevelMiddle
+	}
+	return required
+}
@@ -0,0 +1,89 @@
+package entity
+
+import "time"
+
+// NotificationKind повод уведомления ревьювера в чате
+type NotificationKind string
+
+const (
+	// NotificationAssigned ревьювер назначен на PR
+	NotificationAssigned NotificationKind = "assigned"
+	// NotificationReassigned ревьювер назначен вместо другого
+	NotificationReassigned NotificationKind = "reassigned"
+	// NotificationOverdue срок ревью по SLA истек
+	NotificationOverdue NotificationKind = "overdue"
+)
+
+// NotificationKinds все поводы уведомлений
+var NotificationKinds = []NotificationKind{NotificationAssigned, NotificationReassigned, NotificationOverdue}
+
+// Valid проверяет, что повод уведомления известен
+func (k NotificationKind) Valid() bool {
+	for _, kind := range NotificationKinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// ChatChannel канал команды для уведомлений ревьюверов (входящий вебхук, совместимый со Slack)
+type ChatChannel struct {
+	TeamName   string
+	WebhookURL string
+	// Templates шаблоны сообщений text/template по поводам; для поводов без шаблона используется шаблон по умолчанию
+	Templates map[NotificationKind]string
+}
+
+// NotificationData значения, доступные в шаблонах сообщений
+type NotificationData struct {
+	PullRequestID   string
+	PullRequestName string
+	// Author имя автора PR
+	Author string
+	// Reviewer имя уведомляемого ревьювера
+	Reviewer string
+	// PreviousReviewer имя замененного ревьювера (только для reassigned)
+	PreviousReviewer string
+	// Reviewers имена всех ревьюверов PR
+	Reviewers []string
+	// DueAt срок ревью по SLA (только для overdue)
+	DueAt *time.Time
+}
+
+// ChatMessageStatus состояние отправки сообщения в чат
+type ChatMessageStatus string
+
+const (
+	// ChatMessagePending сообщение ожидает очередной попытки
+	ChatMessagePending ChatMessageStatus = "pending"
+	// ChatMessageSent чат принял сообщение
+	ChatMessageSent ChatMessageStatus = "sent"
+	// ChatMessageDead попытки исчерпаны
+	ChatMessageDead ChatMessageStatus = "dead"
+)
+
+// ChatMessage сообщение в очереди отправки в чат
+type ChatMessage struct {
+	ID int64
+	// DedupKey ключ уведомления; повторное уведомление с тем же ключом не ставится в очередь
+	DedupKey string
+	TeamName string
+	Kind     NotificationKind
+	URL      string
+	// Payload тело запроса (JSON)
+	Payload       []byte
+	Status        ChatMessageStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+}
+
+// ChatDeliveryResult итог одного прохода отправки сообщений в чат
+type ChatDeliveryResult struct {
+	Sent int
+	// Retried сообщения, отложенные до следующей попытки
+	Retried int
+	// Dead сообщения, исчерпавшие попытки
+	Dead int
+}
@@ -0,0 +1,25 @@
+package entity
+
+import "time"
+
+// OutboxRecord доменное событие, сохраненное в outbox в одной транзакции с изменением, которое его породило
+type OutboxRecord struct {
+	// ID порядковый номер записи; события публикуются в порядке возрастания
+	ID    int64
+	Event *Event
+	// Attempts количество неудачных попыток публикации
+	Attempts  int
+	LastError string
+	// NextAttemptAt время следующей попытки после неудачной (nil — публикуется сразу)
+	NextAttemptAt *time.Time
+	PublishedAt   *time.Time
+	// FailedAt время, когда событие исчерпало попытки публикации и было пропущено (nil — не пропущено)
+	FailedAt *time.Time
+}
+
+// OutboxRelayResult итог одного прохода публикации событий outbox
+type OutboxRelayResult struct {
+	Published int
+	// Dead события, исчерпавшие попытки публикации
+	Dead int
+}
@@ -8,6 +8,8 @@ type PullRequestStatus string
 const (
 	PullRequestStatusOpen   PullRequestStatus = "OPEN"
 	PullRequestStatusMerged PullRequestStatus = "MERGED"
+	// PullRequestStatusClosed PR закрыт без слияния; может быть переоткрыт
+	PullRequestStatusClosed PullRequestStatus = "CLOSED"
 )
 
 // PullRequest представляет Pull Request
@@ -15,9 +17,57 @@ type PullRequest struct {
 	PullRequestID     string
 	PullRequestName   string
 	AuthorID          string
+	TeamName          string            // команда, по правилам которой выбираются ревьюверы (пусто — команда автора)
 	Status            PullRequestStatus
-	AssignedReviewers []string // user_id назначенных ревьюверов (0..2)
+	AssignedReviewers []string          // user_id назначенных ревьюверов (0..2)
+	RequiredTags      []string          // навыки, требуемые для ревью
+	Declines          []ReviewDecline   // отказы ревьюверов от ревью
+	Escalation        *ReviewEscalation // эскалация зависшего ревью (nil — не было)
 	CreatedAt         *time.Time
 	MergedAt          *time.Time
 }
 
+// DeclinedBy проверяет, отказывался ли пользователь от ревью этого PR
+func (pr *PullRequest) DeclinedBy(userID string) bool {
+	for _, decline := range pr.Declines {
+		if decline.UserID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// ReviewDecline отказ ревьювера от ревью PR
+type ReviewDecline struct {
+	UserID     string
+	Reason     string
+	DeclinedAt time.Time
+}
+
+// ReviewEscalation добавление тимлида команды автора в ревью PR, которое долго остается открытым
+type ReviewEscalation struct {
+	UserID      string
+	EscalatedAt time.Time
+}
+
+// StaleReviewResult итог обработки зависших ревью
+type StaleReviewResult struct {
+	// Reassigned количество ревьюверов, замененных после простоя
+	Reassigned int64
+	// Escalated количество PR, в которые добавлен тимлид
+	Escalated int64
+}
+
+// CreatePullRequestInput параметры создания PR
+type CreatePullRequestInput struct {
+	PullRequestID   string
+	PullRequestName string
+	AuthorID        string
+	// ChangedFiles пути измененных файлов; по ним в первую очередь выбираются владельцы кода (CODEOWNERS)
+	ChangedFiles []string
+	// RequiredTags навыки, каждый из которых должен быть покрыт хотя бы одним ревьювером
+	RequiredTags []string
+	// TeamName команда, по правилам которой выбираются ревьюверы; пусто — команда автора
+	TeamName string
+}
+
@@ -0,0 +1,27 @@
+package entity
+
+import "time"
+
+// ReviewAssignment назначение ревьювера на PR
+type ReviewAssignment struct {
+	PullRequestID   string
+	PullRequestName string
+	AuthorID        string
+	// TeamName команда, по правилам которой выбирались ревьюверы PR; ее SLA действует для PR
+	TeamName   string
+	Status     PullRequestStatus
+	ReviewerID string
+	AssignedAt time.Time
+	// Escalated ревьювер добавлен эскалацией зависшего PR
+	Escalated bool
+	// DueAt срок ревью по SLA команды PR (nil — SLA не задан)
+	DueAt *time.Time
+	// Overdue PR все еще открыт, а срок ревью истек
+	Overdue bool
+}
+
+// SetDueAt устанавливает срок ревью и отмечает просрочку на момент now
+func (a *ReviewAssignment) SetDueAt(dueAt, now time.Time) {
+	a.DueAt = &dueAt
+	a.Overdue = a.Status == PullRequestStatusOpen && now.After(dueAt)
+}
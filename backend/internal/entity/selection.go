@@ -0,0 +1,22 @@
+package entity
+
+import "strings"
+
+// CandidateRejection причина, по которой кандидат не может быть назначен ревьювером
+type CandidateRejection struct {
+	UserID string
+	Reason string
+}
+
+// NewNoCandidateError формирует ошибку NO_CANDIDATE с перечислением отклоненных кандидатов
+func NewNoCandidateError(message string, rejections []CandidateRejection) *DomainError {
+	if len(rejections) == 0 {
+		return NewDomainError(ErrorCodeNoCandidate, message)
+	}
+
+	reasons := make([]string, 0, len(rejections))
+	for _, rejection := range rejections {
+		reasons = append(reasons, rejection.UserID+": "+rejection.Reason)
+	}
+	return NewDomainError(ErrorCodeNoCandidate, message+" (rejected "+strings.Join(reasons, "; ")+")")
+}
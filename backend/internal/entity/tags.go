@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"sort"
+	"strings"
+)
+
+// maxTagLength ограничение длины тега навыка
+const maxTagLength = 64
+
+// NormalizeTags приводит теги навыков к нижнему регистру, убирает пробелы и дубликаты
+func NormalizeTags(tags []string) ([]string, error) {
+	seen := make(map[string]struct{}, len(tags))
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			return nil, NewDomainError(ErrorCodeInvalidInput, "tag must not be empty")
+		}
+		if len(tag) > maxTagLength {
+			return nil, NewDomainError(ErrorCodeInvalidInput, "tag is too long: "+tag)
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		normalized = append(normalized, tag)
+	}
+	sort.Strings(normalized)
+	return normalized, nil
+}
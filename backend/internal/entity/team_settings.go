@@ -0,0 +1,123 @@
+package entity
+
+import "fmt"
+
+// SelectionMode режим случайного выбора ревьюверов
+type SelectionMode string
+
+const (
+	// SelectionModeRandom равновероятный выбор
+	SelectionModeRandom SelectionMode = "random"
+	// SelectionModeWeighted выбор с вероятностью, пропорциональной весу ревьювера
+	SelectionModeWeighted SelectionMode = "weighted"
+)
+
+// Valid проверяет, что режим выбора известен
+func (m SelectionMode) Valid() bool {
+	return m == SelectionModeRandom || m == SelectionModeWeighted
+}
+
+// DefaultPairHistoryWindow количество последних PR автора, в которых учитываются повторные пары автор–ревьювер
+const DefaultPairHistoryWindow = 10
+
+// maxPairHistoryWindow ограничивает окно, чтобы подбор не сканировал всю историю автора
+const maxPairHistoryWindow = 1000
+
+// maxReviewSLAHours ограничивает SLA ревью одним рабочим годом
+const maxReviewSLAHours = 24 * 260
+
+// TeamSettings настройки подбора ревьюверов команды
+type TeamSettings struct {
+	TeamName string
+	// DefaultMaxOpenReviews лимит открытых ревью для участников без собственного лимита (0 — без ограничения)
+	DefaultMaxOpenReviews int
+	// SelectionMode режим выбора ревьюверов среди подходящих кандидатов
+	SelectionMode SelectionMode
+	// RequiredReviewerTeam команда-партнер, из которой в каждый PR назначается один ревьювер (пусто — правило не действует)
+	RequiredReviewerTeam string
+	// MinReviewerLevel уровень, которого должен достигать хотя бы один ревьювер каждого PR (пусто — правило не действует)
+	MinReviewerLevel UserLevel
+	// PairHistoryWindow количество последних PR автора, ревьюверы которых получают пониженный шанс назначения (0 — не учитывается)
+	PairHistoryWindow int
+	// ReviewSLAHours срок первого ревью в рабочих часах с момента назначения ревьювера (0 — SLA не задан)
+	ReviewSLAHours int
+	// StaleReassignHours простой ревьювера в рабочих часах с момента назначения, после которого он автоматически заменяется (0 — не заменяется)
+	StaleReassignHours int
+	// StaleEscalationHours возраст открытого PR в рабочих часах, после которого в ревью добавляется тимлид команды автора (0 — без эскалации)
+	StaleEscalationHours int
+	// PreferWorkingHours при подборе сначала выбираются ревьюверы, у которых сейчас рабочее время
+	PreferWorkingHours bool
+}
+
+// TeamSettingsUpdate частичное обновление настроек команды (nil — значение не меняется)
+type TeamSettingsUpdate struct {
+	DefaultMaxOpenReviews *int
+	SelectionMode         *SelectionMode
+	RequiredReviewerTeam  *string
+	MinReviewerLevel      *UserLevel
+	PairHistoryWindow     *int
+	ReviewSLAHours        *int
+	StaleReassignHours    *int
+	StaleEscalationHours  *int
+	PreferWorkingHours    *bool
+}
+
+// Apply применяет обновление к настройкам
+func (s *TeamSettings) Apply(update TeamSettingsUpdate) {
+	if update.DefaultMaxOpenReviews != nil {
+		s.DefaultMaxOpenReviews = *update.DefaultMaxOpenReviews
+	}
+	if update.SelectionMode != nil {
+		s.SelectionMode = *update.SelectionMode
+	}
+	if update.RequiredReviewerTeam != nil {
+		s.RequiredReviewerTeam = *update.RequiredReviewerTeam
+	}
+	if update.MinReviewerLevel != nil {
+		s.MinReviewerLevel = *update.MinReviewerLevel
+	}
+	if update.PairHistoryWindow != nil {
+		s.PairHistoryWindow = *update.PairHistoryWindow
+	}
+	if update.ReviewSLAHours != nil {
+		s.ReviewSLAHours = *update.ReviewSLAHours
+	}
+	if update.StaleReassignHours != nil {
+		s.StaleReassignHours = *update.StaleReassignHours
+	}
+	if update.StaleEscalationHours != nil {
+		s.StaleEscalationHours = *update.StaleEscalationHours
+	}
+	if update.PreferWorkingHours != nil {
+		s.PreferWorkingHours = *update.PreferWorkingHours
+	}
+}
+
+// Validate проверяет корректность настроек
+func (s *TeamSettings) Validate() error {
+	if s.DefaultMaxOpenReviews < 0 {
+		return NewDomainError(ErrorCodeInvalidInput, "default_max_open_reviews must not be negative")
+	}
+	if !s.SelectionMode.Valid() {
+		return NewDomainError(ErrorCodeInvalidInput, "unknown selection_mode")
+	}
+	if s.RequiredReviewerTeam == s.TeamName {
+		return NewDomainError(ErrorCodeInvalidInput, "required_reviewer_team must differ from the team itself")
+	}
+	if s.MinReviewerLevel != "" && !s.MinReviewerLevel.Valid() {
+		return NewDomainError(ErrorCodeInvalidInput, "unknown min_reviewer_level")
+	}
+	if s.PairHistoryWindow < 0 || s.PairHistoryWindow > maxPairHistoryWindow {
+		return NewDomainError(ErrorCodeInvalidInput, fmt.Sprintf("pair_history_window must be between 0 and %d", maxPairHistoryWindow))
+	}
+	if s.ReviewSLAHours < 0 || s.ReviewSLAHours > maxReviewSLAHours {
+		return NewDomainError(ErrorCodeInvalidInput, fmt.Sprintf("review_sla_hours must be between 0 and %d", maxReviewSLAHours))
+	}
+	if s.StaleReassignHours < 0 || s.StaleReassignHours > maxReviewSLAHours {
+		return NewDomainError(ErrorCodeInvalidInput, fmt.Sprintf("stale_reassign_hours must be between 0 and %d", maxReviewSLAHours))
+	}
+	if s.StaleEscalationHours < 0 || s.StaleEscalationHours > maxReviewSLAHours {
+		return NewDomainError(ErrorCodeInvalidInput, fmt.Sprintf("stale_escalation_hours must be between 0 and %d", maxReviewSLAHours))
+	}
+	return nil
+}
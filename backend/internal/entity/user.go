@@ -6,5 +6,13 @@ type User struct {
 	Username string
 	TeamName string
 	IsActive bool
+	// MaxOpenReviews лимит одновременно открытых ревью (nil — действует значение по умолчанию команды)
+	MaxOpenReviews *int
+	// ReviewWeight относительная доля назначений при взвешенном выборе (1 — обычная нагрузка)
+	ReviewWeight float64
+	// Level уровень пользователя
+	Level UserLevel
+	// Schedule рабочий график (nil — все часы будних дней по UTC)
+	Schedule *WorkSchedule
 }
 
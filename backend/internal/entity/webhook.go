@@ -0,0 +1,63 @@
+package entity
+
+import "time"
+
+// WebhookSubscription подписка внешнего сервиса на доменные события
+type WebhookSubscription struct {
+	ID     int64
+	URL    string
+	Secret string
+	// EventTypes типы событий, которые доставляются подписчику
+	EventTypes []EventType
+	CreatedAt  time.Time
+}
+
+// Subscribed проверяет, подписан ли получатель на события типа eventType
+func (s *WebhookSubscription) Subscribed(eventType EventType) bool {
+	for _, t := range s.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDeliveryStatus состояние доставки события подписчику
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending доставка ожидает очередной попытки
+	WebhookDeliveryPending WebhookDeliveryStatus = "pending"
+	// WebhookDeliveryDelivered подписчик принял событие
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	// WebhookDeliveryDead попытки исчерпаны, доставка в очереди недоставленных
+	WebhookDeliveryDead WebhookDeliveryStatus = "dead"
+)
+
+// WebhookDelivery доставка одного события одному подписчику
+type WebhookDelivery struct {
+	ID             int64
+	SubscriptionID int64
+	// URL и Secret подписки на момент выборки доставки
+	URL       string
+	Secret    string
+	EventID   string
+	EventType EventType
+	// Payload тело запроса (JSON)
+	Payload       []byte
+	Status        WebhookDeliveryStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	CreatedAt     time.Time
+	DeliveredAt   *time.Time
+}
+
+// WebhookDeliveryResult итог одного прохода доставки вебхуков
+type WebhookDeliveryResult struct {
+	Delivered int
+	// Retried доставки, отложенные до следующей попытки
+	Retried int
+	// Dead доставки, исчерпавшие попытки
+	Dead int
+}
@@ -0,0 +1,101 @@
+package entity
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"test_task_avito/backend/pkg/worktime"
+	"time"
+)
+
+// WorkSchedule рабочий график пользователя: рабочие дни — понедельник–пятница
+type WorkSchedule struct {
+	// Timezone часовой пояс IANA, например Europe/Moscow
+	Timezone string
+	// WorkStart и WorkEnd начало и конец рабочего дня в минутах от местной полуночи
+	WorkStart int
+	WorkEnd   int
+}
+
+// NewWorkSchedule создает график из часового пояса IANA и времени начала и конца рабочего дня в формате HH:MM
+// (конец дня может быть 24:00)
+func NewWorkSchedule(timezone, workStart, workEnd string) (*WorkSchedule, error) {
+	if _, err := time.LoadLocation(timezone); err != nil || timezone == "" || strings.EqualFold(timezone, "Local") {
+		return nil, NewDomainError(ErrorCodeInvalidInput, "unknown timezone")
+	}
+
+	start, err := parseClock(workStart)
+	if err != nil {
+		return nil, NewDomainError(ErrorCodeInvalidInput, "work_start must be in HH:MM format")
+	}
+	end, err := parseClock(workEnd)
+	if err != nil {
+		return nil, NewDomainError(ErrorCodeInvalidInput, "work_end must be in HH:MM format")
+	}
+	if start >= end {
+		return nil, NewDomainError(ErrorCodeInvalidInput, "work_end must be after work_start")
+	}
+
+	return &WorkSchedule{Timezone: timezone, WorkStart: start, WorkEnd: end}, nil
+}
+
+// Location возвращает часовой пояс графика
+func (s *WorkSchedule) Location() (*time.Location, error) {
+	return time.LoadLocation(s.Timezone)
+}
+
+// FormatClock форматирует минуты от полуночи как HH:MM
+func FormatClock(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+func parseClock(value string) (int, error) {
+	if len(value) != 5 || value[2] != ':' || strings.ContainsAny(value, "+-") {
+		return 0, fmt.Errorf("invalid clock %q", value)
+	}
+	hours, errHours := strconv.Atoi(value[:2])
+	minutes, errMinutes := strconv.Atoi(value[3:])
+	if errHours != nil || errMinutes != nil || minutes > 59 {
+		return 0, fmt.Errorf("invalid clock %q", value)
+	}
+	total := hours*60 + minutes
+	// Конец рабочего дня может быть 24:00
+	if total > worktime.MinutesPerDay {
+		return 0, fmt.Errorf("invalid clock %q", value)
+	}
+	return total, nil
+}
+
+// TeamHoliday нерабочий день команды
+type TeamHoliday struct {
+	// Date дата в формате HolidayDateLayout
+	Date string
+	Name string
+}
+
+// HolidayDateLayout формат даты праздника
+const HolidayDateLayout = "2006-01-02"
+
+// NormalizeHolidays проверяет даты праздников, отбрасывает повторы и сортирует по дате
+func NormalizeHolidays(holidays []TeamHoliday) ([]TeamHoliday, error) {
+	byDate := make(map[string]TeamHoliday, len(holidays))
+	for _, holiday := range holidays {
+		date, err := time.Parse(HolidayDateLayout, holiday.Date)
+		if err != nil {
+			return nil, NewDomainError(ErrorCodeInvalidInput, fmt.Sprintf("invalid holiday date %q, expected YYYY-MM-DD", holiday.Date))
+		}
+		holiday.Date = date.Format(HolidayDateLayout)
+		holiday.Name = strings.TrimSpace(holiday.Name)
+		byDate[holiday.Date] = holiday
+	}
+
+	normalized := make([]TeamHoliday, 0, len(byDate))
+	for _, holiday := range byDate {
+		normalized = append(normalized, holiday)
+	}
+	sort.Slice(normalized, func(i, j int) bool {
+		return normalized[i].Date < normalized[j].Date
+	})
+	return normalized, nil
+}
@@ -16,15 +16,54 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Предпросмотр ежедневной сводки ревью пользователя
+	// (GET /digest/preview)
+	GetDigestPreview(w http.ResponseWriter, r *http.Request, params GetDigestPreviewParams)
+	// Получить связи логинов хостинга кода с пользователями
+	// (GET /integrations/accounts/list)
+	GetIntegrationsAccountsList(w http.ResponseWriter, r *http.Request, params GetIntegrationsAccountsListParams)
+	// Удалить связь логина на хостинге кода
+	// (POST /integrations/accounts/remove)
+	PostIntegrationsAccountsRemove(w http.ResponseWriter, r *http.Request)
+	// Связать логин на хостинге кода с пользователем
+	// (POST /integrations/accounts/set)
+	PostIntegrationsAccountsSet(w http.ResponseWriter, r *http.Request)
+	// Получить проекты хостинга кода с назначенными командами
+	// (GET /integrations/projects/list)
+	GetIntegrationsProjectsList(w http.ResponseWriter, r *http.Request, params GetIntegrationsProjectsListParams)
+	// Удалить команду проекта хостинга кода
+	// (POST /integrations/projects/remove)
+	PostIntegrationsProjectsRemove(w http.ResponseWriter, r *http.Request)
+	// Назначить проекту хостинга кода команду
+	// (POST /integrations/projects/set)
+	PostIntegrationsProjectsSet(w http.ResponseWriter, r *http.Request)
+	// Вручную назначить ревьювера PR
+	// (POST /pullRequest/addReviewer)
+	PostPullRequestAddReviewer(w http.ResponseWriter, r *http.Request)
+	// Закрыть PR без слияния (идемпотентная операция)
+	// (POST /pullRequest/close)
+	PostPullRequestClose(w http.ResponseWriter, r *http.Request)
 	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
 	// (POST /pullRequest/create)
 	PostPullRequestCreate(w http.ResponseWriter, r *http.Request)
+	// Отказаться от ревью PR с указанием причины
+	// (POST /pullRequest/decline)
+	PostPullRequestDecline(w http.ResponseWriter, r *http.Request)
 	// Пометить PR как MERGED (идемпотентная операция)
 	// (POST /pullRequest/merge)
 	PostPullRequestMerge(w http.ResponseWriter, r *http.Request)
+	// Получить ревью открытых PR, нарушившие SLA
+	// (GET /pullRequest/overdue)
+	GetPullRequestOverdue(w http.ResponseWriter, r *http.Request)
 	// Переназначить конкретного ревьювера на другого из его команды
 	// (POST /pullRequest/reassign)
 	PostPullRequestReassign(w http.ResponseWriter, r *http.Request)
+	// Снять ревьювера с PR без замены
+	// (POST /pullRequest/removeReviewer)
+	PostPullRequestRemoveReviewer(w http.ResponseWriter, r *http.Request)
+	// Снова открыть закрытый PR (идемпотентная операция)
+	// (POST /pullRequest/reopen)
+	PostPullRequestReopen(w http.ResponseWriter, r *http.Request)
 	// Получить статистику назначений ревьюверов
 	// (GET /stats/reviewers)
 	GetStatsReviewers(w http.ResponseWriter, r *http.Request)
@@ -37,36 +76,189 @@ type ServerInterface interface {
 	// Получить команду с участниками
 	// (GET /team/get)
 	GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams)
+	// Получить канал команды для уведомлений ревьюверов
+	// (GET /team/getChatChannel)
+	GetTeamGetChatChannel(w http.ResponseWriter, r *http.Request, params GetTeamGetChatChannelParams)
+	// Получить правила CODEOWNERS команды
+	// (GET /team/getCodeowners)
+	GetTeamGetCodeowners(w http.ResponseWriter, r *http.Request, params GetTeamGetCodeownersParams)
+	// Получить праздники команды
+	// (GET /team/getHolidays)
+	GetTeamGetHolidays(w http.ResponseWriter, r *http.Request, params GetTeamGetHolidaysParams)
+	// Получить настройки подбора ревьюверов команды
+	// (GET /team/getSettings)
+	GetTeamGetSettings(w http.ResponseWriter, r *http.Request, params GetTeamGetSettingsParams)
+	// Отключить уведомления ревьюверов команды
+	// (POST /team/removeChatChannel)
+	PostTeamRemoveChatChannel(w http.ResponseWriter, r *http.Request)
+	// Задать канал команды для уведомлений ревьюверов
+	// (POST /team/setChatChannel)
+	PostTeamSetChatChannel(w http.ResponseWriter, r *http.Request)
+	// Загрузить правила CODEOWNERS команды
+	// (POST /team/setCodeowners)
+	PostTeamSetCodeowners(w http.ResponseWriter, r *http.Request)
+	// Задать праздники команды
+	// (POST /team/setHolidays)
+	PostTeamSetHolidays(w http.ResponseWriter, r *http.Request)
+	// Обновить настройки подбора ревьюверов команды
+	// (POST /team/setSettings)
+	PostTeamSetSettings(w http.ResponseWriter, r *http.Request)
+	// Запретить двум пользователям ревьюить PR друг друга
+	// (POST /users/addExclusion)
+	PostUsersAddExclusion(w http.ResponseWriter, r *http.Request)
+	// Получить запреты взаимного ревью пользователя
+	// (GET /users/getExclusions)
+	GetUsersGetExclusions(w http.ResponseWriter, r *http.Request, params GetUsersGetExclusionsParams)
 	// Получить PR'ы, где пользователь назначен ревьювером
 	// (GET /users/getReview)
 	GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams)
+	// Импортировать окна недоступности из календаря iCalendar (.ics)
+	// (POST /users/importAvailability)
+	PostUsersImportAvailability(w http.ResponseWriter, r *http.Request)
+	// Снять запрет взаимного ревью
+	// (POST /users/removeExclusion)
+	PostUsersRemoveExclusion(w http.ResponseWriter, r *http.Request)
+	// Задать окно недоступности пользователя (отпуск, больничный)
+	// (POST /users/setAvailability)
+	PostUsersSetAvailability(w http.ResponseWriter, r *http.Request)
+	// Настроить ежедневную сводку ревью на email
+	// (POST /users/setDigest)
+	PostUsersSetDigest(w http.ResponseWriter, r *http.Request)
 	// Установить флаг активности пользователя
 	// (POST /users/setIsActive)
 	PostUsersSetIsActive(w http.ResponseWriter, r *http.Request)
+	// Установить уровень пользователя
+	// (POST /users/setLevel)
+	PostUsersSetLevel(w http.ResponseWriter, r *http.Request)
+	// Установить лимит одновременно открытых ревью пользователя
+	// (POST /users/setMaxOpenReviews)
+	PostUsersSetMaxOpenReviews(w http.ResponseWriter, r *http.Request)
+	// Установить вес пользователя при взвешенном выборе ревьюверов
+	// (POST /users/setReviewWeight)
+	PostUsersSetReviewWeight(w http.ResponseWriter, r *http.Request)
+	// Установить рабочий график пользователя
+	// (POST /users/setSchedule)
+	PostUsersSetSchedule(w http.ResponseWriter, r *http.Request)
+	// Задать навыки пользователя
+	// (POST /users/setSkills)
+	PostUsersSetSkills(w http.ResponseWriter, r *http.Request)
+	// Получить недоставленные события
+	// (GET /webhooks/deadLetters)
+	GetWebhooksDeadLetters(w http.ResponseWriter, r *http.Request)
+	// Получить подписки
+	// (GET /webhooks/list)
+	GetWebhooksList(w http.ResponseWriter, r *http.Request)
+	// Повторить недоставленное событие
+	// (POST /webhooks/redeliver)
+	PostWebhooksRedeliver(w http.ResponseWriter, r *http.Request)
+	// Подписаться на доменные события
+	// (POST /webhooks/subscribe)
+	PostWebhooksSubscribe(w http.ResponseWriter, r *http.Request)
+	// Удалить подписку
+	// (POST /webhooks/unsubscribe)
+	PostWebhooksUnsubscribe(w http.ResponseWriter, r *http.Request)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// Предпросмотр ежедневной сводки ревью пользователя
+// (GET /digest/preview)
+func (_ Unimplemented) GetDigestPreview(w http.ResponseWriter, r *http.Request, params GetDigestPreviewParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить связи логинов хостинга кода с пользователями
+// (GET /integrations/accounts/list)
+func (_ Unimplemented) GetIntegrationsAccountsList(w http.ResponseWriter, r *http.Request, params GetIntegrationsAccountsListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить связь логина на хостинге кода
+// (POST /integrations/accounts/remove)
+func (_ Unimplemented) PostIntegrationsAccountsRemove(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Связать логин на хостинге кода с пользователем
+// (POST /integrations/accounts/set)
+func (_ Unimplemented) PostIntegrationsAccountsSet(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить проекты хостинга кода с назначенными командами
+// (GET /integrations/projects/list)
+func (_ Unimplemented) GetIntegrationsProjectsList(w http.ResponseWriter, r *http.Request, params GetIntegrationsProjectsListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить команду проекта хостинга кода
+// (POST /integrations/projects/remove)
+func (_ Unimplemented) PostIntegrationsProjectsRemove(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Назначить проекту хостинга кода команду
+// (POST /integrations/projects/set)
+func (_ Unimplemented) PostIntegrationsProjectsSet(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Вручную назначить ревьювера PR
+// (POST /pullRequest/addReviewer)
+func (_ Unimplemented) PostPullRequestAddReviewer(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Закрыть PR без слияния (идемпотентная операция)
+// (POST /pullRequest/close)
+func (_ Unimplemented) PostPullRequestClose(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Создать PR и автоматически назначить до 2 ревьюверов из команды автора
 // (POST /pullRequest/create)
 func (_ Unimplemented) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Отказаться от ревью PR с указанием причины
+// (POST /pullRequest/decline)
+func (_ Unimplemented) PostPullRequestDecline(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Пометить PR как MERGED (идемпотентная операция)
 // (POST /pullRequest/merge)
 func (_ Unimplemented) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Получить ревью открытых PR, нарушившие SLA
+// (GET /pullRequest/overdue)
+func (_ Unimplemented) GetPullRequestOverdue(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Переназначить конкретного ревьювера на другого из его команды
 // (POST /pullRequest/reassign)
 func (_ Unimplemented) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Снять ревьювера с PR без замены
+// (POST /pullRequest/removeReviewer)
+func (_ Unimplemented) PostPullRequestRemoveReviewer(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Снова открыть закрытый PR (идемпотентная операция)
+// (POST /pullRequest/reopen)
+func (_ Unimplemented) PostPullRequestReopen(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Получить статистику назначений ревьюверов
 // (GET /stats/reviewers)
 func (_ Unimplemented) GetStatsReviewers(w http.ResponseWriter, r *http.Request) {
@@ -91,18 +283,168 @@ func (_ Unimplemented) GetTeamGet(w http.ResponseWriter, r *http.Request, params
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Получить канал команды для уведомлений ревьюверов
+// (GET /team/getChatChannel)
+func (_ Unimplemented) GetTeamGetChatChannel(w http.ResponseWriter, r *http.Request, params GetTeamGetChatChannelParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить правила CODEOWNERS команды
+// (GET /team/getCodeowners)
+func (_ Unimplemented) GetTeamGetCodeowners(w http.ResponseWriter, r *http.Request, params GetTeamGetCodeownersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить праздники команды
+// (GET /team/getHolidays)
+func (_ Unimplemented) GetTeamGetHolidays(w http.ResponseWriter, r *http.Request, params GetTeamGetHolidaysParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить настройки подбора ревьюверов команды
+// (GET /team/getSettings)
+func (_ Unimplemented) GetTeamGetSettings(w http.ResponseWriter, r *http.Request, params GetTeamGetSettingsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отключить уведомления ревьюверов команды
+// (POST /team/removeChatChannel)
+func (_ Unimplemented) PostTeamRemoveChatChannel(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать канал команды для уведомлений ревьюверов
+// (POST /team/setChatChannel)
+func (_ Unimplemented) PostTeamSetChatChannel(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Загрузить правила CODEOWNERS команды
+// (POST /team/setCodeowners)
+func (_ Unimplemented) PostTeamSetCodeowners(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать праздники команды
+// (POST /team/setHolidays)
+func (_ Unimplemented) PostTeamSetHolidays(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Обновить настройки подбора ревьюверов команды
+// (POST /team/setSettings)
+func (_ Unimplemented) PostTeamSetSettings(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Запретить двум пользователям ревьюить PR друг друга
+// (POST /users/addExclusion)
+func (_ Unimplemented) PostUsersAddExclusion(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить запреты взаимного ревью пользователя
+// (GET /users/getExclusions)
+func (_ Unimplemented) GetUsersGetExclusions(w http.ResponseWriter, r *http.Request, params GetUsersGetExclusionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Получить PR'ы, где пользователь назначен ревьювером
 // (GET /users/getReview)
 func (_ Unimplemented) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Импортировать окна недоступности из календаря iCalendar (.ics)
+// (POST /users/importAvailability)
+func (_ Unimplemented) PostUsersImportAvailability(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Снять запрет взаимного ревью
+// (POST /users/removeExclusion)
+func (_ Unimplemented) PostUsersRemoveExclusion(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать окно недоступности пользователя (отпуск, больничный)
+// (POST /users/setAvailability)
+func (_ Unimplemented) PostUsersSetAvailability(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Настроить ежедневную сводку ревью на email
+// (POST /users/setDigest)
+func (_ Unimplemented) PostUsersSetDigest(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Установить флаг активности пользователя
 // (POST /users/setIsActive)
 func (_ Unimplemented) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Установить уровень пользователя
+// (POST /users/setLevel)
+func (_ Unimplemented) PostUsersSetLevel(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Установить лимит одновременно открытых ревью пользователя
+// (POST /users/setMaxOpenReviews)
+func (_ Unimplemented) PostUsersSetMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Установить вес пользователя при взвешенном выборе ревьюверов
+// (POST /users/setReviewWeight)
+func (_ Unimplemented) PostUsersSetReviewWeight(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Установить рабочий график пользователя
+// (POST /users/setSchedule)
+func (_ Unimplemented) PostUsersSetSchedule(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать навыки пользователя
+// (POST /users/setSkills)
+func (_ Unimplemented) PostUsersSetSkills(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить недоставленные события
+// (GET /webhooks/deadLetters)
+func (_ Unimplemented) GetWebhooksDeadLetters(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить подписки
+// (GET /webhooks/list)
+func (_ Unimplemented) GetWebhooksList(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Повторить недоставленное событие
+// (POST /webhooks/redeliver)
+func (_ Unimplemented) PostWebhooksRedeliver(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Подписаться на доменные события
+// (POST /webhooks/subscribe)
+func (_ Unimplemented) PostWebhooksSubscribe(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить подписку
+// (POST /webhooks/unsubscribe)
+func (_ Unimplemented) PostWebhooksUnsubscribe(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -112,8 +454,10 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
-// PostPullRequestCreate operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+// GetDigestPreview operation middleware
+func (siw *ServerInterfaceWrapper) GetDigestPreview(w http.ResponseWriter, r *http.Request) {
+
+	var err error
 
 	ctx := r.Context()
 
@@ -121,8 +465,26 @@ func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter,
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDigestPreviewParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestCreate(w, r)
+		siw.Handler.GetDigestPreview(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -132,8 +494,10 @@ func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// PostPullRequestMerge operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+// GetIntegrationsAccountsList operation middleware
+func (siw *ServerInterfaceWrapper) GetIntegrationsAccountsList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
 
 	ctx := r.Context()
 
@@ -141,8 +505,26 @@ func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetIntegrationsAccountsListParams
+
+	// ------------- Required query parameter "provider" -------------
+
+	if paramValue := r.URL.Query().Get("provider"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "provider"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "provider", r.URL.Query(), &params.Provider)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestMerge(w, r)
+		siw.Handler.GetIntegrationsAccountsList(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -152,8 +534,8 @@ func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// PostPullRequestReassign operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+// PostIntegrationsAccountsRemove operation middleware
+func (siw *ServerInterfaceWrapper) PostIntegrationsAccountsRemove(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -162,7 +544,7 @@ func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestReassign(w, r)
+		siw.Handler.PostIntegrationsAccountsRemove(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -172,8 +554,8 @@ func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// GetStatsReviewers operation middleware
-func (siw *ServerInterfaceWrapper) GetStatsReviewers(w http.ResponseWriter, r *http.Request) {
+// PostIntegrationsAccountsSet operation middleware
+func (siw *ServerInterfaceWrapper) PostIntegrationsAccountsSet(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -182,7 +564,7 @@ func (siw *ServerInterfaceWrapper) GetStatsReviewers(w http.ResponseWriter, r *h
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetStatsReviewers(w, r)
+		siw.Handler.PostIntegrationsAccountsSet(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -192,11 +574,37 @@ func (siw *ServerInterfaceWrapper) GetStatsReviewers(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// PostTeamAdd operation middleware
-func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+// GetIntegrationsProjectsList operation middleware
+func (siw *ServerInterfaceWrapper) GetIntegrationsProjectsList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetIntegrationsProjectsListParams
+
+	// ------------- Required query parameter "provider" -------------
+
+	if paramValue := r.URL.Query().Get("provider"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "provider"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "provider", r.URL.Query(), &params.Provider)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostTeamAdd(w, r)
+		siw.Handler.GetIntegrationsProjectsList(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -206,8 +614,8 @@ func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// PostTeamDeactivate operation middleware
-func (siw *ServerInterfaceWrapper) PostTeamDeactivate(w http.ResponseWriter, r *http.Request) {
+// PostIntegrationsProjectsRemove operation middleware
+func (siw *ServerInterfaceWrapper) PostIntegrationsProjectsRemove(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -216,7 +624,7 @@ func (siw *ServerInterfaceWrapper) PostTeamDeactivate(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostTeamDeactivate(w, r)
+		siw.Handler.PostIntegrationsProjectsRemove(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -226,39 +634,17 @@ func (siw *ServerInterfaceWrapper) PostTeamDeactivate(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// GetTeamGet operation middleware
-func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// PostIntegrationsProjectsSet operation middleware
+func (siw *ServerInterfaceWrapper) PostIntegrationsProjectsSet(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
 
-	ctx = context.WithValue(ctx, UserTokenScopes, []string{})
-
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTeamGetParams
-
-	// ------------- Required query parameter "team_name" -------------
-
-	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
-		return
-	}
-
-	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTeamGet(w, r, params)
+		siw.Handler.PostIntegrationsProjectsSet(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -268,39 +654,37 @@ func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetUsersGetReview operation middleware
-func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// PostPullRequestAddReviewer operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestAddReviewer(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
 
-	ctx = context.WithValue(ctx, UserTokenScopes, []string{})
-
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetUsersGetReviewParams
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestAddReviewer(w, r)
+	}))
 
-	// ------------- Required query parameter "user_id" -------------
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+	handler.ServeHTTP(w, r)
+}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
-		return
-	}
+// PostPullRequestClose operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestClose(w http.ResponseWriter, r *http.Request) {
 
-	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
-		return
-	}
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUsersGetReview(w, r, params)
+		siw.Handler.PostPullRequestClose(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -310,8 +694,8 @@ func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// PostUsersSetIsActive operation middleware
-func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+// PostPullRequestCreate operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -320,7 +704,7 @@ func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostUsersSetIsActive(w, r)
+		siw.Handler.PostPullRequestCreate(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -330,491 +714,3955 @@ func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// PostPullRequestDecline operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestDecline(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	ctx := r.Context()
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
-
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	ctx = context.WithValue(ctx, UserTokenScopes, []string{})
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	r = r.WithContext(ctx)
 
-type RequiredParamError struct {
-	ParamName string
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestDecline(w, r)
+	}))
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
+	handler.ServeHTTP(w, r)
 }
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+// PostPullRequestMerge operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	ctx := r.Context()
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	r = r.WithContext(ctx)
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestMerge(w, r)
+	}))
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+	handler.ServeHTTP(w, r)
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
-}
+// GetPullRequestOverdue operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestOverdue(w http.ResponseWriter, r *http.Request) {
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	ctx := r.Context()
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
-}
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
-}
+	r = r.WithContext(ctx)
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequestOverdue(w, r)
+	}))
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/stats/reviewers", wrapper.GetStatsReviewers)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/team/deactivate", wrapper.PostTeamDeactivate)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/team/get", wrapper.GetTeamGet)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
-	})
-
-	return r
-}
-
-type PostPullRequestCreateRequestObject struct {
-	Body *PostPullRequestCreateJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type PostPullRequestCreateResponseObject interface {
-	VisitPostPullRequestCreateResponse(w http.ResponseWriter) error
-}
+// PostPullRequestReassign operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
 
-type PostPullRequestCreate201JSONResponse struct {
-	Pr *PullRequest `json:"pr,omitempty"`
-}
+	ctx := r.Context()
 
-func (response PostPullRequestCreate201JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type PostPullRequestCreate404JSONResponse ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestReassign(w, r)
+	}))
 
-func (response PostPullRequestCreate404JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type PostPullRequestCreate409JSONResponse ErrorResponse
-
-func (response PostPullRequestCreate409JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+// PostPullRequestRemoveReviewer operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestRemoveReviewer(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type PostPullRequestMergeRequestObject struct {
-	Body *PostPullRequestMergeJSONRequestBody
-}
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
 
-type PostPullRequestMergeResponseObject interface {
-	VisitPostPullRequestMergeResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type PostPullRequestMerge200JSONResponse struct {
-	Pr *PullRequest `json:"pr,omitempty"`
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestRemoveReviewer(w, r)
+	}))
 
-func (response PostPullRequestMerge200JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type PostPullRequestMerge404JSONResponse ErrorResponse
+// PostPullRequestReopen operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReopen(w http.ResponseWriter, r *http.Request) {
 
-func (response PostPullRequestMerge404JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
 
-type PostPullRequestReassignRequestObject struct {
-	Body *PostPullRequestReassignJSONRequestBody
-}
+	r = r.WithContext(ctx)
 
-type PostPullRequestReassignResponseObject interface {
-	VisitPostPullRequestReassignResponse(w http.ResponseWriter) error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestReopen(w, r)
+	}))
 
-type PostPullRequestReassign200JSONResponse struct {
-	Pr PullRequest `json:"pr"`
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	// ReplacedBy user_id нового ревьювера
-	ReplacedBy string `json:"replaced_by"`
+	handler.ServeHTTP(w, r)
 }
 
-func (response PostPullRequestReassign200JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// GetStatsReviewers operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsReviewers(w http.ResponseWriter, r *http.Request) {
 
-type PostPullRequestReassign404JSONResponse ErrorResponse
+	ctx := r.Context()
 
-func (response PostPullRequestReassign404JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type PostPullRequestReassign409JSONResponse ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsReviewers(w, r)
+	}))
 
-func (response PostPullRequestReassign409JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetStatsReviewersRequestObject struct {
+// PostTeamAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamAdd(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamDeactivate operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamDeactivate(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamDeactivate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamGet operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, UserTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamGetParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamGetChatChannel operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamGetChatChannel(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamGetChatChannelParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamGetChatChannel(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamGetCodeowners operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamGetCodeowners(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamGetCodeownersParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamGetCodeowners(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamGetHolidays operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamGetHolidays(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamGetHolidaysParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamGetHolidays(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamGetSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamGetSettings(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamGetSettingsParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamGetSettings(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamRemoveChatChannel operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamRemoveChatChannel(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamRemoveChatChannel(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamSetChatChannel operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetChatChannel(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamSetChatChannel(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamSetCodeowners operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetCodeowners(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamSetCodeowners(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamSetHolidays operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetHolidays(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamSetHolidays(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamSetSettings operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetSettings(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamSetSettings(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersAddExclusion operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersAddExclusion(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersAddExclusion(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersGetExclusions operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGetExclusions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetExclusionsParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersGetExclusions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersGetReview operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, UserTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetReviewParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersGetReview(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersImportAvailability operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersImportAvailability(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersImportAvailability(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersRemoveExclusion operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersRemoveExclusion(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersRemoveExclusion(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersSetAvailability operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetAvailability(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetAvailability(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersSetDigest operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetDigest(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetDigest(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersSetIsActive operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetIsActive(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersSetLevel operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetLevel(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetLevel(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersSetMaxOpenReviews operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetMaxOpenReviews(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersSetReviewWeight operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetReviewWeight(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetReviewWeight(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersSetSchedule operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetSchedule(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetSchedule(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersSetSkills operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetSkills(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetSkills(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhooksDeadLetters operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooksDeadLetters(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhooksDeadLetters(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhooksList operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooksList(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhooksList(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostWebhooksRedeliver operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooksRedeliver(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWebhooksRedeliver(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostWebhooksSubscribe operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooksSubscribe(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWebhooksSubscribe(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostWebhooksUnsubscribe operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooksUnsubscribe(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWebhooksUnsubscribe(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/digest/preview", wrapper.GetDigestPreview)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/integrations/accounts/list", wrapper.GetIntegrationsAccountsList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/integrations/accounts/remove", wrapper.PostIntegrationsAccountsRemove)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/integrations/accounts/set", wrapper.PostIntegrationsAccountsSet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/integrations/projects/list", wrapper.GetIntegrationsProjectsList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/integrations/projects/remove", wrapper.PostIntegrationsProjectsRemove)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/integrations/projects/set", wrapper.PostIntegrationsProjectsSet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/addReviewer", wrapper.PostPullRequestAddReviewer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/close", wrapper.PostPullRequestClose)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/decline", wrapper.PostPullRequestDecline)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/overdue", wrapper.GetPullRequestOverdue)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/removeReviewer", wrapper.PostPullRequestRemoveReviewer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/reopen", wrapper.PostPullRequestReopen)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/reviewers", wrapper.GetStatsReviewers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/deactivate", wrapper.PostTeamDeactivate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/get", wrapper.GetTeamGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/getChatChannel", wrapper.GetTeamGetChatChannel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/getCodeowners", wrapper.GetTeamGetCodeowners)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/getHolidays", wrapper.GetTeamGetHolidays)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/getSettings", wrapper.GetTeamGetSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/removeChatChannel", wrapper.PostTeamRemoveChatChannel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setChatChannel", wrapper.PostTeamSetChatChannel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setCodeowners", wrapper.PostTeamSetCodeowners)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setHolidays", wrapper.PostTeamSetHolidays)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setSettings", wrapper.PostTeamSetSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/addExclusion", wrapper.PostUsersAddExclusion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/getExclusions", wrapper.GetUsersGetExclusions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/importAvailability", wrapper.PostUsersImportAvailability)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/removeExclusion", wrapper.PostUsersRemoveExclusion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setAvailability", wrapper.PostUsersSetAvailability)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setDigest", wrapper.PostUsersSetDigest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setLevel", wrapper.PostUsersSetLevel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setMaxOpenReviews", wrapper.PostUsersSetMaxOpenReviews)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setReviewWeight", wrapper.PostUsersSetReviewWeight)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setSchedule", wrapper.PostUsersSetSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setSkills", wrapper.PostUsersSetSkills)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks/deadLetters", wrapper.GetWebhooksDeadLetters)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks/list", wrapper.GetWebhooksList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks/redeliver", wrapper.PostWebhooksRedeliver)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks/subscribe", wrapper.PostWebhooksSubscribe)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks/unsubscribe", wrapper.PostWebhooksUnsubscribe)
+	})
+
+	return r
+}
+
+type GetDigestPreviewRequestObject struct {
+	Params GetDigestPreviewParams
+}
+
+type GetDigestPreviewResponseObject interface {
+	VisitGetDigestPreviewResponse(w http.ResponseWriter) error
+}
+
+type GetDigestPreview200JSONResponse struct {
+	Digest DigestPreview `json:"digest"`
+}
+
+func (response GetDigestPreview200JSONResponse) VisitGetDigestPreviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDigestPreview404JSONResponse ErrorResponse
+
+func (response GetDigestPreview404JSONResponse) VisitGetDigestPreviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIntegrationsAccountsListRequestObject struct {
+	Params GetIntegrationsAccountsListParams
+}
+
+type GetIntegrationsAccountsListResponseObject interface {
+	VisitGetIntegrationsAccountsListResponse(w http.ResponseWriter) error
+}
+
+type GetIntegrationsAccountsList200JSONResponse struct {
+	Accounts []CodeHostAccount `json:"accounts"`
+}
+
+func (response GetIntegrationsAccountsList200JSONResponse) VisitGetIntegrationsAccountsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIntegrationsAccountsList400JSONResponse ErrorResponse
+
+func (response GetIntegrationsAccountsList400JSONResponse) VisitGetIntegrationsAccountsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsAccountsRemoveRequestObject struct {
+	Body *PostIntegrationsAccountsRemoveJSONRequestBody
+}
+
+type PostIntegrationsAccountsRemoveResponseObject interface {
+	VisitPostIntegrationsAccountsRemoveResponse(w http.ResponseWriter) error
+}
+
+type PostIntegrationsAccountsRemove200JSONResponse struct {
+	Login    string `json:"login"`
+	Provider string `json:"provider"`
+}
+
+func (response PostIntegrationsAccountsRemove200JSONResponse) VisitPostIntegrationsAccountsRemoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsAccountsRemove400JSONResponse ErrorResponse
+
+func (response PostIntegrationsAccountsRemove400JSONResponse) VisitPostIntegrationsAccountsRemoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsAccountsRemove404JSONResponse ErrorResponse
+
+func (response PostIntegrationsAccountsRemove404JSONResponse) VisitPostIntegrationsAccountsRemoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsAccountsSetRequestObject struct {
+	Body *PostIntegrationsAccountsSetJSONRequestBody
+}
+
+type PostIntegrationsAccountsSetResponseObject interface {
+	VisitPostIntegrationsAccountsSetResponse(w http.ResponseWriter) error
+}
+
+type PostIntegrationsAccountsSet200JSONResponse struct {
+	Account CodeHostAccount `json:"account"`
+}
+
+func (response PostIntegrationsAccountsSet200JSONResponse) VisitPostIntegrationsAccountsSetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsAccountsSet400JSONResponse ErrorResponse
+
+func (response PostIntegrationsAccountsSet400JSONResponse) VisitPostIntegrationsAccountsSetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsAccountsSet404JSONResponse ErrorResponse
+
+func (response PostIntegrationsAccountsSet404JSONResponse) VisitPostIntegrationsAccountsSetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIntegrationsProjectsListRequestObject struct {
+	Params GetIntegrationsProjectsListParams
+}
+
+type GetIntegrationsProjectsListResponseObject interface {
+	VisitGetIntegrationsProjectsListResponse(w http.ResponseWriter) error
+}
+
+type GetIntegrationsProjectsList200JSONResponse struct {
+	Projects []CodeHostProject `json:"projects"`
+}
+
+func (response GetIntegrationsProjectsList200JSONResponse) VisitGetIntegrationsProjectsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIntegrationsProjectsList400JSONResponse ErrorResponse
+
+func (response GetIntegrationsProjectsList400JSONResponse) VisitGetIntegrationsProjectsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsProjectsRemoveRequestObject struct {
+	Body *PostIntegrationsProjectsRemoveJSONRequestBody
+}
+
+type PostIntegrationsProjectsRemoveResponseObject interface {
+	VisitPostIntegrationsProjectsRemoveResponse(w http.ResponseWriter) error
+}
+
+type PostIntegrationsProjectsRemove200JSONResponse struct {
+	Provider   string `json:"provider"`
+	Repository string `json:"repository"`
+}
+
+func (response PostIntegrationsProjectsRemove200JSONResponse) VisitPostIntegrationsProjectsRemoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsProjectsRemove400JSONResponse ErrorResponse
+
+func (response PostIntegrationsProjectsRemove400JSONResponse) VisitPostIntegrationsProjectsRemoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsProjectsRemove404JSONResponse ErrorResponse
+
+func (response PostIntegrationsProjectsRemove404JSONResponse) VisitPostIntegrationsProjectsRemoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsProjectsSetRequestObject struct {
+	Body *PostIntegrationsProjectsSetJSONRequestBody
+}
+
+type PostIntegrationsProjectsSetResponseObject interface {
+	VisitPostIntegrationsProjectsSetResponse(w http.ResponseWriter) error
+}
+
+type PostIntegrationsProjectsSet200JSONResponse struct {
+	Project CodeHostProject `json:"project"`
+}
+
+func (response PostIntegrationsProjectsSet200JSONResponse) VisitPostIntegrationsProjectsSetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsProjectsSet400JSONResponse ErrorResponse
+
+func (response PostIntegrationsProjectsSet400JSONResponse) VisitPostIntegrationsProjectsSetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsProjectsSet404JSONResponse ErrorResponse
+
+func (response PostIntegrationsProjectsSet404JSONResponse) VisitPostIntegrationsProjectsSetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewerRequestObject struct {
+	Body *PostPullRequestAddReviewerJSONRequestBody
+}
+
+type PostPullRequestAddReviewerResponseObject interface {
+	VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestAddReviewer200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+}
+
+func (response PostPullRequestAddReviewer200JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewer400JSONResponse ErrorResponse
+
+func (response PostPullRequestAddReviewer400JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewer404JSONResponse ErrorResponse
+
+func (response PostPullRequestAddReviewer404JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewer409JSONResponse ErrorResponse
+
+func (response PostPullRequestAddReviewer409JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCloseRequestObject struct {
+	Body *PostPullRequestCloseJSONRequestBody
+}
+
+type PostPullRequestCloseResponseObject interface {
+	VisitPostPullRequestCloseResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestClose200JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestClose200JSONResponse) VisitPostPullRequestCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestClose404JSONResponse ErrorResponse
+
+func (response PostPullRequestClose404JSONResponse) VisitPostPullRequestCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestClose409JSONResponse ErrorResponse
+
+func (response PostPullRequestClose409JSONResponse) VisitPostPullRequestCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreateRequestObject struct {
+	Body *PostPullRequestCreateJSONRequestBody
+}
+
+type PostPullRequestCreateResponseObject interface {
+	VisitPostPullRequestCreateResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestCreate201JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+
+	// UncoveredTags Требуемые навыки, для которых не нашлось ревьювера
+	UncoveredTags *[]string `json:"uncovered_tags,omitempty"`
+}
+
+func (response PostPullRequestCreate201JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate400JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate400JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate404JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate404JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate409JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate409JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestDeclineRequestObject struct {
+	Body *PostPullRequestDeclineJSONRequestBody
+}
+
+type PostPullRequestDeclineResponseObject interface {
+	VisitPostPullRequestDeclineResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestDecline200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+
+	// ReplacedBy user_id нового ревьювера
+	ReplacedBy string `json:"replaced_by"`
+}
+
+func (response PostPullRequestDecline200JSONResponse) VisitPostPullRequestDeclineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestDecline400JSONResponse ErrorResponse
+
+func (response PostPullRequestDecline400JSONResponse) VisitPostPullRequestDeclineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestDecline404JSONResponse ErrorResponse
+
+func (response PostPullRequestDecline404JSONResponse) VisitPostPullRequestDeclineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestDecline409JSONResponse ErrorResponse
+
+func (response PostPullRequestDecline409JSONResponse) VisitPostPullRequestDeclineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMergeRequestObject struct {
+	Body *PostPullRequestMergeJSONRequestBody
+}
+
+type PostPullRequestMergeResponseObject interface {
+	VisitPostPullRequestMergeResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestMerge200JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestMerge200JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge404JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge404JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge409JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge409JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestOverdueRequestObject struct {
+}
+
+type GetPullRequestOverdueResponseObject interface {
+	VisitGetPullRequestOverdueResponse(w http.ResponseWriter) error
+}
+
+type GetPullRequestOverdue200JSONResponse struct {
+	Reviews []OverdueReview `json:"reviews"`
+}
+
+func (response GetPullRequestOverdue200JSONResponse) VisitGetPullRequestOverdueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassignRequestObject struct {
+	Body *PostPullRequestReassignJSONRequestBody
+}
+
+type PostPullRequestReassignResponseObject interface {
+	VisitPostPullRequestReassignResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReassign200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+
+	// ReplacedBy user_id нового ревьювера
+	ReplacedBy string `json:"replaced_by"`
+}
+
+func (response PostPullRequestReassign200JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign400JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign400JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign404JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign404JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign409JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign409JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewerRequestObject struct {
+	Body *PostPullRequestRemoveReviewerJSONRequestBody
+}
+
+type PostPullRequestRemoveReviewerResponseObject interface {
+	VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestRemoveReviewer200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+}
+
+func (response PostPullRequestRemoveReviewer200JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewer404JSONResponse ErrorResponse
+
+func (response PostPullRequestRemoveReviewer404JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewer409JSONResponse ErrorResponse
+
+func (response PostPullRequestRemoveReviewer409JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReopenRequestObject struct {
+	Body *PostPullRequestReopenJSONRequestBody
+}
+
+type PostPullRequestReopenResponseObject interface {
+	VisitPostPullRequestReopenResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReopen200JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestReopen200JSONResponse) VisitPostPullRequestReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReopen404JSONResponse ErrorResponse
+
+func (response PostPullRequestReopen404JSONResponse) VisitPostPullRequestReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReopen409JSONResponse ErrorResponse
+
+func (response PostPullRequestReopen409JSONResponse) VisitPostPullRequestReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsReviewersRequestObject struct {
+}
+
+type GetStatsReviewersResponseObject interface {
+	VisitGetStatsReviewersResponse(w http.ResponseWriter) error
+}
+
+type GetStatsReviewers200JSONResponse ReviewerStatsResponse
+
+func (response GetStatsReviewers200JSONResponse) VisitGetStatsReviewersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAddRequestObject struct {
+	Body *PostTeamAddJSONRequestBody
+}
+
+type PostTeamAddResponseObject interface {
+	VisitPostTeamAddResponse(w http.ResponseWriter) error
+}
+
+type PostTeamAdd201JSONResponse struct {
+	Team *Team `json:"team,omitempty"`
+}
+
+func (response PostTeamAdd201JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd400JSONResponse ErrorResponse
+
+func (response PostTeamAdd400JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamDeactivateRequestObject struct {
+	Body *PostTeamDeactivateJSONRequestBody
+}
+
+type PostTeamDeactivateResponseObject interface {
+	VisitPostTeamDeactivateResponse(w http.ResponseWriter) error
+}
+
+type PostTeamDeactivate200JSONResponse TeamDeactivateResult
+
+func (response PostTeamDeactivate200JSONResponse) VisitPostTeamDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamDeactivate404JSONResponse ErrorResponse
+
+func (response PostTeamDeactivate404JSONResponse) VisitPostTeamDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamDeactivate409JSONResponse ErrorResponse
+
+func (response PostTeamDeactivate409JSONResponse) VisitPostTeamDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGetRequestObject struct {
+	Params GetTeamGetParams
+}
+
+type GetTeamGetResponseObject interface {
+	VisitGetTeamGetResponse(w http.ResponseWriter) error
+}
+
+type GetTeamGet200JSONResponse Team
+
+func (response GetTeamGet200JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGet404JSONResponse ErrorResponse
+
+func (response GetTeamGet404JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGetChatChannelRequestObject struct {
+	Params GetTeamGetChatChannelParams
+}
+
+type GetTeamGetChatChannelResponseObject interface {
+	VisitGetTeamGetChatChannelResponse(w http.ResponseWriter) error
+}
+
+type GetTeamGetChatChannel200JSONResponse struct {
+	Channel ChatChannel `json:"channel"`
+}
+
+func (response GetTeamGetChatChannel200JSONResponse) VisitGetTeamGetChatChannelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGetChatChannel404JSONResponse ErrorResponse
+
+func (response GetTeamGetChatChannel404JSONResponse) VisitGetTeamGetChatChannelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGetCodeownersRequestObject struct {
+	Params GetTeamGetCodeownersParams
+}
+
+type GetTeamGetCodeownersResponseObject interface {
+	VisitGetTeamGetCodeownersResponse(w http.ResponseWriter) error
+}
+
+type GetTeamGetCodeowners200JSONResponse struct {
+	Codeowners string `json:"codeowners"`
+	TeamName   string `json:"team_name"`
+}
+
+func (response GetTeamGetCodeowners200JSONResponse) VisitGetTeamGetCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGetCodeowners404JSONResponse ErrorResponse
+
+func (response GetTeamGetCodeowners404JSONResponse) VisitGetTeamGetCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGetHolidaysRequestObject struct {
+	Params GetTeamGetHolidaysParams
+}
+
+type GetTeamGetHolidaysResponseObject interface {
+	VisitGetTeamGetHolidaysResponse(w http.ResponseWriter) error
+}
+
+type GetTeamGetHolidays200JSONResponse TeamHolidaysResponse
+
+func (response GetTeamGetHolidays200JSONResponse) VisitGetTeamGetHolidaysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGetHolidays404JSONResponse ErrorResponse
+
+func (response GetTeamGetHolidays404JSONResponse) VisitGetTeamGetHolidaysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGetSettingsRequestObject struct {
+	Params GetTeamGetSettingsParams
+}
+
+type GetTeamGetSettingsResponseObject interface {
+	VisitGetTeamGetSettingsResponse(w http.ResponseWriter) error
+}
+
+type GetTeamGetSettings200JSONResponse TeamSettings
+
+func (response GetTeamGetSettings200JSONResponse) VisitGetTeamGetSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGetSettings404JSONResponse ErrorResponse
+
+func (response GetTeamGetSettings404JSONResponse) VisitGetTeamGetSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRemoveChatChannelRequestObject struct {
+	Body *PostTeamRemoveChatChannelJSONRequestBody
+}
+
+type PostTeamRemoveChatChannelResponseObject interface {
+	VisitPostTeamRemoveChatChannelResponse(w http.ResponseWriter) error
+}
+
+type PostTeamRemoveChatChannel200JSONResponse struct {
+	TeamName string `json:"team_name"`
+}
+
+func (response PostTeamRemoveChatChannel200JSONResponse) VisitPostTeamRemoveChatChannelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRemoveChatChannel400JSONResponse ErrorResponse
+
+func (response PostTeamRemoveChatChannel400JSONResponse) VisitPostTeamRemoveChatChannelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRemoveChatChannel404JSONResponse ErrorResponse
+
+func (response PostTeamRemoveChatChannel404JSONResponse) VisitPostTeamRemoveChatChannelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetChatChannelRequestObject struct {
+	Body *PostTeamSetChatChannelJSONRequestBody
+}
+
+type PostTeamSetChatChannelResponseObject interface {
+	VisitPostTeamSetChatChannelResponse(w http.ResponseWriter) error
+}
+
+type PostTeamSetChatChannel200JSONResponse struct {
+	Channel ChatChannel `json:"channel"`
+}
+
+func (response PostTeamSetChatChannel200JSONResponse) VisitPostTeamSetChatChannelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetChatChannel400JSONResponse ErrorResponse
+
+func (response PostTeamSetChatChannel400JSONResponse) VisitPostTeamSetChatChannelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetChatChannel404JSONResponse ErrorResponse
+
+func (response PostTeamSetChatChannel404JSONResponse) VisitPostTeamSetChatChannelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetCodeownersRequestObject struct {
+	Body *PostTeamSetCodeownersJSONRequestBody
+}
+
+type PostTeamSetCodeownersResponseObject interface {
+	VisitPostTeamSetCodeownersResponse(w http.ResponseWriter) error
+}
+
+type PostTeamSetCodeowners200JSONResponse struct {
+	// Rules Количество правил в файле
+	Rules    int    `json:"rules"`
+	TeamName string `json:"team_name"`
+}
+
+func (response PostTeamSetCodeowners200JSONResponse) VisitPostTeamSetCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetCodeowners400JSONResponse ErrorResponse
+
+func (response PostTeamSetCodeowners400JSONResponse) VisitPostTeamSetCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetCodeowners404JSONResponse ErrorResponse
+
+func (response PostTeamSetCodeowners404JSONResponse) VisitPostTeamSetCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetHolidaysRequestObject struct {
+	Body *PostTeamSetHolidaysJSONRequestBody
+}
+
+type PostTeamSetHolidaysResponseObject interface {
+	VisitPostTeamSetHolidaysResponse(w http.ResponseWriter) error
+}
+
+type PostTeamSetHolidays200JSONResponse TeamHolidaysResponse
+
+func (response PostTeamSetHolidays200JSONResponse) VisitPostTeamSetHolidaysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetHolidays400JSONResponse ErrorResponse
+
+func (response PostTeamSetHolidays400JSONResponse) VisitPostTeamSetHolidaysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetHolidays404JSONResponse ErrorResponse
+
+func (response PostTeamSetHolidays404JSONResponse) VisitPostTeamSetHolidaysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetSettingsRequestObject struct {
+	Body *PostTeamSetSettingsJSONRequestBody
+}
+
+type PostTeamSetSettingsResponseObject interface {
+	VisitPostTeamSetSettingsResponse(w http.ResponseWriter) error
+}
+
+type PostTeamSetSettings200JSONResponse TeamSettings
+
+func (response PostTeamSetSettings200JSONResponse) VisitPostTeamSetSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetSettings400JSONResponse ErrorResponse
+
+func (response PostTeamSetSettings400JSONResponse) VisitPostTeamSetSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetSettings404JSONResponse ErrorResponse
+
+func (response PostTeamSetSettings404JSONResponse) VisitPostTeamSetSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAddExclusionRequestObject struct {
+	Body *PostUsersAddExclusionJSONRequestBody
+}
+
+type PostUsersAddExclusionResponseObject interface {
+	VisitPostUsersAddExclusionResponse(w http.ResponseWriter) error
+}
+
+type PostUsersAddExclusion200JSONResponse struct {
+	Exclusion ReviewerExclusion `json:"exclusion"`
+}
+
+func (response PostUsersAddExclusion200JSONResponse) VisitPostUsersAddExclusionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAddExclusion400JSONResponse ErrorResponse
+
+func (response PostUsersAddExclusion400JSONResponse) VisitPostUsersAddExclusionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAddExclusion404JSONResponse ErrorResponse
+
+func (response PostUsersAddExclusion404JSONResponse) VisitPostUsersAddExclusionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetExclusionsRequestObject struct {
+	Params GetUsersGetExclusionsParams
+}
+
+type GetUsersGetExclusionsResponseObject interface {
+	VisitGetUsersGetExclusionsResponse(w http.ResponseWriter) error
+}
+
+type GetUsersGetExclusions200JSONResponse struct {
+	Exclusions []ReviewerExclusion `json:"exclusions"`
+	UserId     string              `json:"user_id"`
+}
+
+func (response GetUsersGetExclusions200JSONResponse) VisitGetUsersGetExclusionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetExclusions404JSONResponse ErrorResponse
+
+func (response GetUsersGetExclusions404JSONResponse) VisitGetUsersGetExclusionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReviewRequestObject struct {
+	Params GetUsersGetReviewParams
+}
+
+type GetUsersGetReviewResponseObject interface {
+	VisitGetUsersGetReviewResponse(w http.ResponseWriter) error
+}
+
+type GetUsersGetReview200JSONResponse struct {
+	PullRequests []PullRequestShort `json:"pull_requests"`
+	UserId       string             `json:"user_id"`
+}
+
+func (response GetUsersGetReview200JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReview404JSONResponse ErrorResponse
+
+func (response GetUsersGetReview404JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersImportAvailabilityRequestObject struct {
+	Body *PostUsersImportAvailabilityJSONRequestBody
+}
+
+type PostUsersImportAvailabilityResponseObject interface {
+	VisitPostUsersImportAvailabilityResponse(w http.ResponseWriter) error
+}
+
+type PostUsersImportAvailability200JSONResponse struct {
+	// Imported Количество созданных или обновленных окон недоступности
+	Imported int64 `json:"imported"`
+
+	// ReassignedPrs Количество открытых PR, переназначенных по начавшимся окнам
+	ReassignedPrs int64 `json:"reassigned_prs"`
+
+	// Skipped Количество событий, не являющихся отсутствием или уже завершившихся
+	Skipped int64 `json:"skipped"`
+
+	// UnmatchedEvents События отсутствия, для которых не найден пользователь или не удалось определить время (например, TZID неизвестной зоны)
+	UnmatchedEvents []string `json:"unmatched_events"`
+}
+
+func (response PostUsersImportAvailability200JSONResponse) VisitPostUsersImportAvailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersImportAvailability400JSONResponse ErrorResponse
+
+func (response PostUsersImportAvailability400JSONResponse) VisitPostUsersImportAvailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersRemoveExclusionRequestObject struct {
+	Body *PostUsersRemoveExclusionJSONRequestBody
+}
+
+type PostUsersRemoveExclusionResponseObject interface {
+	VisitPostUsersRemoveExclusionResponse(w http.ResponseWriter) error
+}
+
+type PostUsersRemoveExclusion200JSONResponse struct {
+	Exclusion ReviewerExclusion `json:"exclusion"`
+}
+
+func (response PostUsersRemoveExclusion200JSONResponse) VisitPostUsersRemoveExclusionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersRemoveExclusion404JSONResponse ErrorResponse
+
+func (response PostUsersRemoveExclusion404JSONResponse) VisitPostUsersRemoveExclusionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetAvailabilityRequestObject struct {
+	Body *PostUsersSetAvailabilityJSONRequestBody
+}
+
+type PostUsersSetAvailabilityResponseObject interface {
+	VisitPostUsersSetAvailabilityResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetAvailability200JSONResponse struct {
+	Availability UserAvailability `json:"availability"`
+
+	// ReassignedPrs Количество открытых PR, переназначенных сразу (если окно уже началось)
+	ReassignedPrs int64 `json:"reassigned_prs"`
+}
+
+func (response PostUsersSetAvailability200JSONResponse) VisitPostUsersSetAvailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetAvailability400JSONResponse ErrorResponse
+
+func (response PostUsersSetAvailability400JSONResponse) VisitPostUsersSetAvailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetAvailability404JSONResponse ErrorResponse
+
+func (response PostUsersSetAvailability404JSONResponse) VisitPostUsersSetAvailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetDigestRequestObject struct {
+	Body *PostUsersSetDigestJSONRequestBody
+}
+
+type PostUsersSetDigestResponseObject interface {
+	VisitPostUsersSetDigestResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetDigest200JSONResponse struct {
+	Digest DigestSettings `json:"digest"`
+}
+
+func (response PostUsersSetDigest200JSONResponse) VisitPostUsersSetDigestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetDigest400JSONResponse ErrorResponse
+
+func (response PostUsersSetDigest400JSONResponse) VisitPostUsersSetDigestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetDigest404JSONResponse ErrorResponse
+
+func (response PostUsersSetDigest404JSONResponse) VisitPostUsersSetDigestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActiveRequestObject struct {
+	Body *PostUsersSetIsActiveJSONRequestBody
+}
+
+type PostUsersSetIsActiveResponseObject interface {
+	VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetIsActive200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetIsActive200JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive401JSONResponse ErrorResponse
+
+func (response PostUsersSetIsActive401JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive404JSONResponse ErrorResponse
+
+func (response PostUsersSetIsActive404JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetLevelRequestObject struct {
+	Body *PostUsersSetLevelJSONRequestBody
+}
+
+type PostUsersSetLevelResponseObject interface {
+	VisitPostUsersSetLevelResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetLevel200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetLevel200JSONResponse) VisitPostUsersSetLevelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetLevel400JSONResponse ErrorResponse
+
+func (response PostUsersSetLevel400JSONResponse) VisitPostUsersSetLevelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetLevel404JSONResponse ErrorResponse
+
+func (response PostUsersSetLevel404JSONResponse) VisitPostUsersSetLevelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetMaxOpenReviewsRequestObject struct {
+	Body *PostUsersSetMaxOpenReviewsJSONRequestBody
+}
+
+type PostUsersSetMaxOpenReviewsResponseObject interface {
+	VisitPostUsersSetMaxOpenReviewsResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetMaxOpenReviews200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetMaxOpenReviews200JSONResponse) VisitPostUsersSetMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetMaxOpenReviews400JSONResponse ErrorResponse
+
+func (response PostUsersSetMaxOpenReviews400JSONResponse) VisitPostUsersSetMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetMaxOpenReviews404JSONResponse ErrorResponse
+
+func (response PostUsersSetMaxOpenReviews404JSONResponse) VisitPostUsersSetMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetReviewWeightRequestObject struct {
+	Body *PostUsersSetReviewWeightJSONRequestBody
+}
+
+type PostUsersSetReviewWeightResponseObject interface {
+	VisitPostUsersSetReviewWeightResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetReviewWeight200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetReviewWeight200JSONResponse) VisitPostUsersSetReviewWeightResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetReviewWeight400JSONResponse ErrorResponse
+
+func (response PostUsersSetReviewWeight400JSONResponse) VisitPostUsersSetReviewWeightResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetReviewWeight404JSONResponse ErrorResponse
+
+func (response PostUsersSetReviewWeight404JSONResponse) VisitPostUsersSetReviewWeightResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetScheduleRequestObject struct {
+	Body *PostUsersSetScheduleJSONRequestBody
+}
+
+type PostUsersSetScheduleResponseObject interface {
+	VisitPostUsersSetScheduleResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetSchedule200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetSchedule200JSONResponse) VisitPostUsersSetScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetSchedule400JSONResponse ErrorResponse
+
+func (response PostUsersSetSchedule400JSONResponse) VisitPostUsersSetScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetSchedule404JSONResponse ErrorResponse
+
+func (response PostUsersSetSchedule404JSONResponse) VisitPostUsersSetScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetSkillsRequestObject struct {
+	Body *PostUsersSetSkillsJSONRequestBody
+}
+
+type PostUsersSetSkillsResponseObject interface {
+	VisitPostUsersSetSkillsResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetSkills200JSONResponse struct {
+	Skills []string `json:"skills"`
+	UserId string   `json:"user_id"`
+}
+
+func (response PostUsersSetSkills200JSONResponse) VisitPostUsersSetSkillsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetSkills400JSONResponse ErrorResponse
+
+func (response PostUsersSetSkills400JSONResponse) VisitPostUsersSetSkillsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetSkills404JSONResponse ErrorResponse
+
+func (response PostUsersSetSkills404JSONResponse) VisitPostUsersSetSkillsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooksDeadLettersRequestObject struct {
+}
+
+type GetWebhooksDeadLettersResponseObject interface {
+	VisitGetWebhooksDeadLettersResponse(w http.ResponseWriter) error
+}
+
+type GetWebhooksDeadLetters200JSONResponse struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+}
+
+func (response GetWebhooksDeadLetters200JSONResponse) VisitGetWebhooksDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooksListRequestObject struct {
+}
+
+type GetWebhooksListResponseObject interface {
+	VisitGetWebhooksListResponse(w http.ResponseWriter) error
+}
+
+type GetWebhooksList200JSONResponse struct {
+	Subscriptions []WebhookSubscription `json:"subscriptions"`
+}
+
+func (response GetWebhooksList200JSONResponse) VisitGetWebhooksListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksRedeliverRequestObject struct {
+	Body *PostWebhooksRedeliverJSONRequestBody
+}
+
+type PostWebhooksRedeliverResponseObject interface {
+	VisitPostWebhooksRedeliverResponse(w http.ResponseWriter) error
+}
+
+type PostWebhooksRedeliver200JSONResponse struct {
+	Delivery WebhookDelivery `json:"delivery"`
+}
+
+func (response PostWebhooksRedeliver200JSONResponse) VisitPostWebhooksRedeliverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksRedeliver400JSONResponse ErrorResponse
+
+func (response PostWebhooksRedeliver400JSONResponse) VisitPostWebhooksRedeliverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksRedeliver404JSONResponse ErrorResponse
+
+func (response PostWebhooksRedeliver404JSONResponse) VisitPostWebhooksRedeliverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksSubscribeRequestObject struct {
+	Body *PostWebhooksSubscribeJSONRequestBody
+}
+
+type PostWebhooksSubscribeResponseObject interface {
+	VisitPostWebhooksSubscribeResponse(w http.ResponseWriter) error
+}
+
+type PostWebhooksSubscribe201JSONResponse struct {
+	Subscription WebhookSubscription `json:"subscription"`
+}
+
+func (response PostWebhooksSubscribe201JSONResponse) VisitPostWebhooksSubscribeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksSubscribe400JSONResponse ErrorResponse
+
+func (response PostWebhooksSubscribe400JSONResponse) VisitPostWebhooksSubscribeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksUnsubscribeRequestObject struct {
+	Body *PostWebhooksUnsubscribeJSONRequestBody
+}
+
+type PostWebhooksUnsubscribeResponseObject interface {
+	VisitPostWebhooksUnsubscribeResponse(w http.ResponseWriter) error
+}
+
+type PostWebhooksUnsubscribe200JSONResponse struct {
+	SubscriptionId int64 `json:"subscription_id"`
+}
+
+func (response PostWebhooksUnsubscribe200JSONResponse) VisitPostWebhooksUnsubscribeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksUnsubscribe404JSONResponse ErrorResponse
+
+func (response PostWebhooksUnsubscribe404JSONResponse) VisitPostWebhooksUnsubscribeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Предпросмотр ежедневной сводки ревью пользователя
+	// (GET /digest/preview)
+	GetDigestPreview(ctx context.Context, request GetDigestPreviewRequestObject) (GetDigestPreviewResponseObject, error)
+	// Получить связи логинов хостинга кода с пользователями
+	// (GET /integrations/accounts/list)
+	GetIntegrationsAccountsList(ctx context.Context, request GetIntegrationsAccountsListRequestObject) (GetIntegrationsAccountsListResponseObject, error)
+	// Удалить связь логина на хостинге кода
+	// (POST /integrations/accounts/remove)
+	PostIntegrationsAccountsRemove(ctx context.Context, request PostIntegrationsAccountsRemoveRequestObject) (PostIntegrationsAccountsRemoveResponseObject, error)
+	// Связать логин на хостинге кода с пользователем
+	// (POST /integrations/accounts/set)
+	PostIntegrationsAccountsSet(ctx context.Context, request PostIntegrationsAccountsSetRequestObject) (PostIntegrationsAccountsSetResponseObject, error)
+	// Получить проекты хостинга кода с назначенными командами
+	// (GET /integrations/projects/list)
+	GetIntegrationsProjectsList(ctx context.Context, request GetIntegrationsProjectsListRequestObject) (GetIntegrationsProjectsListResponseObject, error)
+	// Удалить команду проекта хостинга кода
+	// (POST /integrations/projects/remove)
+	PostIntegrationsProjectsRemove(ctx context.Context, request PostIntegrationsProjectsRemoveRequestObject) (PostIntegrationsProjectsRemoveResponseObject, error)
+	// Назначить проекту хостинга кода команду
+	// (POST /integrations/projects/set)
+	PostIntegrationsProjectsSet(ctx context.Context, request PostIntegrationsProjectsSetRequestObject) (PostIntegrationsProjectsSetResponseObject, error)
+	// Вручную назначить ревьювера PR
+	// (POST /pullRequest/addReviewer)
+	PostPullRequestAddReviewer(ctx context.Context, request PostPullRequestAddReviewerRequestObject) (PostPullRequestAddReviewerResponseObject, error)
+	// Закрыть PR без слияния (идемпотентная операция)
+	// (POST /pullRequest/close)
+	PostPullRequestClose(ctx context.Context, request PostPullRequestCloseRequestObject) (PostPullRequestCloseResponseObject, error)
+	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// (POST /pullRequest/create)
+	PostPullRequestCreate(ctx context.Context, request PostPullRequestCreateRequestObject) (PostPullRequestCreateResponseObject, error)
+	// Отказаться от ревью PR с указанием причины
+	// (POST /pullRequest/decline)
+	PostPullRequestDecline(ctx context.Context, request PostPullRequestDeclineRequestObject) (PostPullRequestDeclineResponseObject, error)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	PostPullRequestMerge(ctx context.Context, request PostPullRequestMergeRequestObject) (PostPullRequestMergeResponseObject, error)
+	// Получить ревью открытых PR, нарушившие SLA
+	// (GET /pullRequest/overdue)
+	GetPullRequestOverdue(ctx context.Context, request GetPullRequestOverdueRequestObject) (GetPullRequestOverdueResponseObject, error)
+	// Переназначить конкретного ревьювера на другого из его команды
+	// (POST /pullRequest/reassign)
+	PostPullRequestReassign(ctx context.Context, request PostPullRequestReassignRequestObject) (PostPullRequestReassignResponseObject, error)
+	// Снять ревьювера с PR без замены
+	// (POST /pullRequest/removeReviewer)
+	PostPullRequestRemoveReviewer(ctx context.Context, request PostPullRequestRemoveReviewerRequestObject) (PostPullRequestRemoveReviewerResponseObject, error)
+	// Снова открыть закрытый PR (идемпотентная операция)
+	// (POST /pullRequest/reopen)
+	PostPullRequestReopen(ctx context.Context, request PostPullRequestReopenRequestObject) (PostPullRequestReopenResponseObject, error)
+	// Получить статистику назначений ревьюверов
+	// (GET /stats/reviewers)
+	GetStatsReviewers(ctx context.Context, request GetStatsReviewersRequestObject) (GetStatsReviewersResponseObject, error)
+	// Создать команду с участниками (создаёт/обновляет пользователей)
+	// (POST /team/add)
+	PostTeamAdd(ctx context.Context, request PostTeamAddRequestObject) (PostTeamAddResponseObject, error)
+	// Массовая деактивация пользователей команды с безопасным переназначением
+	// (POST /team/deactivate)
+	PostTeamDeactivate(ctx context.Context, request PostTeamDeactivateRequestObject) (PostTeamDeactivateResponseObject, error)
+	// Получить команду с участниками
+	// (GET /team/get)
+	GetTeamGet(ctx context.Context, request GetTeamGetRequestObject) (GetTeamGetResponseObject, error)
+	// Получить канал команды для уведомлений ревьюверов
+	// (GET /team/getChatChannel)
+	GetTeamGetChatChannel(ctx context.Context, request GetTeamGetChatChannelRequestObject) (GetTeamGetChatChannelResponseObject, error)
+	// Получить правила CODEOWNERS команды
+	// (GET /team/getCodeowners)
+	GetTeamGetCodeowners(ctx context.Context, request GetTeamGetCodeownersRequestObject) (GetTeamGetCodeownersResponseObject, error)
+	// Получить праздники команды
+	// (GET /team/getHolidays)
+	GetTeamGetHolidays(ctx context.Context, request GetTeamGetHolidaysRequestObject) (GetTeamGetHolidaysResponseObject, error)
+	// Получить настройки подбора ревьюверов команды
+	// (GET /team/getSettings)
+	GetTeamGetSettings(ctx context.Context, request GetTeamGetSettingsRequestObject) (GetTeamGetSettingsResponseObject, error)
+	// Отключить уведомления ревьюверов команды
+	// (POST /team/removeChatChannel)
+	PostTeamRemoveChatChannel(ctx context.Context, request PostTeamRemoveChatChannelRequestObject) (PostTeamRemoveChatChannelResponseObject, error)
+	// Задать канал команды для уведомлений ревьюверов
+	// (POST /team/setChatChannel)
+	PostTeamSetChatChannel(ctx context.Context, request PostTeamSetChatChannelRequestObject) (PostTeamSetChatChannelResponseObject, error)
+	// Загрузить правила CODEOWNERS команды
+	// (POST /team/setCodeowners)
+	PostTeamSetCodeowners(ctx context.Context, request PostTeamSetCodeownersRequestObject) (PostTeamSetCodeownersResponseObject, error)
+	// Задать праздники команды
+	// (POST /team/setHolidays)
+	PostTeamSetHolidays(ctx context.Context, request PostTeamSetHolidaysRequestObject) (PostTeamSetHolidaysResponseObject, error)
+	// Обновить настройки подбора ревьюверов команды
+	// (POST /team/setSettings)
+	PostTeamSetSettings(ctx context.Context, request PostTeamSetSettingsRequestObject) (PostTeamSetSettingsResponseObject, error)
+	// Запретить двум пользователям ревьюить PR друг друга
+	// (POST /users/addExclusion)
+	PostUsersAddExclusion(ctx context.Context, request PostUsersAddExclusionRequestObject) (PostUsersAddExclusionResponseObject, error)
+	// Получить запреты взаимного ревью пользователя
+	// (GET /users/getExclusions)
+	GetUsersGetExclusions(ctx context.Context, request GetUsersGetExclusionsRequestObject) (GetUsersGetExclusionsResponseObject, error)
+	// Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /users/getReview)
+	GetUsersGetReview(ctx context.Context, request GetUsersGetReviewRequestObject) (GetUsersGetReviewResponseObject, error)
+	// Импортировать окна недоступности из календаря iCalendar (.ics)
+	// (POST /users/importAvailability)
+	PostUsersImportAvailability(ctx context.Context, request PostUsersImportAvailabilityRequestObject) (PostUsersImportAvailabilityResponseObject, error)
+	// Снять запрет взаимного ревью
+	// (POST /users/removeExclusion)
+	PostUsersRemoveExclusion(ctx context.Context, request PostUsersRemoveExclusionRequestObject) (PostUsersRemoveExclusionResponseObject, error)
+	// Задать окно недоступности пользователя (отпуск, больничный)
+	// (POST /users/setAvailability)
+	PostUsersSetAvailability(ctx context.Context, request PostUsersSetAvailabilityRequestObject) (PostUsersSetAvailabilityResponseObject, error)
+	// Настроить ежедневную сводку ревью на email
+	// (POST /users/setDigest)
+	PostUsersSetDigest(ctx context.Context, request PostUsersSetDigestRequestObject) (PostUsersSetDigestResponseObject, error)
+	// Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	PostUsersSetIsActive(ctx context.Context, request PostUsersSetIsActiveRequestObject) (PostUsersSetIsActiveResponseObject, error)
+	// Установить уровень пользователя
+	// (POST /users/setLevel)
+	PostUsersSetLevel(ctx context.Context, request PostUsersSetLevelRequestObject) (PostUsersSetLevelResponseObject, error)
+	// Установить лимит одновременно открытых ревью пользователя
+	// (POST /users/setMaxOpenReviews)
+	PostUsersSetMaxOpenReviews(ctx context.Context, request PostUsersSetMaxOpenReviewsRequestObject) (PostUsersSetMaxOpenReviewsResponseObject, error)
+	// Установить вес пользователя при взвешенном выборе ревьюверов
+	// (POST /users/setReviewWeight)
+	PostUsersSetReviewWeight(ctx context.Context, request PostUsersSetReviewWeightRequestObject) (PostUsersSetReviewWeightResponseObject, error)
+	// Установить рабочий график пользователя
+	// (POST /users/setSchedule)
+	PostUsersSetSchedule(ctx context.Context, request PostUsersSetScheduleRequestObject) (PostUsersSetScheduleResponseObject, error)
+	// Задать навыки пользователя
+	// (POST /users/setSkills)
+	PostUsersSetSkills(ctx context.Context, request PostUsersSetSkillsRequestObject) (PostUsersSetSkillsResponseObject, error)
+	// Получить недоставленные события
+	// (GET /webhooks/deadLetters)
+	GetWebhooksDeadLetters(ctx context.Context, request GetWebhooksDeadLettersRequestObject) (GetWebhooksDeadLettersResponseObject, error)
+	// Получить подписки
+	// (GET /webhooks/list)
+	GetWebhooksList(ctx context.Context, request GetWebhooksListRequestObject) (GetWebhooksListResponseObject, error)
+	// Повторить недоставленное событие
+	// (POST /webhooks/redeliver)
+	PostWebhooksRedeliver(ctx context.Context, request PostWebhooksRedeliverRequestObject) (PostWebhooksRedeliverResponseObject, error)
+	// Подписаться на доменные события
+	// (POST /webhooks/subscribe)
+	PostWebhooksSubscribe(ctx context.Context, request PostWebhooksSubscribeRequestObject) (PostWebhooksSubscribeResponseObject, error)
+	// Удалить подписку
+	// (POST /webhooks/unsubscribe)
+	PostWebhooksUnsubscribe(ctx context.Context, request PostWebhooksUnsubscribeRequestObject) (PostWebhooksUnsubscribeResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetDigestPreview operation middleware
+func (sh *strictHandler) GetDigestPreview(w http.ResponseWriter, r *http.Request, params GetDigestPreviewParams) {
+	var request GetDigestPreviewRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDigestPreview(ctx, request.(GetDigestPreviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDigestPreview")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDigestPreviewResponseObject); ok {
+		if err := validResponse.VisitGetDigestPreviewResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetIntegrationsAccountsList operation middleware
+func (sh *strictHandler) GetIntegrationsAccountsList(w http.ResponseWriter, r *http.Request, params GetIntegrationsAccountsListParams) {
+	var request GetIntegrationsAccountsListRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetIntegrationsAccountsList(ctx, request.(GetIntegrationsAccountsListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetIntegrationsAccountsList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetIntegrationsAccountsListResponseObject); ok {
+		if err := validResponse.VisitGetIntegrationsAccountsListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostIntegrationsAccountsRemove operation middleware
+func (sh *strictHandler) PostIntegrationsAccountsRemove(w http.ResponseWriter, r *http.Request) {
+	var request PostIntegrationsAccountsRemoveRequestObject
+
+	var body PostIntegrationsAccountsRemoveJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostIntegrationsAccountsRemove(ctx, request.(PostIntegrationsAccountsRemoveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostIntegrationsAccountsRemove")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostIntegrationsAccountsRemoveResponseObject); ok {
+		if err := validResponse.VisitPostIntegrationsAccountsRemoveResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostIntegrationsAccountsSet operation middleware
+func (sh *strictHandler) PostIntegrationsAccountsSet(w http.ResponseWriter, r *http.Request) {
+	var request PostIntegrationsAccountsSetRequestObject
+
+	var body PostIntegrationsAccountsSetJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostIntegrationsAccountsSet(ctx, request.(PostIntegrationsAccountsSetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostIntegrationsAccountsSet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostIntegrationsAccountsSetResponseObject); ok {
+		if err := validResponse.VisitPostIntegrationsAccountsSetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetIntegrationsProjectsList operation middleware
+func (sh *strictHandler) GetIntegrationsProjectsList(w http.ResponseWriter, r *http.Request, params GetIntegrationsProjectsListParams) {
+	var request GetIntegrationsProjectsListRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetIntegrationsProjectsList(ctx, request.(GetIntegrationsProjectsListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetIntegrationsProjectsList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetIntegrationsProjectsListResponseObject); ok {
+		if err := validResponse.VisitGetIntegrationsProjectsListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostIntegrationsProjectsRemove operation middleware
+func (sh *strictHandler) PostIntegrationsProjectsRemove(w http.ResponseWriter, r *http.Request) {
+	var request PostIntegrationsProjectsRemoveRequestObject
+
+	var body PostIntegrationsProjectsRemoveJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostIntegrationsProjectsRemove(ctx, request.(PostIntegrationsProjectsRemoveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostIntegrationsProjectsRemove")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostIntegrationsProjectsRemoveResponseObject); ok {
+		if err := validResponse.VisitPostIntegrationsProjectsRemoveResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostIntegrationsProjectsSet operation middleware
+func (sh *strictHandler) PostIntegrationsProjectsSet(w http.ResponseWriter, r *http.Request) {
+	var request PostIntegrationsProjectsSetRequestObject
+
+	var body PostIntegrationsProjectsSetJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostIntegrationsProjectsSet(ctx, request.(PostIntegrationsProjectsSetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostIntegrationsProjectsSet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostIntegrationsProjectsSetResponseObject); ok {
+		if err := validResponse.VisitPostIntegrationsProjectsSetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestAddReviewer operation middleware
+func (sh *strictHandler) PostPullRequestAddReviewer(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestAddReviewerRequestObject
+
+	var body PostPullRequestAddReviewerJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestAddReviewer(ctx, request.(PostPullRequestAddReviewerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestAddReviewer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestAddReviewerResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestAddReviewerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestClose operation middleware
+func (sh *strictHandler) PostPullRequestClose(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestCloseRequestObject
+
+	var body PostPullRequestCloseJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestClose(ctx, request.(PostPullRequestCloseRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestClose")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestCloseResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestCloseResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestCreate operation middleware
+func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestCreateRequestObject
+
+	var body PostPullRequestCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestCreate(ctx, request.(PostPullRequestCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestCreate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestCreateResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestCreateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestDecline operation middleware
+func (sh *strictHandler) PostPullRequestDecline(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestDeclineRequestObject
+
+	var body PostPullRequestDeclineJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestDecline(ctx, request.(PostPullRequestDeclineRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestDecline")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestDeclineResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestDeclineResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestMerge operation middleware
+func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestMergeRequestObject
+
+	var body PostPullRequestMergeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestMerge(ctx, request.(PostPullRequestMergeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestMerge")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestMergeResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestMergeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPullRequestOverdue operation middleware
+func (sh *strictHandler) GetPullRequestOverdue(w http.ResponseWriter, r *http.Request) {
+	var request GetPullRequestOverdueRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPullRequestOverdue(ctx, request.(GetPullRequestOverdueRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPullRequestOverdue")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPullRequestOverdueResponseObject); ok {
+		if err := validResponse.VisitGetPullRequestOverdueResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetStatsReviewersResponseObject interface {
-	VisitGetStatsReviewersResponse(w http.ResponseWriter) error
+// PostPullRequestReassign operation middleware
+func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestReassignRequestObject
+
+	var body PostPullRequestReassignJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReassign(ctx, request.(PostPullRequestReassignRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReassign")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestReassignResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestReassignResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetStatsReviewers200JSONResponse ReviewerStatsResponse
+// PostPullRequestRemoveReviewer operation middleware
+func (sh *strictHandler) PostPullRequestRemoveReviewer(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestRemoveReviewerRequestObject
+
+	var body PostPullRequestRemoveReviewerJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestRemoveReviewer(ctx, request.(PostPullRequestRemoveReviewerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestRemoveReviewer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestRemoveReviewerResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestRemoveReviewerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestReopen operation middleware
+func (sh *strictHandler) PostPullRequestReopen(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestReopenRequestObject
+
+	var body PostPullRequestReopenJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReopen(ctx, request.(PostPullRequestReopenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReopen")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestReopenResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestReopenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStatsReviewers operation middleware
+func (sh *strictHandler) GetStatsReviewers(w http.ResponseWriter, r *http.Request) {
+	var request GetStatsReviewersRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatsReviewers(ctx, request.(GetStatsReviewersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatsReviewers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStatsReviewersResponseObject); ok {
+		if err := validResponse.VisitGetStatsReviewersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamAdd operation middleware
+func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamAddRequestObject
+
+	var body PostTeamAddJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamAdd(ctx, request.(PostTeamAddRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamAdd")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamAddResponseObject); ok {
+		if err := validResponse.VisitPostTeamAddResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamDeactivate operation middleware
+func (sh *strictHandler) PostTeamDeactivate(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamDeactivateRequestObject
+
+	var body PostTeamDeactivateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamDeactivate(ctx, request.(PostTeamDeactivateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamDeactivate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamDeactivateResponseObject); ok {
+		if err := validResponse.VisitPostTeamDeactivateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTeamGet operation middleware
+func (sh *strictHandler) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
+	var request GetTeamGetRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamGet(ctx, request.(GetTeamGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamGet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamGetResponseObject); ok {
+		if err := validResponse.VisitGetTeamGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTeamGetChatChannel operation middleware
+func (sh *strictHandler) GetTeamGetChatChannel(w http.ResponseWriter, r *http.Request, params GetTeamGetChatChannelParams) {
+	var request GetTeamGetChatChannelRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamGetChatChannel(ctx, request.(GetTeamGetChatChannelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamGetChatChannel")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamGetChatChannelResponseObject); ok {
+		if err := validResponse.VisitGetTeamGetChatChannelResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTeamGetCodeowners operation middleware
+func (sh *strictHandler) GetTeamGetCodeowners(w http.ResponseWriter, r *http.Request, params GetTeamGetCodeownersParams) {
+	var request GetTeamGetCodeownersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamGetCodeowners(ctx, request.(GetTeamGetCodeownersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamGetCodeowners")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamGetCodeownersResponseObject); ok {
+		if err := validResponse.VisitGetTeamGetCodeownersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTeamGetHolidays operation middleware
+func (sh *strictHandler) GetTeamGetHolidays(w http.ResponseWriter, r *http.Request, params GetTeamGetHolidaysParams) {
+	var request GetTeamGetHolidaysRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamGetHolidays(ctx, request.(GetTeamGetHolidaysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamGetHolidays")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamGetHolidaysResponseObject); ok {
+		if err := validResponse.VisitGetTeamGetHolidaysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response GetStatsReviewers200JSONResponse) VisitGetStatsReviewersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetTeamGetSettings operation middleware
+func (sh *strictHandler) GetTeamGetSettings(w http.ResponseWriter, r *http.Request, params GetTeamGetSettingsParams) {
+	var request GetTeamGetSettingsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type PostTeamAddRequestObject struct {
-	Body *PostTeamAddJSONRequestBody
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamGetSettings(ctx, request.(GetTeamGetSettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamGetSettings")
+	}
 
-type PostTeamAddResponseObject interface {
-	VisitPostTeamAddResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type PostTeamAdd201JSONResponse struct {
-	Team *Team `json:"team,omitempty"`
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamGetSettingsResponseObject); ok {
+		if err := validResponse.VisitGetTeamGetSettingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response PostTeamAdd201JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// PostTeamRemoveChatChannel operation middleware
+func (sh *strictHandler) PostTeamRemoveChatChannel(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamRemoveChatChannelRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PostTeamRemoveChatChannelJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type PostTeamAdd400JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamRemoveChatChannel(ctx, request.(PostTeamRemoveChatChannelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamRemoveChatChannel")
+	}
 
-func (response PostTeamAdd400JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamRemoveChatChannelResponseObject); ok {
+		if err := validResponse.VisitPostTeamRemoveChatChannelResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostTeamDeactivateRequestObject struct {
-	Body *PostTeamDeactivateJSONRequestBody
-}
+// PostTeamSetChatChannel operation middleware
+func (sh *strictHandler) PostTeamSetChatChannel(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamSetChatChannelRequestObject
 
-type PostTeamDeactivateResponseObject interface {
-	VisitPostTeamDeactivateResponse(w http.ResponseWriter) error
-}
+	var body PostTeamSetChatChannelJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type PostTeamDeactivate200JSONResponse TeamDeactivateResult
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamSetChatChannel(ctx, request.(PostTeamSetChatChannelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamSetChatChannel")
+	}
 
-func (response PostTeamDeactivate200JSONResponse) VisitPostTeamDeactivateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamSetChatChannelResponseObject); ok {
+		if err := validResponse.VisitPostTeamSetChatChannelResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostTeamDeactivate404JSONResponse ErrorResponse
+// PostTeamSetCodeowners operation middleware
+func (sh *strictHandler) PostTeamSetCodeowners(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamSetCodeownersRequestObject
 
-func (response PostTeamDeactivate404JSONResponse) VisitPostTeamDeactivateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var body PostTeamSetCodeownersJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamSetCodeowners(ctx, request.(PostTeamSetCodeownersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamSetCodeowners")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamSetCodeownersResponseObject); ok {
+		if err := validResponse.VisitPostTeamSetCodeownersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostTeamDeactivate409JSONResponse ErrorResponse
+// PostTeamSetHolidays operation middleware
+func (sh *strictHandler) PostTeamSetHolidays(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamSetHolidaysRequestObject
 
-func (response PostTeamDeactivate409JSONResponse) VisitPostTeamDeactivateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	var body PostTeamSetHolidaysJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamSetHolidays(ctx, request.(PostTeamSetHolidaysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamSetHolidays")
+	}
 
-type GetTeamGetRequestObject struct {
-	Params GetTeamGetParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetTeamGetResponseObject interface {
-	VisitGetTeamGetResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamSetHolidaysResponseObject); ok {
+		if err := validResponse.VisitPostTeamSetHolidaysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetTeamGet200JSONResponse Team
+// PostTeamSetSettings operation middleware
+func (sh *strictHandler) PostTeamSetSettings(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamSetSettingsRequestObject
 
-func (response GetTeamGet200JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var body PostTeamSetSettingsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamSetSettings(ctx, request.(PostTeamSetSettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamSetSettings")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamSetSettingsResponseObject); ok {
+		if err := validResponse.VisitPostTeamSetSettingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetTeamGet404JSONResponse ErrorResponse
+// PostUsersAddExclusion operation middleware
+func (sh *strictHandler) PostUsersAddExclusion(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersAddExclusionRequestObject
 
-func (response GetTeamGet404JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var body PostUsersAddExclusionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersAddExclusion(ctx, request.(PostUsersAddExclusionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersAddExclusion")
+	}
 
-type GetUsersGetReviewRequestObject struct {
-	Params GetUsersGetReviewParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetUsersGetReviewResponseObject interface {
-	VisitGetUsersGetReviewResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUsersAddExclusionResponseObject); ok {
+		if err := validResponse.VisitPostUsersAddExclusionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetUsersGetReview200JSONResponse struct {
-	PullRequests []PullRequestShort `json:"pull_requests"`
-	UserId       string             `json:"user_id"`
-}
+// GetUsersGetExclusions operation middleware
+func (sh *strictHandler) GetUsersGetExclusions(w http.ResponseWriter, r *http.Request, params GetUsersGetExclusionsParams) {
+	var request GetUsersGetExclusionsRequestObject
 
-func (response GetUsersGetReview200JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersGetExclusions(ctx, request.(GetUsersGetExclusionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersGetExclusions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUsersGetExclusionsResponseObject); ok {
+		if err := validResponse.VisitGetUsersGetExclusionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetUsersGetReview404JSONResponse ErrorResponse
+// GetUsersGetReview operation middleware
+func (sh *strictHandler) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
+	var request GetUsersGetReviewRequestObject
 
-func (response GetUsersGetReview404JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersGetReview(ctx, request.(GetUsersGetReviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersGetReview")
+	}
 
-type PostUsersSetIsActiveRequestObject struct {
-	Body *PostUsersSetIsActiveJSONRequestBody
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type PostUsersSetIsActiveResponseObject interface {
-	VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUsersGetReviewResponseObject); ok {
+		if err := validResponse.VisitGetUsersGetReviewResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostUsersSetIsActive200JSONResponse struct {
-	User *User `json:"user,omitempty"`
+// PostUsersImportAvailability operation middleware
+func (sh *strictHandler) PostUsersImportAvailability(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersImportAvailabilityRequestObject
+
+	var body PostUsersImportAvailabilityJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersImportAvailability(ctx, request.(PostUsersImportAvailabilityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersImportAvailability")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUsersImportAvailabilityResponseObject); ok {
+		if err := validResponse.VisitPostUsersImportAvailabilityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response PostUsersSetIsActive200JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PostUsersRemoveExclusion operation middleware
+func (sh *strictHandler) PostUsersRemoveExclusion(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersRemoveExclusionRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PostUsersRemoveExclusionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type PostUsersSetIsActive401JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersRemoveExclusion(ctx, request.(PostUsersRemoveExclusionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersRemoveExclusion")
+	}
 
-func (response PostUsersSetIsActive401JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUsersRemoveExclusionResponseObject); ok {
+		if err := validResponse.VisitPostUsersRemoveExclusionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostUsersSetIsActive404JSONResponse ErrorResponse
+// PostUsersSetAvailability operation middleware
+func (sh *strictHandler) PostUsersSetAvailability(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersSetAvailabilityRequestObject
 
-func (response PostUsersSetIsActive404JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var body PostUsersSetAvailabilityJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersSetAvailability(ctx, request.(PostUsersSetAvailabilityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersSetAvailability")
+	}
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
-	// (POST /pullRequest/create)
-	PostPullRequestCreate(ctx context.Context, request PostPullRequestCreateRequestObject) (PostPullRequestCreateResponseObject, error)
-	// Пометить PR как MERGED (идемпотентная операция)
-	// (POST /pullRequest/merge)
-	PostPullRequestMerge(ctx context.Context, request PostPullRequestMergeRequestObject) (PostPullRequestMergeResponseObject, error)
-	// Переназначить конкретного ревьювера на другого из его команды
-	// (POST /pullRequest/reassign)
-	PostPullRequestReassign(ctx context.Context, request PostPullRequestReassignRequestObject) (PostPullRequestReassignResponseObject, error)
-	// Получить статистику назначений ревьюверов
-	// (GET /stats/reviewers)
-	GetStatsReviewers(ctx context.Context, request GetStatsReviewersRequestObject) (GetStatsReviewersResponseObject, error)
-	// Создать команду с участниками (создаёт/обновляет пользователей)
-	// (POST /team/add)
-	PostTeamAdd(ctx context.Context, request PostTeamAddRequestObject) (PostTeamAddResponseObject, error)
-	// Массовая деактивация пользователей команды с безопасным переназначением
-	// (POST /team/deactivate)
-	PostTeamDeactivate(ctx context.Context, request PostTeamDeactivateRequestObject) (PostTeamDeactivateResponseObject, error)
-	// Получить команду с участниками
-	// (GET /team/get)
-	GetTeamGet(ctx context.Context, request GetTeamGetRequestObject) (GetTeamGetResponseObject, error)
-	// Получить PR'ы, где пользователь назначен ревьювером
-	// (GET /users/getReview)
-	GetUsersGetReview(ctx context.Context, request GetUsersGetReviewRequestObject) (GetUsersGetReviewResponseObject, error)
-	// Установить флаг активности пользователя
-	// (POST /users/setIsActive)
-	PostUsersSetIsActive(ctx context.Context, request PostUsersSetIsActiveRequestObject) (PostUsersSetIsActiveResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUsersSetAvailabilityResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetAvailabilityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// PostUsersSetDigest operation middleware
+func (sh *strictHandler) PostUsersSetDigest(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersSetDigestRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	var body PostUsersSetDigestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersSetDigest(ctx, request.(PostUsersSetDigestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersSetDigest")
+	}
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUsersSetDigestResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetDigestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// PostPullRequestCreate operation middleware
-func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
-	var request PostPullRequestCreateRequestObject
+// PostUsersSetIsActive operation middleware
+func (sh *strictHandler) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersSetIsActiveRequestObject
 
-	var body PostPullRequestCreateJSONRequestBody
+	var body PostUsersSetIsActiveJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -822,18 +4670,18 @@ func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Re
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestCreate(ctx, request.(PostPullRequestCreateRequestObject))
+		return sh.ssi.PostUsersSetIsActive(ctx, request.(PostUsersSetIsActiveRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestCreate")
+		handler = middleware(handler, "PostUsersSetIsActive")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPullRequestCreateResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestCreateResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostUsersSetIsActiveResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetIsActiveResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -841,11 +4689,11 @@ func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// PostPullRequestMerge operation middleware
-func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
-	var request PostPullRequestMergeRequestObject
+// PostUsersSetLevel operation middleware
+func (sh *strictHandler) PostUsersSetLevel(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersSetLevelRequestObject
 
-	var body PostPullRequestMergeJSONRequestBody
+	var body PostUsersSetLevelJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -853,18 +4701,18 @@ func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Req
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestMerge(ctx, request.(PostPullRequestMergeRequestObject))
+		return sh.ssi.PostUsersSetLevel(ctx, request.(PostUsersSetLevelRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestMerge")
+		handler = middleware(handler, "PostUsersSetLevel")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPullRequestMergeResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestMergeResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostUsersSetLevelResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetLevelResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -872,11 +4720,11 @@ func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// PostPullRequestReassign operation middleware
-func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
-	var request PostPullRequestReassignRequestObject
+// PostUsersSetMaxOpenReviews operation middleware
+func (sh *strictHandler) PostUsersSetMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersSetMaxOpenReviewsRequestObject
 
-	var body PostPullRequestReassignJSONRequestBody
+	var body PostUsersSetMaxOpenReviewsJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -884,18 +4732,18 @@ func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestReassign(ctx, request.(PostPullRequestReassignRequestObject))
+		return sh.ssi.PostUsersSetMaxOpenReviews(ctx, request.(PostUsersSetMaxOpenReviewsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestReassign")
+		handler = middleware(handler, "PostUsersSetMaxOpenReviews")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPullRequestReassignResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestReassignResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostUsersSetMaxOpenReviewsResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetMaxOpenReviewsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -903,23 +4751,30 @@ func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.
 	}
 }
 
-// GetStatsReviewers operation middleware
-func (sh *strictHandler) GetStatsReviewers(w http.ResponseWriter, r *http.Request) {
-	var request GetStatsReviewersRequestObject
+// PostUsersSetReviewWeight operation middleware
+func (sh *strictHandler) PostUsersSetReviewWeight(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersSetReviewWeightRequestObject
+
+	var body PostUsersSetReviewWeightJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetStatsReviewers(ctx, request.(GetStatsReviewersRequestObject))
+		return sh.ssi.PostUsersSetReviewWeight(ctx, request.(PostUsersSetReviewWeightRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetStatsReviewers")
+		handler = middleware(handler, "PostUsersSetReviewWeight")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetStatsReviewersResponseObject); ok {
-		if err := validResponse.VisitGetStatsReviewersResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostUsersSetReviewWeightResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetReviewWeightResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -927,11 +4782,11 @@ func (sh *strictHandler) GetStatsReviewers(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// PostTeamAdd operation middleware
-func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
-	var request PostTeamAddRequestObject
+// PostUsersSetSchedule operation middleware
+func (sh *strictHandler) PostUsersSetSchedule(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersSetScheduleRequestObject
 
-	var body PostTeamAddJSONRequestBody
+	var body PostUsersSetScheduleJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -939,18 +4794,18 @@ func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostTeamAdd(ctx, request.(PostTeamAddRequestObject))
+		return sh.ssi.PostUsersSetSchedule(ctx, request.(PostUsersSetScheduleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostTeamAdd")
+		handler = middleware(handler, "PostUsersSetSchedule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostTeamAddResponseObject); ok {
-		if err := validResponse.VisitPostTeamAddResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostUsersSetScheduleResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetScheduleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -958,11 +4813,11 @@ func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// PostTeamDeactivate operation middleware
-func (sh *strictHandler) PostTeamDeactivate(w http.ResponseWriter, r *http.Request) {
-	var request PostTeamDeactivateRequestObject
+// PostUsersSetSkills operation middleware
+func (sh *strictHandler) PostUsersSetSkills(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersSetSkillsRequestObject
 
-	var body PostTeamDeactivateJSONRequestBody
+	var body PostUsersSetSkillsJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -970,18 +4825,18 @@ func (sh *strictHandler) PostTeamDeactivate(w http.ResponseWriter, r *http.Reque
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostTeamDeactivate(ctx, request.(PostTeamDeactivateRequestObject))
+		return sh.ssi.PostUsersSetSkills(ctx, request.(PostUsersSetSkillsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostTeamDeactivate")
+		handler = middleware(handler, "PostUsersSetSkills")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostTeamDeactivateResponseObject); ok {
-		if err := validResponse.VisitPostTeamDeactivateResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostUsersSetSkillsResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetSkillsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -989,25 +4844,47 @@ func (sh *strictHandler) PostTeamDeactivate(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// GetTeamGet operation middleware
-func (sh *strictHandler) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
-	var request GetTeamGetRequestObject
+// GetWebhooksDeadLetters operation middleware
+func (sh *strictHandler) GetWebhooksDeadLetters(w http.ResponseWriter, r *http.Request) {
+	var request GetWebhooksDeadLettersRequestObject
 
-	request.Params = params
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWebhooksDeadLetters(ctx, request.(GetWebhooksDeadLettersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWebhooksDeadLetters")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetWebhooksDeadLettersResponseObject); ok {
+		if err := validResponse.VisitGetWebhooksDeadLettersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetWebhooksList operation middleware
+func (sh *strictHandler) GetWebhooksList(w http.ResponseWriter, r *http.Request) {
+	var request GetWebhooksListRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTeamGet(ctx, request.(GetTeamGetRequestObject))
+		return sh.ssi.GetWebhooksList(ctx, request.(GetWebhooksListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTeamGet")
+		handler = middleware(handler, "GetWebhooksList")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetTeamGetResponseObject); ok {
-		if err := validResponse.VisitGetTeamGetResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWebhooksListResponseObject); ok {
+		if err := validResponse.VisitGetWebhooksListResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1015,25 +4892,30 @@ func (sh *strictHandler) GetTeamGet(w http.ResponseWriter, r *http.Request, para
 	}
 }
 
-// GetUsersGetReview operation middleware
-func (sh *strictHandler) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
-	var request GetUsersGetReviewRequestObject
+// PostWebhooksRedeliver operation middleware
+func (sh *strictHandler) PostWebhooksRedeliver(w http.ResponseWriter, r *http.Request) {
+	var request PostWebhooksRedeliverRequestObject
 
-	request.Params = params
+	var body PostWebhooksRedeliverJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetUsersGetReview(ctx, request.(GetUsersGetReviewRequestObject))
+		return sh.ssi.PostWebhooksRedeliver(ctx, request.(PostWebhooksRedeliverRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetUsersGetReview")
+		handler = middleware(handler, "PostWebhooksRedeliver")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetUsersGetReviewResponseObject); ok {
-		if err := validResponse.VisitGetUsersGetReviewResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostWebhooksRedeliverResponseObject); ok {
+		if err := validResponse.VisitPostWebhooksRedeliverResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1041,11 +4923,11 @@ func (sh *strictHandler) GetUsersGetReview(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// PostUsersSetIsActive operation middleware
-func (sh *strictHandler) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
-	var request PostUsersSetIsActiveRequestObject
+// PostWebhooksSubscribe operation middleware
+func (sh *strictHandler) PostWebhooksSubscribe(w http.ResponseWriter, r *http.Request) {
+	var request PostWebhooksSubscribeRequestObject
 
-	var body PostUsersSetIsActiveJSONRequestBody
+	var body PostWebhooksSubscribeJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1053,18 +4935,49 @@ func (sh *strictHandler) PostUsersSetIsActive(w http.ResponseWriter, r *http.Req
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostUsersSetIsActive(ctx, request.(PostUsersSetIsActiveRequestObject))
+		return sh.ssi.PostWebhooksSubscribe(ctx, request.(PostWebhooksSubscribeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostUsersSetIsActive")
+		handler = middleware(handler, "PostWebhooksSubscribe")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostUsersSetIsActiveResponseObject); ok {
-		if err := validResponse.VisitPostUsersSetIsActiveResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostWebhooksSubscribeResponseObject); ok {
+		if err := validResponse.VisitPostWebhooksSubscribeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostWebhooksUnsubscribe operation middleware
+func (sh *strictHandler) PostWebhooksUnsubscribe(w http.ResponseWriter, r *http.Request) {
+	var request PostWebhooksUnsubscribeRequestObject
+
+	var body PostWebhooksUnsubscribeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostWebhooksUnsubscribe(ctx, request.(PostWebhooksUnsubscribeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostWebhooksUnsubscribe")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostWebhooksUnsubscribeResponseObject); ok {
+		if err := validResponse.VisitPostWebhooksUnsubscribeResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -18,50 +18,230 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xb724bxxF/lcW2QByAFqk/Dhp+Y2LF1QcrKqUARQWBWPHW0sW8O+Z2z40gELCktG4r",
-	"I2q+FUETw8gL0LJY0ZJIvcLsGxWze+TdkccjKcpy2i8Gdbe3Ozs785vfzKz3adVz6p7LXSlocZ/Wmc8c",
-	"Lrmv/9rgzFllDv9DwP09fGBxUfXturQ9lxYp/AIdaMMFNOFSvYQOdKFFoA1X6oTABXThCprQgTN1THPU",
-	"xi++0RPlqMscTotUcuZU9O8c9fk3ge1zixalH/AcFdVd7jBcVO7VcbCQvu3u0EYjR78S3F+xRkn1LziD",
-	"FnTUIbTVd0Y+dQhd9ZzANXS1qOfQhVP9uAWX6mSEeIHgfsW2phKu0XupFbjs+55f5qLuuYLjA/4tc+o1",
-	"8xPf4Y+qZ+EUq19uVL748qvVhzRHHS4E28GnPhde4Fc5cT1JnniBa2kN1H2vzn1pc5GYKvnYTLxPuRs4",
-	"tLhJN5ZLjyvLf1xZ31inObpWTvx+vFx+tIxroxyl9fWVR6vhn5XPS6sPVx6WNpbDt0bKrdzg5mNyp51a",
-	"pMRNI1o0PprL2/6aV+XQeLPD4WE5uhbUamX+TcCFHNYAE8LecblV8fkzm/85NOukvYSnTKADTTjHf9UL",
-	"tB/oqGP1F6KeQwtO1Uv1PZxCSz1HyyH3CnNzCx+j2UjuiJTt9gVlvs/28G8WyF1Pm1Pa6KrPmeRWSe/h",
-	"iec7TNIitZjk96Wt/cMNajW2jaZjTDBF9/7ObDPUg1qt4htdjhI0Mcb4ScooIZkMRNz2vlxbXqU5GlrZ",
-	"sO0MnPegKGkLx3XaXzKXduZj7GZ91/PTjCfzxP4flJWml3KotXXJUnRidCoqVS9wk5Zmu/KTJXRp27Ud",
-	"3EahP7ntSr7DfZy9B6ljASKOvfElx4ks4miblB03rX/0nfa3Pn9Ci/Q3+SgI5kP0zif0kOLP0pOsFtqY",
-	"mFoTA7s1og1OmrZXDMnDW3O4sx2C20Sbw1ke629St9YPy2OPKR7Be0KMEvshZ1VpP2OSj8Rrn9drrMod",
-	"7sqKkD6TfCctwr9Wh+p5GL7fQhvpBkb2tjrAYE/gHJpwhRhO7uELoo7gSkf+F5qQtNX3RDCHV1B6RPGe",
-	"3/UfRu6i/0oLdTdS0iSqEUEtRTNWf4RVQd8QN3A+n/exsX6jCcRTu16Pvk6eylo5R+AM6ZQmf4Zy6RAK",
-	"HWjhGZwhUYSuOlAvTbR9hxwtdlzqiOamlemGxjqszyH9JPc76uhCNxo6MFtU9ApxubY9r8aZmw2E5t1k",
-	"O4pQsv9NLrZymszInaeWNkvJ73Uv8SPL2hdOZrtPPL2MLZHk0LUy6YE4KemDRVwh69x/Zlc5ubfBhSQb",
-	"TDzNkS9YrUYWCgsPEA2ecV8Yk56fK8wVcBdenbusbtMiXZwrzC1ihGVyV2suX4+IRN7QOK1ez+AbKpmh",
-	"h6xYKJInZIx4fG6GGz1wIT/zrD1D3F3JTXxl9XrNruoZ8l8LlCqRRMQ4Cg3maQotoXX//nyhMJ/KCoq0",
-	"ZFlEcOZXd2kjntd8CCo0I61Jt4pk6qYfGIKgN7ZQmJ9O4XV/VF6xSYMFNN5FFOQ2zyViiIYYNjIOyoiX",
-	"Ffrj+VKjkaqyQVwn6gC6cK7xu4OHuVRYmkBrkYxZ8iRT5JT14Z9waoJJPl5UwDiPccUEkjDlPzbSfTrd",
-	"mQ5m4vHMOMrE18rEtgir+ZxZe4R/awspBs5ipn2ino/gPxgqD9SR+ju01IE6hFN1BC11aFbi1cC35R4t",
-	"bu7TkuXY7ob3lLu0uLnV2MpRETgO8/c0Q+odmDpUL8lamWCcbRpFogZ1ceQFLgEX+Cqe+7b1N3AGXbKQ",
-	"nv5CG84HKjz92ZGYIXCzHe0TMXMTdAs3kQBMnbROjJeP9egZ4HK0F2b51Fh4GwNcNwOmwt0AU1Q2oBgA",
-	"788X7i8sbcwvFBeXig8++dOtQVeYzN49eMGpxi/tTF11okuWbdIT547BDOUZRK0pXfuVdruW9uCec19A",
-	"Ey7CPZF70NYTX2Huo3OkjjrUvn1CoAvX2o2b6q+YOH08uav22PHE3lrufTCDw3q1yJRDo13INMkM88K5",
-	"sqjqzH6eSyzx4b0eeWrw4L3TkVwvbbcq22ihwQN6e04+MHlG8bYLp9CFt5juD8YsHZCyj9KnyZW2JgAX",
-	"eKVnbw1Vjtvo4afq2PQb0OFRvg8CNm24xPie3vh4mQZGUxKosP6EQUSLHgHVT2YNOEfcMfn9iaEWiEsH",
-	"cAkt0u86PGO1YBQZ6w+KyFiVua4nSQ+TiOcSIwNZKxtVuN7nzLVsK8zHknKpQ81vMCaoI7gOS/0aRpFa",
-	"tg1zQl1liTbQGomkcz1iMlUSK2iRak8eYrtE15VCQWUp9N8BQV9lHtobdQyXQ12LNMJ2lb2JRLsn3nkK",
-	"c2db6OZTD2SI9IjctUWo6dsjwPATNNVzdaT+FjnRmQl2/W4MXKM7wynaNQlDWYr/qZOpg+rwTCEPRprb",
-	"gQt8rcPoKIzRR0HgDLeAQ/Qww5Rb5vdgSzQj8OqCcD7RtdrhKSH3EZdh1bs3ctaIElbJN4dq/Q9ihR4M",
-	"Io3c0JDFxJAFikoeqJP/bmJ7Sa/qp9nNa3UYZjTI8dqmBnydekhwdQO2damO+uagl0gspo5ISgB4l5o5",
-	"ZR86YkKeWVY2w9rgzClZ1iysqt8u2ExU/kxbMHHI8QIeLdXsKtfnnvXRQvKjz7xtYwdRCZHW2Z6jbxxM",
-	"bAwbfbi85XqODPspH1ol26z6lIfN/VHUqSfrBIqahL38mCimxGs80DQ0oDBbHSV53yCKLP19v8dqyuDu",
-	"xlVWRpVOEqB9RNQB0WDQ1DP0br9cQZvcixSoflCHeejCm5CVXqoTQzlSWRi04F08DcMTTCBC1KoYDwxR",
-	"I2kWfEhvwyXaY6m2O403D3cD30d+lNI2WxxuhC0NtLbmb3l/uqWXZqQ/J7Px4aSheffl1h+za6w9kT69",
-	"Q5Ey86zh5uKUIf7f2psPQp88IXqfTbjQ4f00OpuR3jtYClUHBN5ASw+8xsmRvMJVBl+FlibpIyEg5H6j",
-	"KCCOf8SlbktF9/c209UeDckn7/ehWmbztl9NEJ2eVgw55hv1D2ihEQzy9l+jQ46x99y+bvtOynEnjXkj",
-	"LFbjLJqsYfBZhotSiUf9kdPab/we6OzWG697meXfa9lsazBZmqwDMfkln6EbbilXfW5wHyspzER1stdw",
-	"rS/ndOGCrJU/Mj2kUXdx79y/Xk1eG7tdP1srf6SOcwTe4vSZNbqJSjw9X9ROlfBFweWKKPXvd4ymkPrT",
-	"9djoGUhkDMufsJrgk5v7ja/OjLTZcVdHbpl1BuEdm2EVpEWrsVEuQ1W9lbI8QN/4mSwl/DmWs/xgim5I",
-	"b0ZYpnHV+Tt0VV26zWtubCw/FLAJZxiQoGP62fCO6OrtRVTR/t8ElCR+/GKKT+EJhfWo7+ASmvCWxBhr",
-	"J+x2trP+w8MQWjT6z/Z7/wHCRHWEtfCBGRx7kKhixZ7/nrOa3KWNrcZ/AwAA//8U9J8iYjIAAA==",
+	"H4sIAAAAAAAC/+y9fW8bR5og/lUa/C2w0qAlUbKT3aWxwCiWxtbvbFknyXkZK6e0xLbECdlU2E3HXsOA",
+	"JSVxcvZGk2wOM7jbzGwud9gD7h9aFm3qjQb2E1R/hfkkh+d5qqqruqubTVGSHY8HGIci+6Xqqef99X5h",
+	"tV7bqHuuF/iF0v3ChtNwam7gNvCvRdepzTo19z833cY9+KLs+quNykZQqXuFUoH9zI5Zhx2wFjsMn7Bj",
+	"1mVti3XYUbhjsQPWZUesxY7ZXvi4YBcqcMdn+CC74Dk1t1AqBK5TW8bPdqHhftasNNxyoRQ0mq5d8FfX",
+	"3ZoDLw3ubcDFftCoeGuFBw/swk3fbcyU01b1R7bH2uw43GKd8AtaX7jFuuFDi71kXVzqC9Zlu/h1mx2G",
+	"OynLa/puY7lS7mtxD8SPCMDL605wed3xPLeK0G3UN9xGUHHxx2j3yefYhcCtbVSdgC79m4Z7u1Aq/H9j",
+	"0WmN8deMwTsW5cUP7MLn7sp6vf7pcrNRNUDn92wvfMja4abFdsMvWZfthTvhN6zNnrGuxXZZmz0Nvwy3",
+	"AWq2FW4ioI7geoAnO2JdvBB+sBaqzuqnBdtwQBG8bmmHrC5N3eLH8iH1ld+5qwFsQ99XciP/h7XYU3bI",
+	"uuw4fEwr7bKnuBXAyn1r6ErdCty7wZh4zzAiAP7DdmHnrMWOrHAbd72H+HpId4c7oxb7gXVh1+E2e4mv",
+	"4NizY43ONavVefezpusHM1P2kqd+AfRiW6OTzWC93rCt0Xn3TsX93IWPcw33TqXe9MVX1lDDdXy/sua5",
+	"5WHlUt8aCjfZS9bBTR0Mwxummu5kYA3V77iNchN20rHCL8JtdswOwq9gwdbv6hVvdMkr2DE0E28wYhl/",
+	"nvG3aHFmKkyeWL3sXq37weTqar3pBUmMr9bXKp7hKP8HYlWHHVvsmLWs8EuCPHzDngFP2bXwTJ+zY9aG",
+	"I3uI+NrB43nI2kkcRCDcqZTdhuF1/1t9PHGqPdayhtYqwXpzxbbWKkHVWRk2PVWwhNL9HlgvX2/zXUf3",
+	"fpwBurlGHb9KgO5sttNwN+p+Jagb+eifAd11ro6ABzJ4wTrEUxH1htjL8CFcxw7CLdYaPtGBaewwtpT/",
+	"HgkTYEucjMOHrMV2WYcdIiHDzvmiumwf2Ntj9pR14Krw23Ar3BQb2A2fhN8C0YcPw8fW3LylL78nR1PO",
+	"VgGgugPTEU9V1lw4YCTy5AGXncC08x9QSrWAvxHLOmAd2n/4iLU4f+6yo3Abvw13ws1wO1XQWUMfffTR",
+	"RyPXr49MTRkRwq05laqRG6y5nttwAre87CB63q43avAJFz4SVJC/J+5aD2oGIXR18fq1EX4Am4hBxO3C",
+	"J3DKRmpuVqvLDeKwJmHwp3CLHcBxhlvhY9amQ02BwV8e/gDgPGYtACEgD0eAcBP/fYQy4BieY1vsBb/z",
+	"iGPdLqLQEckJ5FfsBX8WFx0Fu1AJ3FpPwa0IjYX1egORhG/caTSce/C331wR/MBAMHcDAyj+J+IxMATa",
+	"9o7VF6Rzc7hIOyKksQmFY5gSbYEvmONE/EjTKWbBDYKKt+YnSUYia7qKs4cnrhEPMKttDp99gQzi5xac",
+	"aNtCRiL4y2G4w9rEP8w04zkrVbecXMhtp+q7+IYUXHxCLzpAHAIlGlgUfKWtOHrnSr1edR0PXlp1/GDZ",
+	"d71guW6SqP/KNbZjQoA9zkWQRWyilrMHG2X7tAK5VUJ9hIwOtB58YwCsEeAzYcB0o1FvzLv+Rt3zkTu6",
+	"d53aRpU+wm/wYbVehrtmbywu/+bGzdmpgl2oub7vrMG3DdevNxurruXVA+t2vemVcUkxRBKP0r+mB98v",
+	"uF6zBmtfnJ68vjz94czC4kLBLszNa5+vT89fmZ6iz5ev3VjAz7CmyYWFmSuz+OfktfnpyamP1K9mbyxf",
+	"npydmpmaXJwu2NomZmbfn7w2M7U8Mzt3c1EBTwR1uc1eUMedRNcnQR27ngBiOpEbpDDOp0gxoTH2JSQc",
+	"1JXN6GMXyk2XPy2G4z+h0D5QZDqx6IVrkzHz0wLk5qoBML18y1IZVNritGtSbbkGV+zzaY6x15peosJM",
+	"f7ytHYGEnukoFfmTcZDi4Qapy2k5KQSPw8fhlwldC1ifNVQcHZ0YVkVkUq7FZGA2eqw2XJA1k+n45jWr",
+	"VWAxwnhPYpi7Wq14bqpeAbsLH5v3Q9xa/mKF/xxucRt5bj6vJkDUNEXLMIHA9VedqkOLyvOo6eh6ZBON",
+	"tcEgdJqkQJi+HDhrJoD/iKT6GISObZGxwJ6G26ByoWInJLqEeF+o5AdO0PRVnn5jbnq2YBck9+as+2P7",
+	"1KmUv9s2kVYP8iT1sBezTagAXVJTwy2jmpquIBsQ/Sg31zxfZm4NAQGCzYP/brFdwJVwy7ZAAWKHrENP",
+	"QKUOdCzw+RwP596N4h/RFzw3T5oTNzpsC220rE2Q8fsdOzDqc6dHYa8XjqMQElA0obnO+5JmMf3Qn0IB",
+	"nitilaehqPKn2dpa0rcyrfHqhHHWQS9jh+3ZFvocn6qKd/gYHRcqAnFLlrR2yw+cqrsciYPl9Xqz4Sd8",
+	"fvyCPsF2EiVefVE6TNzG9N3VatM3A+UPrIUWSBs41R6S8FEqd4KfnqKd/yTJqFrsiHUQZHvhw3CbPZMf",
+	"kFVAXGBfZRMWSnD2lLWtcJPzFPQnDydAyvWMvgDqwqbLbnk5HbIqssb9b+hde4R+vRZxLw4l3Mwx/7uD",
+	"7vmHtoUbPZC+7U5kZJLHiO3Rw8LvBKpxB7/kp7TvU8GL+M4VMlIgmYUxC4FjkHkkM/1l6WGWJ1Hxgncv",
+	"gpFT8So1YHxF+fCKF7hrbuPE9K++steSfdVc1dcO3BE/9KEUcjgYdJmgHjhVrkP4fUMitltaWvyhpr0u",
+	"uFV3FVD0OreOY1j7b6zNngNSCgcsyWmT8lyyGo5XrtfICUMeiGPWFReEO+jA6NrW525lbT1wy3ThphW/",
+	"AiXrk/Bbe8njrtyXiM5fsQ6QsxKf3Ldob8v0SAyXCDFJi8EoFb3OaHNDTDR5tDW3tuI28h8uPOU63mM8",
+	"2ozIYEZ0TSzCdGzwwinXWQ0qd5zATTX7Gu5G1Vl1a+BY8gPw4q3dM2prW+hW3+Jufa5MQsDqQPAq1D2t",
+	"IfJVb2Pk8BAdrqB9fmv5Ts1dhtUPK0cgv4z0Cvzr417xgrxAygMav1kNTIqIuIKYmn8C5hNF1JY3TvQA",
+	"/9PKxkZ0d1wltYV5FIVC0BJH9TfcRuX3EMnlCZkE+xA2Uo4r3C7Y/a7phMiahGcCPvp+047uar1aKTv3",
+	"+o6ogJr1BXKnI8JkK/Jxcl//kfSi8ggLpjoAoNCj+hDs/W2Kw+BVmGsAmoMTBG4D3vZflpbK9y8+GIH/",
+	"TIj//I1JyOaDIHexZ+IyB0iGIFrnV/TFrgScT49fyWWk7YRzyMT6K/4yIo/6PsWWytK24Ld8K40UAHmP",
+	"rbw5bc3p4Yqye9tpVoPlmnN3ub7heqrsTgTEQavrgILaVSNbmldN+kLiKIh+qaeszV6QfvcUf9uVvn3M",
+	"9DgUrwBlskhRCroHrggfckYt3QXDPRlBreJJl8Zy1b3jmmIzP5PoR2bzxBr6XdOrQJpErVIuV13b8l36",
+	"u+o6kBKhhXRx2XsYlH6OsgX+4JHvZ6xFFgGkDmwBB3wKngLUg9lxQvsgSn7O9vhj5+aXvCgu1MKQEYq4",
+	"LlzIQzhKuLnLPQoJg+KSOBS0QdhzzNDo8A3sgkq0iSITAvSqd4uHp+mpFDlvc6AMo5KSdAY4lcbyesWH",
+	"2PPy5xWvXP88JXB+SMfIkaAbDwJ1wi9xtYpXRUTYFSnCjihmCitryfWGXwOahJsk/sX9EsdMXifgtCCS",
+	"YIMmpXDJE9ioPZKcfy+R6z4WAg3sGSALthuF+AlcNecuYep4sVjshbgbDfe221j+vN74tOKtcZM6xR7j",
+	"lhTXa81bSESXTZkIiIHHiJ8YnQsf21a4rcEcqH0TMQzpm1TkpzxC3ZahaK59WXiSRLqU9xZuX1ryOIlw",
+	"FVj4ULvqehCckpjgq29Yh7Uj+ddSUVBhtNKZK8k+4NpxVv7GCD9GYFdouLIOe6FsnGdvSBpFp8jcvI5O",
+	"EQ6mE/mpUHRK3gwaEX7VScUW4dBkLxEtdkXinMK+d9UThcOjc2YtPHgLVWbuwWUtffuSmhIekEuIbpTZ",
+	"x897CPyj7Cm+aAupexc5wAFFyYkXs/bwkpdBV0JImNypOsm9O3GxJ8n5wopcrnEzMkv90G1O8nGa3GDJ",
+	"U/gecpUQzzdjwlRy/sxzsFXnW1Ia7cbEsXTnRSkDFs+bRJ+fNRRuawIQ5dySl+HhpvMkbxg7Vp6r4n0L",
+	"CAi8RHPzMWEe/jPZZMCI0B7unOS0ENxCO89kkF2ZWZFEzRPAPycd9DimLjuWMOXaPheJEGda8hQDSIGv",
+	"MclsbCMKy4wJiEiYS7qIPewEMD+xWZWiZCZoLpV9GzU5s8Jh4IQp2JJKsyni16ReQ9p338aAVESzGAw8",
+	"+Rpe+MAuJECXrZ+jEtXV8sKOWVfD9bjiPhRjoIQ8Bu+0huakABmdKTr/0FT1cbMjQnGCpYS90a8WeZGj",
+	"VCJKgk6SIdsncumAfHmBRP+1AIfuDmxbQ+O05y768h+Jh8Mzn6Ef+wUwLT1OV29CiFpux2sK9xmcYrlZ",
+	"7SlGPqg3Pl0Q1/YgsTO1IlWCjdA3fiwCd9NIYfKOU6k6K5VqJTB4P1yvnD9QUSkbnVFmD1aeYEUsKMuz",
+	"hCnVbBvYrg0CqssRC27ksa+OFW6NspejRrXLD5zGWcSz5PnIKGYjQK+kErdIO4VruQzd9ITcNJLmxp/i",
+	"HCVbGWkbfkKOzr8BNcLoJP2ACi6m3GrljtswoAl4qmobgZo+oRz3SYJeZf6u5dxI5d4Bd3MKqdGP9LXh",
+	"Z8yDlPl7iZ89926wzPfY1y6SUfQN1yvDj3KHbhk/p0Deb65IfMgPCl6z08MJqEA4+SJ6iAJVDYZqjF4c",
+	"vQbFnvE5jlILymsNqZMniZbKVRpE7kZjlD/TtoRqMiq8xcpXDdf4Za1+B77ZaIxSMhR+XK3Wff6x4YLE",
+	"hz+AN48qzun+8ovc1YZrTG9hbW5ubQkPAtX3dC6hNQbyEs2Ub1TvyhZnGgdCE+2QQ+8FqQ2gzetP07KF",
+	"zwUXs7GPDrM3SqmC2RRSlMYB29c8HCXNciD7HdLypf+IHBtCgemwg788/Bf2kscNO+FXWOSW6laBx9wC",
+	"vXQZRYJt4WfXKw/rsYFnicKA0SWP/TeegKQuOG462/oGjvCEyfxWfUXoNuRmEdiHTzGYQ767KJMbJMnN",
+	"xcumIjCguX+qeybo/rtSRrIvi0ismcnZSRMuCRCkeHnApfOVDlLutz0GaXf1aun6dZ75wjUBBeEnLpaK",
+	"xeFkAGXiQSkjchIdkDmfkPvhuj1W1ed74zaYALC2HgVchpxrULy823U6n6DqUlqZrA6cREYGoVhrwW3c",
+	"qay61tCi6wfWouN/alu/capVa6I48Q4s/I7boOSawvhocbQIYAGG5mxUCqXChdHi6AXa3Tpiw1gZKyvG",
+	"NqJipDUj2/pfPD7WQZ28rRUmgLMSq/W2kLdto9NwX7PWo4iCUmOAmZ2Isi/wb+SCcBdeZgFlcL9UCzNX",
+	"lAqJZG0E4TpgOtqUM+VCqXDFDfRaK1sra75lNhKiS8bU8uIHH8M5UyANYTdRLBawNsALXMpAcTY2qpVV",
+	"fP/Y77h2HBUIx2JAuLBehoq+/IT4p2eYESohd2RlC+DExeLFvhaftUi9NsP08j+nFb3wKEeL7VOpNm7R",
+	"d1ebDTRlbt0vTJZrFW+x/qnrFUq3PoZD8Ju1mtO4J2wNYOu8aAtwB1y7FmaeiMqWXVMZSywxM6UWnFKT",
+	"b6F67xc+hsWNoVwkJPPHHCpw9ceqFT9QqCeBhzPKXbwq1r8G9yRQ0lR/rtUZ5i1AP118FTvNHSmO1wAn",
+	"lKQYMssX5EXncAeKT0Uxnurejbwhh6KeWGB98Ryx/kfWhoAG+j9QQUDDVq9p7hfhEVWFW/4J4rSAQ7RX",
+	"jDxpr2Et8gxhtHEzI6WSdRS0V5E2E/tJrUakqfsGApir+0YKmKf7CBFcP3ivXr43AI7KqvLMGvA+y7XN",
+	"6KiT4YNTJbVz3IaZqgCxRI5Q+3WmnfOWYxF8EpKLtfok5Z85gHVCDp+ohNxKa4PAafkktOq7gUqoSUkt",
+	"Kpf2Ne6i9gPpGLhLl1KSuY21Q9FktT5jbn7U0vs7tC1V8eNRuXiHgBZErvWcgla4I5cGoBMRJMwk0KEX",
+	"i8BYfJHP2XH4bfitSWdMY1ULbtA3n1JqVDlNF+qrQX0VSyEiWi5QfwbF81gqNMcLD+zT5RBn1bPirNmh",
+	"EzUR6UvfMOsXfTPCTdYNv+RZUK8xM7QwMNmxyJjmBBwRw5uj8/Oj4Wle0Q57sMp0tQd8NX0w0g1qzNKf",
+	"ys+7ufyyVH6x075Vfr7bniq/fEEumvxz1JqF92BKVfs7wvv2Rir9apOa8HHs2XFN31CRjc5FNVBM1VIn",
+	"oYF+FX9BB6es+GdKPb210Uka+py/zHslG8pK1Yt1RorZB5eSvZMo9RHZbCxfL3Jmi9RS+Zq2pjK+tTqS",
+	"jG9bkZ8qWbdiBD2QMaI+KtxOHGwavzkJA8m2Rv7N0KPL1iNf1GFCb1dI0ZPEMnmSfNSXQd+YnZKea24x",
+	"luCroHAl8veGEin55PJuyRzUffru8o2p6RsfzE7PLwxjBTmdcnoy4KjF/sgTfrXDGcCs6prwqmPIY1Os",
+	"KNZm7TxWlOD7A1pRus1UdVZ0tlYqbDj3akhdK5VqlWL0Sm6P/DnLtjo56+0rVe8krePOQeqIHmN9qXlm",
+	"te40pMwv1/iK+t9qWzp/6RED8MB+qx8VDmHQR7cz9FFdrmSLCzW71imXRSw023VlNji1SiHRMYC18EA6",
+	"bJdUYpuDRvl9V+YOH6HcYe3wm/C7+HVGBdtinfh1lJVxGH4bPoK6+yWPyyO9ickYVkDCjmWfhGH0m+Uq",
+	"A+toBRzUr5Z/Fob3S5GNFj4Md4SQS2PhSueZSeUMBuHg8e4mhY3GyHixOK57vy5mcugcHVLyu7oSrU3O",
+	"3tOlSbS0Hlu3Cs0JWA3E65sXCx/LOmjhH7QzgWloEVOYLJct33Uaq+tR9leJmsI8yJSIfTSwNEiDfILg",
+	"3+KFeTHCOn/On+3BguyG56QOpXYhAc6h9wE7b/Y/Nx/JpvwOOVjkP5zrIsNt9N5TZ6KeS+ZXJ1GkL0n2",
+	"PeSyYMLxdvit/rCO+Txb1MpNiC0F7U1iC7MK+7JulMI7WQZqdN+gtOqSZi6FgSyOi1duRZUsu1nyw+bt",
+	"qeLZ96Dhsz+yF8IGSMP1XW09SlnPMR0bewEpVrIr5nAOqXMZQXgm8mYgAdNDiLwK1b1fHt2TI/OCrE1R",
+	"yRXu8ERTOr5XwspeI1ZFOZ3hFmI08a3hPhnQHxTqfYKUImv14dE7vLRzCCuDIaP0JVIv1b/xQpkuLytt",
+	"UfP/4T7YE6biZvAnmba6m3RZQEVeVje+KLGVtSxzeZktS6aiHFpeLSX7VgHXWvISvdq3qVJYBqrjXhfZ",
+	"8k9XhdObkYZbwqtDLQOV9WC3rWTNJbiZDtSype6wluprqOHSq4xl/ScmniYL7RLg5TJRQnlEbyswPBg8",
+	"tX4DertNUbCsLhjzqkW1dgoSkCOLF4LxPNtEORDr8OeI9mydGGZpUCVcbxNmhY8trYmonSi3N/udk63/",
+	"qWqUaJGXOvPCd7XyHB+HLUlLS16+ze9FFXJ4I8DPTjTl2U14wTkeRlDOgo7WKVV9Nmsr+4psQL3/jy2h",
+	"iirDpsjeEApD01ut33EFjDNPY3Xd8dbc8vLtStX1LdaR++/FKEjXQAsdQ3uqs1VxjdpYl96SahLbDb+G",
+	"Y6Klyh4FCFBebE+qHSZ601LjJruaWw+vE0UC4VewEgozUyvAba4woZMHuPA+tArZpfyTI0tAj7V7HNmw",
+	"ndZmIYmYKS7pHriXR7Ei5j+AZpWwRrXTx2I6L4BCx+oY2ZxjFa/s3h1dq2PhmgwFFMeLy3TBqP8Z1jgO",
+	"ZNbG2grfKuD78MkZGl+PztL6zgwlj9vUPqsjlGS1+fUXqK4cyibRhnpa3mHlIbJW82iVvqqPXlGXZp1b",
+	"6r08iHQ0V5zOmnI27OkDDgM2sT2ZEj9++v6fU3f9ZNBIwi9kF3QBgGrsqfmKkk9PNspNdv4+1pAuvdEd",
+	"XBh+LRvdJc35/pApj8WkxiZfTawCGQlu9YBHK3SInX8E4vdCMo3pMeqkORc+zm/QZQwAUQdyRANA5uat",
+	"Stlyqg3XKd+z3LsVMIt0v+epmYZYBNVW1f++s9w4GknTsJPRuMTkvAJea02k+Gl6KBD5jciy0q47l5fL",
+	"2BWF7K8DzOC1pW21a+6xQpq1LAbTgoTKI3GbspdzB7tBg/b6J2XODumP+3Q970IArSo4Ziq6V/TYRBaB",
+	"SH3JOKAutbEmFTQZYteLRCV8xEEeI1W3MUYVbZtUeWrvtRnV5XEFMb2WWJ0zk0NRFP3YzyjmI9pIFLy6",
+	"hU+8GyghmBLIwAEjQX00qpDVhYae2v/xf8koI7I55rWOqL//xyH+LM5XMWb+43DA2YGpwamMbhTnHaXC",
+	"ANU7Ri0lmqdyK9bAvwD1qiPj4yPFC4vFfygVi6Vi8bc58eHj04182aLXcXl5BXhv853CaSo42sMzBud0",
+	"DZ3hFD2lVzZJQX9TrpDbnyJmQriOzn3bmF0WcaVXk4Nh8dSuF9ynyF6q5Ps2rpYnrmbnWaxy7uaApti3",
+	"ZIda90rSL2LFN9s9tR/7PhbZpqlDitDGBu/ReDxtPka4qaNJh49rjFAlfJxft8EGIdm5zcoTruPVb0NV",
+	"p2nlRvOquLwojkxcXByfKF24WHrn3d+eWgoEkccpJ0GcPMAmlvNXGmBTo8hpIbEoinyCYgpQ6ba4dg3v",
+	"I3uDoH5W0TZlfpS5w4Zh7lXUAEbp9Zk2xMvUZRNSl+N9GTO98T2acI4ueXPz2gPE+WDmACKznFYuEs4Q",
+	"aBgMBAd2Sp8OBXp8sGRhUKYjmzbeig1IS9M9k+pr043dcFG7YUAnnDIKkvTaDPajdKDMVYqlT+fs5RpN",
+	"H/OSVo6QnJCsJZKI9iwtdkTuuD0+0wXwydQwctA+CBHRJBJcMHXzGBtNb6O9z6NGgLP56Vd4H/LEy7W4",
+	"mOW5n4vhR7ah/7yx4+wxldhEmoxsLpU6qm+T+u9gbdfzWKanKIqwl7zwEYWFOwn/ipJla0f5q6rHXiZi",
+	"txVzmUdMda1M9Y5cwmApP23NkEjxsKS6uXK4K+bFMQ2giNWr5eUEddon08+Uw0/tk8XbwYnGly/T85jh",
+	"oBWEeUz9YzEJYUfOJ+chJaPnAbaW1c50YHVSf8Vr7Zx460M4DR/Cn1O9mm2tXzl+2T1/18HPKhNFAlNI",
+	"ss9UXqK2qHCgzY5tRX8iSRPL87dMaf7ChE6b+a6yz+E3zLfBKZfC7hSMmeS0i3uQQl49p5SE37QBtXec",
+	"atMYGTLMXY8CRPAyq+LLEJFgKVZQt4L1io/52w/kGGV9tRh7E0muWqas0pBeDl5NXaA6Pz5a2arjefXA",
+	"kl3m655Fa5BL8uqXHa9cEZOu9HXRNM14IpzJc5O1tNiA+mh1Xt2ixtWWMjTOWhXrsSoe9lIVCw3Mx90j",
+	"3f5p+JgdDo4AsTn80SaE2AAEAFinHP7phQvZj1IllfxyT3RMFEekZEJlBJDCnb7V5+STlNJfRSVJFSfc",
+	"xyeSnOgyiivyVD/NzOxH0YbWAKZ6rx6Kn3bb2ZcoXXhbooTOu7+6wqRwk1j7X3sCfM5anfyRhb5TJFJr",
+	"UbD1SZRPr5pM/TCi+oabZe//aKjNMSZx6hkKIo3zEjrNUn18kLvwpRwq8oyyunuNvxntbR/jpt6GKV6r",
+	"ihphRL6tpxmonuanqOOM6gR8YqyTO0U/P87MHlMEY3pTMD4YXFw5qGzGNyNgYuPQ30k0E0xccsGQVxEb",
+	"Jf73udVd8+BzY2u9cIv79ahHwIEIVJhm+A/cHjf+snDbwDdNM8rIGMo4dDBpwGearaHCINjJcnkQhisn",
+	"it/SRlxRLzjtkNVxRIXJamXVxXPPumlCv+m9+grhQc+mKb2GBJ9JtrQYKvmqQbLirH7qeuVM5VSsNQeg",
+	"+u+TorVA6iMhJyNpdnF68ropbVbu+wxTZ+O765VGm5YnG2tchekg8ancGCEZigAYfhdujeG8RhIeh7LJ",
+	"UUqzyH1VGsAJahwhGhjTmzFEs+YH4Q/mSf3aBH0j7vZDzepUfKHwnL6laZisfyE5K/9ibPr9+CnvD6f+",
+	"G9PldKUg6d5+Pbv7nLNGlx0RSNYf9ini/xWpeZPT5A4V2kYueXk2qdQbz38IN7mZSHkK4abo4JOer603",
+	"jE2wAK77pamAcP0VN+h7GAncN+vU3IHGkbxGesWJCDWFMJ+G/5UKXmKn+0tst9UjFTKu4+aVeT0w9vK6",
+	"A//3PLeaA3nVq18JHqdpXavRFjJb2Cnrj7sexCNytq9DDYwdIh/Riv55WS/2ovwaE6oO0bdOp/EKEFOs",
+	"NMYAY4PIBjS52EHqe3jlzDZGTinUcZjXADOibL3s1j/3eljcAmOji181411V1l34laX879fOas0d44yR",
+	"Ru33Ypgx9NdAMkh/yuhSW31q7gyxqH4/0ZwjbRY/dtjblMnlhtYAw7/89onGZt6mZgfpATQTLVytVytl",
+	"514eSpCXvmo6WJdrhuogtJgo07I4PoKhIo737EfsU4vJURBp3DsdVULAoWfv5RYairx9b4KrZfWhJ7v0",
+	"8RuLtelw6YGuC24QVLy1POgqL33V6Jo6UB+crYZB+aVCyqz80ngxZdZ96bZT9d3Uefz4xMSc/VIxMdG/",
+	"VGg4XrleSx+2XyqKn2Lj+eGHgUlLnlla3oPW/foN0Nl7UMtxcss0rPcpTwgzV2nnpijKmYip8Nm+p/nE",
+	"Lac1jOFESsb5x/dOcZnpNoEyJeHVVE3quegQZSFHiGhsxgdntt4Ye4QqBTH9UgSBDAZHuDMoyflukEJv",
+	"vbt8JjUIzi5aNDZtV7GiKDLaxRzvb+Ti4fwMfQRsNb2+bVFHcrVa40BLUIC2Az8Znq3M6FWbgc3dWFgc",
+	"0dAGkmI/ub9UgDLtpULJWiqMjo4uFR58Yon0fsxgCHfESGBlTAOf47Ar+3Z1sL0LTp3rWgtVZ/VTam6m",
+	"IoPIsVDsad6mS6ZcitUmbW4eZtzGPIpDADfpaZcsfRICrgIVzhdRV1Nt/oFi43Zii4E1/14dXLxL+iH1",
+	"D3vIZ0W0aZ9YhmYNVeurTnW97ge2Va3XN0Dk2lbkw8Et08T3Q24LPRXfQvZmC+urXtD7h0VWMa1HwgN6",
+	"pyWtKwSqNXnt2o0PlufmZ96fXJxe/mD6vas3bvyn5cXJ+SvTiwtpRRdczg8iQWKRvbjKAYpIbaPqBMSw",
+	"ZQVd4f79URFvfvDAtjaqruO7vNDN+tX9+6NKuBY0wAcPfmUF9TL1xvrcXVmv1z9dxqH2hfUg2PBLY2Pw",
+	"lT/qI9at1oG8cc62P7ZYLBbH3oN/Pvzwww8LPQJ+6Y20tK30ck0tyovjC+7HbFdvPH/5+io9cfrUiEuy",
+	"7/EuUlzb7KiLs5XXpX3TvjIJXWTbqSv95evLf+DloefpP/Tj/sO0qRK6LwvTtzqUgccb72yytnWlElxt",
+	"rmjeG9k9RpNK7ADzEtXhEnGtAyTID4busaKKgI8156W1e4qIbYuyP7Hg7qjFvk90tfw1zvQk8fsy6qWp",
+	"yfpw0+LRnCWP45wI59jWr+uNNYQiNiiNpsztqk0XtD3ZlltzKlW8njwmh+TzE9VqbD8SeB1t9H8v+aP6",
+	"dU8sfnK7ZH8FTSrlb80LS95Yub7qj8lvJgZ32yZmkYKlCCj9HBGqS32fqKmk6jE0Ffydlcf3lCP/jSZW",
+	"BF3oG3L8RmNl5aGoHaUOyLFqit0IiG3sSOpVas0a+T9ovzixzG0MAEZa3Ql85nER1o80ysjxmZl9f/La",
+	"zNTyzOzczUUty6fi3XGqlbKCTSULujVZEyVL/IYIYS0VmheWCoXTrYQxy70kq1Wx/Y0Qe2LKwukFA3w9",
+	"GJAi1v4QM2kSjRtiPSC40IlnT4xaJg85b54nBNNWvKcEawtm/zgZrE4KxCWPhKnFHeyPIGWL6vdj04/I",
+	"zhUPkVPwwq+pj0Vk+Froo+MyONwRGqDo8NeiEbNdnlewx1tj9ZiWxCWSEl85sTw649CIzkPXFXTJ1VxC",
+	"iZ6Yug+fkFnKZZyLxPklBJ9+UspGvpPOD1PsJXcQ6hWbMyJlq/VmzMLTDJdBQmK+HhJLLThqx/y5x1GT",
+	"f2oCEi80snijym1u4uYrGOKcTAm9nZiT9R81812vUm+kxc4mUmNnlIOWGjoDh8rteqNmDKFNXDTE0D53",
+	"K2vrgVvOiKJd/Pu0MNr4u33z4XRQJTAh5zxAYTGbRKzsqwVSjlTkttpo5lC8AvqhFslyo3vgCq6adiJM",
+	"Gu6pRJtOO7GzfwVZi6/mtiFpgmL2CGgsxl7xGZXKc/MZSSfSXYoRg5ZhUouxkYoRN+8nExMPqLu/brx3",
+	"sCtRrCXEnqBgPoRFeONjrmmsCofKceX2vzz8l/i24ciSO8Mzcu7SGY0Xi8VeR2amNKOTBLb2kuuLW5wn",
+	"mpwytgU1OHq3dvChsH3CUlVTbJMi+RBbv+9E57BSr1ddx9MGFMTo/X4m7x8hGCJFALQ49M2ddETxJUw8",
+	"Vji6AeVOH8+SrCq1cZwYeZJcVVZLNzOigG6tIcu7Exd7IkucgWZL6wVx9XW4+EE6j01s+HtVz9EYoNKR",
+	"O2vL2Bod/mFPyfEVBQUxDIW8b4/4Xg8iygUXo4C4n9ZXjUZaGfhYjj2lNyDfjzWOOpWdvWah/rdqRz61",
+	"Y6D0nT8plVKKUZJIcXmNpl/EW2Vqi22zg1++IRIdytlnHCUnVPfwM72kZjKpQ0m5c0ctaaRxDXbm0ARM",
+	"bzhSyoGQyRnKgVhn1GI/GxtIEYYYJuI9NvWRlO3CtJiO7kwypA+YaguVjtTbaf4kqALxJ1U4D2CHufCQ",
+	"sltejhXpyIb7Ncdz1rBzTqxwPNVeST4yc/zCyRvgiAvt5CvPRZi4KqLT2MzsTrInBnYvaItF5CnFj9Am",
+	"Ds/oUblCExoNb+o+qdcoSP4iWub5M/Q/52+U17eHiW8qmusDMeGj1FawMIswYmdRl2veqkt+0Mb8IK/R",
+	"GPyaG0gMyszTxluvaFf3m6oNj5gpn1aitqss+9bJCXa8J8FSz4y8w2pcDZq5HO0GUk6620/KRmkp/XKA",
+	"8HEq4r05VJdI4H6hA2AXvwBL0eADSAVPD2qjw85DafzKV0tlaoems2yyLtP+yNA6zdZyH59s0lR++lXy",
+	"EBfW643glMhXX0wuCv5JCe/Ozf8tKaZvIB33WTw9N/+3OP35GduL4ijGdeRqO5pO35XaRr0RTN5xKlVn",
+	"pVLF1acaTT/xseIg8nf40J4j1lZNlmgAeWKgdtsa+nDk+szl+RsLN36zOHJ56sbIzOzi9OzU9NTC4uTi",
+	"zYXSjRu/kW37Dvg2n4lBs0vejWZg1W9bN27frqy61pj1vkPHDoOLX/Kd86ly6qBowC1K+1amG1O/Wfqc",
+	"2iUQnL+Q9ZaKBl3+3j2u4USmI80UfwjaDFqF5Lrt8hnxxlJ4EfPg8C1Rapot+5bqmWktgIh4SPhEwg3d",
+	"1+wpAII9B2uWZ2ZhGvsOZSLwkQd8ong8sc5ScurMNmSHNyd7SHE87oh+jgartgfwO7OudXNmathobtLJ",
+	"dGly4jFrq8Y2XrAXbkM6afhk1NKxD9a7+NuZKbwN4bvLM6qOhVMRk2VxMndbWzLNJFSGVidmgdB48ZoT",
+	"rK675WX3DnCNUUvOUYhKVY4NlSw4yyDWOVFkdBF1kFt3Dx39O3bc6yPagcPXlIv/kNNy2xqafH9y5trk",
+	"ezPXZhY/Wr48eW16dmpyfnlucvHqcKa1PpMk8wFs9sqqD70zpq/MzJbeF6tYaix5/Kvp96dnF+HvmzNT",
+	"pTucSkfG4ZuFm9evT85/VBK0C99NLS4sTs4vXnp/8trN6X+ENs4lkLnj48UL9PP07FTyx/G/gx9vzF+Z",
+	"nJ357fT8pcuz//hefaUENBPUS82JX/MFQ9o8XNmb98BV07NTyvrxL3WDWaIYodJvluQo3GXqgJ8QnQN7",
+	"C4jVg8weTzYvmpDNi9ClHsf+HtOVo0fnSnjUXGo8dijYV8Qk1H7TlDt1nMHCC3YB3NmosVW84N2LPcO+",
+	"cRDkWrp5ikuaN1DGRaksCuMZNOXliHdUJdHEjvpfvjyu/CDn3JPt25zb7sjs6m8gbiO7vMYldzRdTzS8",
+	"pxw28EjKyTV0f/8bSeJatuJhWCGy0ex520JTy1CmovmBsVZQMUEvjC8ZADbNZc0jnIZPPjReUlyECAZI",
+	"JpA8d8WKKqOeJMQnQa4fL9tAyb+rTtX1yk6jZDW9wG3UKh44Tizi0+eS8csOTDAx1azJ2c6PSLPr08L/",
+	"ownQhG551Fg+PzumY1iVyxyC1hAInOFMk4CKto1BlBTtYj52xxmEA07V9//Wwf/LcPD/TExYhnNftTNd",
+	"DTgM6sqLusSre8p24WWSre/mNeN/pBJoIbwixpLdKt80E83kbxi1UAN5RiHkrkg149M82HF0O9b+4h51",
+	"tUorBs+Yu2ZWvFpRI3tlxI1QIWV5dbTxJS9155j2hl+pJrusfds1NrsPvxQZiWhoPhJSPxn+zTLZFtxT",
+	"s9dcr6ywjPG/WywWI5YhWYMw1Mgf2YhzGeWW3JEFD3ms1AchnX0kqGBWfT8BWb6cvE86AXunN9i44nPh",
+	"7E6MVDOPqBLZbAOeVNzmKWacXnyJWbwTMFbD1vO0r7hP60W4bQ1FvQQE41FGdIWPIp1+uF9DJYY7Tpw2",
+	"+9ez/xSxxjSFTrOUu2esbqtbslyvbNWafmCtuJZzO3AbFqHaGWvb5KsUZzdEPJu1w6/4kM/wCcylUk+z",
+	"NfxmRdX3dH2/27/bGg1SapayDYLSBnHU5X5jIDfUpYZ7aRNTlTXXDzL0iO/14jme5HTMR8ulrAt9IVzo",
+	"Y7Z++IWYf9BJ1qq0EhXbw7amSvAJszzf1JqauTK9sLh89cbNeUt51ZInu7kAhh2F21IBIqFND+XF4PFG",
+	"M5G2swk8iu0pedNxTsUDNl1DLKYUq4Qy6g5Q1Z6cxEIhIEh+Rj+4sghR+ZCWE5ayFe7XP0THieIxEa2w",
+	"dac2hqJMKWaXSHUy9K6JwWpXJvQiE8fHdTMAvcXx50CUR3e0ZjtwhmoxfruXJsVxeRAdClADU/xXVM8y",
+	"6gzOSlUoBn0oR/TABFX9PuqpIUsvBCQ7lywialMiv/BZieCKBI9JR5KLjr8fO/1RVY2cAirHZst1GEse",
+	"+ta7zr/5S1nytCwBQNiiZBvry+cPySXjk00FNTC+hq1c3hxpqsKeu2zbWMFENePU+0rlVNCmXLE7pUTo",
+	"JSln/Eneb76nq2xBuXqQCFzU4p635szLdpQ7758GDdvKE8/FcoIXm0HA6xioTiF1/kbPcQAZwBPv7mUK",
+	"5ZydYypZSB+0ToQ5fr7MAmbQELG0o/g/yOEjzBFHNwzbJ4F9ELWTfBPYx8+yXD2qXwi/AIuDPVOdOb3V",
+	"8V7845qoOU1RtH/Wi0wzyhVk0V5Cb7aSxUUlU71qx6C5UkjhOVamUR3bkod/HFJBaDSeXMC8E0UJI5Xt",
+	"GWqKfC/HqHqC1kJJwOx5uI03iuZTqIJoj6tVyuWq20vVI2AOwFsThVd5WassHe7FHmiJJ+O29JJXxWlJ",
+	"xU1A6K+C8563mqbFjQ3V5m84p9X2emLWet25e2PD9eajjgVp/fTMe7PlhHrWYc8w2WFf60HAcw+7wtmS",
+	"LCBLrRHLqiEzlcFBKuJ3aO/Hq0eVFYE6+5S7GGIC4iBecfhtWufZZOe/TJYbA/IgMyUTZbEX8vPf5M33",
+	"I4/uuDH15PWxWPPwXSN03vLe8zKRJY292ZxX4SWyg4nqLD02hWkGrTPxRfXIBxyPM7zOKnPCHgPUnKMt",
+	"MyyPRLCaSopF4XrUybObMqgc8ZC4OSIARGmpTTdPura4RN4uZQaN+WW4lOLoO1ordRE5xqiV8EXAiqGA",
+	"jsIf5GWlSEcEWGy93cY8cfX54724swbXgeZ5aoymOPpOftYcu1eN5NabK1UljOs1YfrfCZVj/TWvllmb",
+	"wPWWM58NZ959sxyXRq68SzGBtMiaUDZ3udXwteDVOjds92pIbWTNC5BU2qy6meMkzG00k+1ihvgoSOyW",
+	"icuLR/2wCIzHCPXgH6ZXRjBQyzhytOLE4BqxdnJHZHfjJP8EdnmNUghUZ343ObXV1IsFS4goaoMRNshb",
+	"+ae659oWXLaMcXUwCPAviLvHVXiqSVGipb1YvjyvQSYf8FUWSoXpJnCdset1f7X+eZJhiXUXSoVxyEUU",
+	"X4nclHHIRsmcUCBflUCrfwcoRF004Ox2wk1rZnJ2kmK2ata1FV9oH6lB6jaMmSpKFoJoAvaM2jUdQ2D7",
+	"6tXS9etkLRpMwYmLpWLR2EhLhZQpU0/krPR487CxiMQsJSW4tbcrAHg9vEvk7jN6l3yFH2XgaW7EfCuX",
+	"z8Jb9chMu0rivDH7J73P3pvt8Irl0ijs/sQm1cKnlWp1kHbbLVQdSCIbilzW6rblf1a1rdsNPJDy8KjF",
+	"fpR38YSeDsVXwx3ODNmB8Omj6SOirs+odAJyZkFi/jFb0Of2muGVEGMINy3ZDQ2g1lOIEvAGEKE+B/+t",
+	"wlq9YBf8z9Bxn9dm8uXh5a0ROlnqK73mXHj+Lx8gSQEtcX2Q2Qhn3mouIuXOG5oueayxnb74JR9I5Y+V",
+	"Xad8zQ0CfXR3bDc/sK41XixGgYEW8jdIswQG9ggZz0tR6cnrP9lLLF88wFTDLiX/Yf92+P3AoudgBt5R",
+	"svX1FTf4gC9xSlnh6WZGudXKHbdRcXUCyzp4vqYpuvNez5pF5RU5iautwfhQaTOh9xkYfA5q7jdFGCSO",
+	"JI5E1YofKNiTepTXKn5wumfoN1ckAPs+xgXl5p5Hqb8p3yAZdCaTdoE0Kht9A6OiTmLgihh0ALT+mhzn",
+	"1XA5YmY5nTENX7YX4c0pFIzBBGac5vZIZM5yTzBWtIfbiup+ZAkPBSybHAgqk+iyA3P7e7GDebni05qN",
+	"y593j4vKRGFEj2II9fZXkNTJ3943v0rZRT58/kE9fV49F2MgrSRKnL9KkFinELo0B4d1hF6tL1VYaEa2",
+	"GH55/kpE6j4GGoctOttkCwLWjQkC1s7BWDiTXHHztlTSWUrvEbfhpvX/L9yYHRENaXDqbaVsW4C9tlVf",
+	"XW02GlgxbFtlJ3AefAKGFY53ArbJTfMD65MPR/geRhYqa54TNBvuyMQ7735Ce5btS8Itjb8Cg6M3t6xP",
+	"/HVn4p13/3GpWSxeWF137+IH9xNr6Or1ycsjC1cnJ955FxYc4/dHCY49bC95yoKmoZPByEz5E0wIm5mK",
+	"yeOoQ364Fct7MMwpgCQKC0cx74pqW8Ciibt3teuiSgRoaGSF/4xjvl6SE5AdY1hQnRDI89wISugW1kpP",
+	"/x7xitLzqfpGVwrhCNheHL2VnkSy2FSXLln0CQct+xUpIDeVd9hUr8Ke4e1tpIaH4baAwah1FoODl7yB",
+	"Jwdbpzs4WJDxgiTbQcpFAG2XgQ6RRWw0Rnn5fsGGP2puY81FWamP+1UqS8aEdkKpHlmVJOq7+jGOfXe1",
+	"QUpq4tJcQ33hIlt7/cmE//gp6bwn0nQzNNuTKLYtvWaz9RpFSbnfNU664G28OX/NNnSrwftQ3r0c3OIS",
+	"MEJ7nVSOYxow0xWZJSczuJpeHkn7vSwBbFsikBodG462kAVUkbOxTdV8z2nwhpzInKkdJfaQrc3fVJZ/",
+	"Wvq8isUn1Onjjzh/vf7cNtGLoDlesHZE0OftPlPXM7jmyxurGG3mcDuF5B7Ir++LIYQ0fuGBLb8gr5ry",
+	"hdJpVvtePlj5bgYOkYhE+/6q61SD9cKDjx/8vwEAbrLTng02AQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -14,32 +14,130 @@ const (
 
 // Defines values for ErrorResponseErrorCode.
 const (
-	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
+	ALREADYASSIGNED ErrorResponseErrorCode = "ALREADY_ASSIGNED"
+	INVALIDINPUT    ErrorResponseErrorCode = "INVALID_INPUT"
+	NOCANDIDATE     ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED     ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND        ErrorResponseErrorCode = "NOT_FOUND"
+	PRCLOSED        ErrorResponseErrorCode = "PR_CLOSED"
+	PREXISTS        ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED        ErrorResponseErrorCode = "PR_MERGED"
+	TEAMEXISTS      ErrorResponseErrorCode = "TEAM_EXISTS"
 )
 
 // Defines values for PullRequestStatus.
 const (
+	PullRequestStatusCLOSED PullRequestStatus = "CLOSED"
 	PullRequestStatusMERGED PullRequestStatus = "MERGED"
 	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
 )
 
 // Defines values for PullRequestShortStatus.
 const (
+	PullRequestShortStatusCLOSED PullRequestShortStatus = "CLOSED"
 	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
 	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
 )
 
+// Defines values for SelectionMode.
+const (
+	Random   SelectionMode = "random"
+	Weighted SelectionMode = "weighted"
+)
+
 // Defines values for TeamDeactivateRequestReplacementStrategy.
 const (
 	AuthorTeam TeamDeactivateRequestReplacementStrategy = "author_team"
 	SameTeam   TeamDeactivateRequestReplacementStrategy = "same_team"
 )
 
+// Defines values for UserLevel.
+const (
+	Junior UserLevel = "junior"
+	Lead   UserLevel = "lead"
+	Middle UserLevel = "middle"
+	Senior UserLevel = "senior"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	Dead      WebhookDeliveryStatus = "dead"
+	Delivered WebhookDeliveryStatus = "delivered"
+	Pending   WebhookDeliveryStatus = "pending"
+)
+
+// ChatChannel defines model for ChatChannel.
+type ChatChannel struct {
+	TeamName  string        `json:"team_name"`
+	Templates ChatTemplates `json:"templates"`
+
+	// WebhookUrl Адрес входящего вебхука, совместимого со Slack
+	WebhookUrl string `json:"webhook_url"`
+}
+
+// ChatTemplates Шаблоны сообщений (Go text/template) по поводам уведомления. Доступны поля .PullRequestID,
+// .PullRequestName, .Author, .Reviewer, .PreviousReviewer (reassigned), .Reviewers (список),
+// .DueAt (overdue) и функция join.
+type ChatTemplates struct {
+	Assigned   *string `json:"assigned,omitempty"`
+	Overdue    *string `json:"overdue,omitempty"`
+	Reassigned *string `json:"reassigned,omitempty"`
+}
+
+// CodeHostAccount defines model for CodeHostAccount.
+type CodeHostAccount struct {
+	// Login Логин на хостинге в нижнем регистре
+	Login string `json:"login"`
+
+	// Provider Хостинг кода (github, gitlab)
+	Provider string `json:"provider"`
+	UserId   string `json:"user_id"`
+}
+
+// CodeHostProject defines model for CodeHostProject.
+type CodeHostProject struct {
+	// Provider Хостинг кода (github, gitlab)
+	Provider string `json:"provider"`
+
+	// Repository Полное имя репозитория (проекта) в нижнем регистре
+	Repository string `json:"repository"`
+
+	// TeamName Команда, по правилам которой выбираются ревьюверы PR проекта
+	TeamName string `json:"team_name"`
+}
+
+// DigestPreview defines model for DigestPreview.
+type DigestPreview struct {
+	// Date Дата сводки по часовому поясу пользователя (YYYY-MM-DD)
+	Date        string    `json:"date"`
+	Email       string    `json:"email"`
+	GeneratedAt time.Time `json:"generated_at"`
+
+	// Html HTML-версия письма
+	Html string `json:"html"`
+
+	// PullRequests Открытые PR пользователя — сначала просроченные, затем по времени назначения
+	PullRequests []PullRequestShort `json:"pull_requests"`
+	Subject      string             `json:"subject"`
+
+	// Text Текстовая версия письма
+	Text   string `json:"text"`
+	UserId string `json:"user_id"`
+}
+
+// DigestSettings defines model for DigestSettings.
+type DigestSettings struct {
+	// Email Адрес для сводки (пустой — сводка не отправляется)
+	Email string `json:"email"`
+
+	// Enabled false — пользователь отказался от сводки
+	Enabled bool `json:"enabled"`
+
+	// LastSentOn Местная дата последней отправленной сводки (YYYY-MM-DD)
+	LastSentOn *string `json:"last_sent_on,omitempty"`
+	UserId     string  `json:"user_id"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error struct {
@@ -51,16 +149,35 @@ type ErrorResponse struct {
 // ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
 type ErrorResponseErrorCode string
 
+// OverdueReview defines model for OverdueReview.
+type OverdueReview struct {
+	AssignedAt time.Time `json:"assigned_at"`
+	AuthorId   string    `json:"author_id"`
+
+	// DueAt Срок ревью по SLA команды автора
+	DueAt           time.Time `json:"due_at"`
+	PullRequestId   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+	ReviewerId      string    `json:"reviewer_id"`
+}
+
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
 	// AssignedReviewers user_id назначенных ревьюверов (0..2)
-	AssignedReviewers []string          `json:"assigned_reviewers"`
-	AuthorId          string            `json:"author_id"`
-	CreatedAt         *time.Time        `json:"createdAt"`
-	MergedAt          *time.Time        `json:"mergedAt"`
-	PullRequestId     string            `json:"pull_request_id"`
-	PullRequestName   string            `json:"pull_request_name"`
-	Status            PullRequestStatus `json:"status"`
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	AuthorId          string     `json:"author_id"`
+	CreatedAt         *time.Time `json:"createdAt"`
+
+	// Declines Отказы ревьюверов от ревью этого PR
+	Declines        *[]ReviewDecline  `json:"declines,omitempty"`
+	Escalation      *ReviewEscalation `json:"escalation,omitempty"`
+	MergedAt        *time.Time        `json:"mergedAt"`
+	PullRequestId   string            `json:"pull_request_id"`
+	PullRequestName string            `json:"pull_request_name"`
+
+	// RequiredTags Навыки, требуемые для ревью
+	RequiredTags *[]string         `json:"required_tags,omitempty"`
+	Status       PullRequestStatus `json:"status"`
 }
 
 // PullRequestStatus defines model for PullRequest.Status.
@@ -68,7 +185,15 @@ type PullRequestStatus string
 
 // PullRequestShort defines model for PullRequestShort.
 type PullRequestShort struct {
-	AuthorId        string                 `json:"author_id"`
+	// AssignedAt Момент назначения пользователя ревьювером
+	AssignedAt time.Time `json:"assigned_at"`
+	AuthorId   string    `json:"author_id"`
+
+	// DueAt Срок ревью по SLA команды автора (отсутствует, если SLA не задан)
+	DueAt *time.Time `json:"due_at,omitempty"`
+
+	// Overdue PR открыт, а срок ревью по SLA истёк
+	Overdue         bool                   `json:"overdue"`
 	PullRequestId   string                 `json:"pull_request_id"`
 	PullRequestName string                 `json:"pull_request_name"`
 	Status          PullRequestShortStatus `json:"status"`
@@ -77,6 +202,29 @@ type PullRequestShort struct {
 // PullRequestShortStatus defines model for PullRequestShort.Status.
 type PullRequestShortStatus string
 
+// ReviewDecline defines model for ReviewDecline.
+type ReviewDecline struct {
+	DeclinedAt time.Time `json:"declined_at"`
+	Reason     string    `json:"reason"`
+	UserId     string    `json:"user_id"`
+}
+
+// ReviewEscalation Тимлид, добавленный в ревью PR после stale_escalation_hours
+type ReviewEscalation struct {
+	EscalatedAt time.Time `json:"escalated_at"`
+	UserId      string    `json:"user_id"`
+}
+
+// ReviewerExclusion Запрет двум пользователям быть ревьюверами PR друг друга (действует в обе стороны)
+type ReviewerExclusion struct {
+	CreatedAt      time.Time `json:"created_at"`
+	ExcludedUserId string    `json:"excluded_user_id"`
+
+	// Reason Причина запрета (например, руководитель и подчинённый, соавторы)
+	Reason string `json:"reason"`
+	UserId string `json:"user_id"`
+}
+
 // ReviewerStat defines model for ReviewerStat.
 type ReviewerStat struct {
 	ReviewsCount int64  `json:"reviews_count"`
@@ -89,6 +237,10 @@ type ReviewerStatsResponse struct {
 	TotalReviews int64          `json:"total_reviews"`
 }
 
+// SelectionMode Режим выбора ревьюверов: random — равновероятно, weighted — с вероятностью,
+// пропорциональной review_weight
+type SelectionMode string
+
 // Team defines model for Team.
 type Team struct {
 	Members  []TeamMember `json:"members"`
@@ -115,6 +267,19 @@ type TeamDeactivateResult struct {
 	TeamName   string `json:"team_name"`
 }
 
+// TeamHoliday defines model for TeamHoliday.
+type TeamHoliday struct {
+	// Date Дата в формате YYYY-MM-DD по местному календарю участника
+	Date string `json:"date"`
+	Name string `json:"name"`
+}
+
+// TeamHolidaysResponse defines model for TeamHolidaysResponse.
+type TeamHolidaysResponse struct {
+	Holidays []TeamHoliday `json:"holidays"`
+	TeamName string        `json:"team_name"`
+}
+
 // TeamMember defines model for TeamMember.
 type TeamMember struct {
 	IsActive bool   `json:"is_active"`
@@ -122,12 +287,112 @@ type TeamMember struct {
 	Username string `json:"username"`
 }
 
+// TeamSettings defines model for TeamSettings.
+type TeamSettings struct {
+	// DefaultMaxOpenReviews Лимит открытых ревью для участников без собственного лимита (0 — без ограничения)
+	DefaultMaxOpenReviews int `json:"default_max_open_reviews"`
+
+	// MinReviewerLevel Уровень (junior, middle, senior, lead), которого должен достигать хотя бы один ревьювер каждого PR
+	// (пустая строка — правило не действует; для PR джуниоров всегда требуется не ниже middle)
+	MinReviewerLevel string `json:"min_reviewer_level"`
+
+	// PairHistoryWindow Количество последних PR автора, по которым снижается шанс повторного назначения тех же ревьюверов
+	// (0 — повторные пары не учитываются)
+	PairHistoryWindow int `json:"pair_history_window"`
+
+	// PreferWorkingHours При подборе ревьюверов сначала выбираются кандидаты, у которых сейчас рабочее время по их графику;
+	// остальные добирают недостающие места
+	PreferWorkingHours bool `json:"prefer_working_hours"`
+
+	// RequiredReviewerTeam Команда-партнер, из которой в каждый PR назначается один ревьювер (пустая строка — правило не действует)
+	RequiredReviewerTeam string `json:"required_reviewer_team"`
+
+	// ReviewSlaHours Срок первого ревью в рабочих часах с момента назначения ревьювера; выходные (суббота, воскресенье)
+	// не учитываются (0 — SLA не задан)
+	ReviewSlaHours int           `json:"review_sla_hours"`
+	SelectionMode  SelectionMode `json:"selection_mode"`
+
+	// StaleEscalationHours Возраст открытого PR в рабочих часах, после которого в ревью добавляется тимлид (уровень lead)
+	// команды автора; выполняется один раз на PR (0 — без эскалации)
+	StaleEscalationHours int `json:"stale_escalation_hours"`
+
+	// StaleReassignHours Простой ревьювера открытого PR в рабочих часах с момента назначения, после которого он автоматически
+	// заменяется по правилам /pullRequest/reassign (0 — не заменяется)
+	StaleReassignHours int    `json:"stale_reassign_hours"`
+	TeamName           string `json:"team_name"`
+}
+
 // User defines model for User.
 type User struct {
-	IsActive bool   `json:"is_active"`
-	TeamName string `json:"team_name"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+	IsActive bool      `json:"is_active"`
+	Level    UserLevel `json:"level"`
+
+	// MaxOpenReviews Лимит одновременно открытых ревью (не задан — действует значение по умолчанию команды)
+	MaxOpenReviews *int `json:"max_open_reviews,omitempty"`
+
+	// ReviewWeight Относительная доля назначений при взвешенном выборе (1 — обычная нагрузка)
+	ReviewWeight float64       `json:"review_weight"`
+	Schedule     *WorkSchedule `json:"schedule,omitempty"`
+	TeamName     string        `json:"team_name"`
+	UserId       string        `json:"user_id"`
+	Username     string        `json:"username"`
+}
+
+// UserAvailability defines model for UserAvailability.
+type UserAvailability struct {
+	End time.Time `json:"end"`
+	Id  int64     `json:"id"`
+
+	// Reason Причина отсутствия (отпуск, больничный и т.п.)
+	Reason string    `json:"reason"`
+	Start  time.Time `json:"start"`
+	UserId string    `json:"user_id"`
+}
+
+// UserLevel Уровень пользователя (по умолчанию middle)
+type UserLevel string
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts       int                   `json:"attempts"`
+	CreatedAt      time.Time             `json:"created_at"`
+	DeliveryId     int64                 `json:"delivery_id"`
+	EventId        string                `json:"event_id"`
+	EventType      string                `json:"event_type"`
+	LastError      string                `json:"last_error"`
+	NextAttemptAt  *time.Time            `json:"next_attempt_at,omitempty"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	SubscriptionId int64                 `json:"subscription_id"`
+	Url            string                `json:"url"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDelivery.Status.
+type WebhookDeliveryStatus string
+
+// WebhookSubscription defines model for WebhookSubscription.
+type WebhookSubscription struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// EventTypes pr.created, reviewer.assigned, reviewer.reassigned, reviewer.removed, pr.merged, pr.closed, pr.reopened, team.deactivated
+	EventTypes []string `json:"event_types"`
+
+	// Secret Секрет подписи; возвращается только при создании подписки
+	Secret         *string `json:"secret,omitempty"`
+	SubscriptionId int64   `json:"subscription_id"`
+	Url            string  `json:"url"`
+}
+
+// WorkSchedule Рабочий график: рабочие дни — понедельник–пятница, рабочее время — [work_start, work_end) местного времени.
+// Если график не задан, рабочими считаются все часы будних дней по UTC.
+type WorkSchedule struct {
+	// Timezone Часовой пояс IANA
+	Timezone string `json:"timezone"`
+
+	// WorkEnd Конец рабочего дня (HH:MM, допускается 24:00)
+	WorkEnd string `json:"work_end"`
+
+	// WorkStart Начало рабочего дня (HH:MM)
+	WorkStart string `json:"work_start"`
 }
 
 // TeamNameQuery defines model for TeamNameQuery.
@@ -136,11 +401,79 @@ type TeamNameQuery = string
 // UserIdQuery defines model for UserIdQuery.
 type UserIdQuery = string
 
+// GetDigestPreviewParams defines parameters for GetDigestPreview.
+type GetDigestPreviewParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// GetIntegrationsAccountsListParams defines parameters for GetIntegrationsAccountsList.
+type GetIntegrationsAccountsListParams struct {
+	Provider string `form:"provider" json:"provider"`
+}
+
+// PostIntegrationsAccountsRemoveJSONBody defines parameters for PostIntegrationsAccountsRemove.
+type PostIntegrationsAccountsRemoveJSONBody struct {
+	Login    string `json:"login"`
+	Provider string `json:"provider"`
+}
+
+// PostIntegrationsAccountsSetJSONBody defines parameters for PostIntegrationsAccountsSet.
+type PostIntegrationsAccountsSetJSONBody struct {
+	Login    string `json:"login"`
+	Provider string `json:"provider"`
+	UserId   string `json:"user_id"`
+}
+
+// GetIntegrationsProjectsListParams defines parameters for GetIntegrationsProjectsList.
+type GetIntegrationsProjectsListParams struct {
+	Provider string `form:"provider" json:"provider"`
+}
+
+// PostIntegrationsProjectsRemoveJSONBody defines parameters for PostIntegrationsProjectsRemove.
+type PostIntegrationsProjectsRemoveJSONBody struct {
+	Provider   string `json:"provider"`
+	Repository string `json:"repository"`
+}
+
+// PostIntegrationsProjectsSetJSONBody defines parameters for PostIntegrationsProjectsSet.
+type PostIntegrationsProjectsSetJSONBody struct {
+	Provider   string `json:"provider"`
+	Repository string `json:"repository"`
+	TeamName   string `json:"team_name"`
+}
+
+// PostPullRequestAddReviewerJSONBody defines parameters for PostPullRequestAddReviewer.
+type PostPullRequestAddReviewerJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+	UserId        string `json:"user_id"`
+}
+
+// PostPullRequestCloseJSONBody defines parameters for PostPullRequestClose.
+type PostPullRequestCloseJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
 // PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
 type PostPullRequestCreateJSONBody struct {
-	AuthorId        string `json:"author_id"`
-	PullRequestId   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
+	AuthorId string `json:"author_id"`
+
+	// ChangedFiles Пути измененных файлов относительно корня репозитория
+	ChangedFiles    *[]string `json:"changed_files,omitempty"`
+	PullRequestId   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+
+	// RequiredTags Навыки, каждый из которых должен покрыть хотя бы один ревьювер
+	RequiredTags *[]string `json:"required_tags,omitempty"`
+}
+
+// PostPullRequestDeclineJSONBody defines parameters for PostPullRequestDecline.
+type PostPullRequestDeclineJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+
+	// Reason Причина отказа (например, «нет контекста», «перегружен»)
+	Reason string `json:"reason"`
+	UserId string `json:"user_id"`
 }
 
 // PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
@@ -150,7 +483,20 @@ type PostPullRequestMergeJSONBody struct {
 
 // PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
 type PostPullRequestReassignJSONBody struct {
-	OldUserId     string `json:"old_user_id"`
+	// NewUserId Конкретный пользователь для замены (необязательно)
+	NewUserId     *string `json:"new_user_id,omitempty"`
+	OldUserId     string  `json:"old_user_id"`
+	PullRequestId string  `json:"pull_request_id"`
+}
+
+// PostPullRequestRemoveReviewerJSONBody defines parameters for PostPullRequestRemoveReviewer.
+type PostPullRequestRemoveReviewerJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+	UserId        string `json:"user_id"`
+}
+
+// PostPullRequestReopenJSONBody defines parameters for PostPullRequestReopen.
+type PostPullRequestReopenJSONBody struct {
 	PullRequestId string `json:"pull_request_id"`
 }
 
@@ -160,32 +506,288 @@ type GetTeamGetParams struct {
 	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 }
 
+// GetTeamGetChatChannelParams defines parameters for GetTeamGetChatChannel.
+type GetTeamGetChatChannelParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetTeamGetCodeownersParams defines parameters for GetTeamGetCodeowners.
+type GetTeamGetCodeownersParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetTeamGetHolidaysParams defines parameters for GetTeamGetHolidays.
+type GetTeamGetHolidaysParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetTeamGetSettingsParams defines parameters for GetTeamGetSettings.
+type GetTeamGetSettingsParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// PostTeamRemoveChatChannelJSONBody defines parameters for PostTeamRemoveChatChannel.
+type PostTeamRemoveChatChannelJSONBody struct {
+	TeamName string `json:"team_name"`
+}
+
+// PostTeamSetChatChannelJSONBody defines parameters for PostTeamSetChatChannel.
+type PostTeamSetChatChannelJSONBody struct {
+	TeamName   string         `json:"team_name"`
+	Templates  *ChatTemplates `json:"templates,omitempty"`
+	WebhookUrl string         `json:"webhook_url"`
+}
+
+// PostTeamSetCodeownersJSONBody defines parameters for PostTeamSetCodeowners.
+type PostTeamSetCodeownersJSONBody struct {
+	// Codeowners Содержимое файла CODEOWNERS
+	Codeowners string `json:"codeowners"`
+	TeamName   string `json:"team_name"`
+}
+
+// PostTeamSetHolidaysJSONBody defines parameters for PostTeamSetHolidays.
+type PostTeamSetHolidaysJSONBody struct {
+	Holidays []TeamHoliday `json:"holidays"`
+	TeamName string        `json:"team_name"`
+}
+
+// PostTeamSetSettingsJSONBody defines parameters for PostTeamSetSettings.
+type PostTeamSetSettingsJSONBody struct {
+	// DefaultMaxOpenReviews Лимит открытых ревью для участников без собственного лимита (0 — без ограничения)
+	DefaultMaxOpenReviews *int `json:"default_max_open_reviews,omitempty"`
+
+	// MinReviewerLevel Минимальный уровень хотя бы одного ревьювера PR (пустая строка отключает правило)
+	MinReviewerLevel *string `json:"min_reviewer_level,omitempty"`
+
+	// PairHistoryWindow Окно последних PR автора для снижения повторных пар автор–ревьювер (0 отключает)
+	PairHistoryWindow *int `json:"pair_history_window,omitempty"`
+
+	// PreferWorkingHours Предпочитать ревьюверов, у которых сейчас рабочее время
+	PreferWorkingHours *bool `json:"prefer_working_hours,omitempty"`
+
+	// RequiredReviewerTeam Команда-партнер для обязательного межкомандного ревью (пустая строка отключает правило)
+	RequiredReviewerTeam *string `json:"required_reviewer_team,omitempty"`
+
+	// ReviewSlaHours Срок первого ревью в рабочих часах (0 отключает SLA)
+	ReviewSlaHours *int           `json:"review_sla_hours,omitempty"`
+	SelectionMode  *SelectionMode `json:"selection_mode,omitempty"`
+
+	// StaleEscalationHours Возраст открытого PR в рабочих часах до добавления тимлида (0 отключает)
+	StaleEscalationHours *int `json:"stale_escalation_hours,omitempty"`
+
+	// StaleReassignHours Простой ревьювера в рабочих часах до автоматической замены (0 отключает)
+	StaleReassignHours *int   `json:"stale_reassign_hours,omitempty"`
+	TeamName           string `json:"team_name"`
+}
+
+// PostUsersAddExclusionJSONBody defines parameters for PostUsersAddExclusion.
+type PostUsersAddExclusionJSONBody struct {
+	ExcludedUserId string  `json:"excluded_user_id"`
+	Reason         *string `json:"reason,omitempty"`
+	UserId         string  `json:"user_id"`
+}
+
+// GetUsersGetExclusionsParams defines parameters for GetUsersGetExclusions.
+type GetUsersGetExclusionsParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
 // GetUsersGetReviewParams defines parameters for GetUsersGetReview.
 type GetUsersGetReviewParams struct {
 	// UserId Идентификатор пользователя
 	UserId UserIdQuery `form:"user_id" json:"user_id"`
 }
 
+// PostUsersImportAvailabilityJSONBody defines parameters for PostUsersImportAvailability.
+type PostUsersImportAvailabilityJSONBody struct {
+	// Ics Содержимое файла .ics
+	Ics *string `json:"ics,omitempty"`
+}
+
+// PostUsersRemoveExclusionJSONBody defines parameters for PostUsersRemoveExclusion.
+type PostUsersRemoveExclusionJSONBody struct {
+	ExcludedUserId string `json:"excluded_user_id"`
+	UserId         string `json:"user_id"`
+}
+
+// PostUsersSetAvailabilityJSONBody defines parameters for PostUsersSetAvailability.
+type PostUsersSetAvailabilityJSONBody struct {
+	End    time.Time `json:"end"`
+	Reason *string   `json:"reason,omitempty"`
+	Start  time.Time `json:"start"`
+	UserId string    `json:"user_id"`
+}
+
+// PostUsersSetDigestJSONBody defines parameters for PostUsersSetDigest.
+type PostUsersSetDigestJSONBody struct {
+	// Email Адрес для сводки; пустая строка удаляет адрес
+	Email *string `json:"email,omitempty"`
+
+	// Enabled false — отказ от сводки
+	Enabled *bool  `json:"enabled,omitempty"`
+	UserId  string `json:"user_id"`
+}
+
 // PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
 type PostUsersSetIsActiveJSONBody struct {
 	IsActive bool   `json:"is_active"`
 	UserId   string `json:"user_id"`
 }
 
+// PostUsersSetLevelJSONBody defines parameters for PostUsersSetLevel.
+type PostUsersSetLevelJSONBody struct {
+	Level  UserLevel `json:"level"`
+	UserId string    `json:"user_id"`
+}
+
+// PostUsersSetMaxOpenReviewsJSONBody defines parameters for PostUsersSetMaxOpenReviews.
+type PostUsersSetMaxOpenReviewsJSONBody struct {
+	MaxOpenReviews *int   `json:"max_open_reviews,omitempty"`
+	UserId         string `json:"user_id"`
+}
+
+// PostUsersSetReviewWeightJSONBody defines parameters for PostUsersSetReviewWeight.
+type PostUsersSetReviewWeightJSONBody struct {
+	ReviewWeight float64 `json:"review_weight"`
+	UserId       string  `json:"user_id"`
+}
+
+// PostUsersSetScheduleJSONBody defines parameters for PostUsersSetSchedule.
+type PostUsersSetScheduleJSONBody struct {
+	// Timezone Часовой пояс IANA, например Europe/Moscow
+	Timezone string `json:"timezone"`
+	UserId   string `json:"user_id"`
+
+	// WorkEnd Конец рабочего дня (HH:MM, допускается 24:00)
+	WorkEnd string `json:"work_end"`
+
+	// WorkStart Начало рабочего дня (HH:MM)
+	WorkStart string `json:"work_start"`
+}
+
+// PostUsersSetSkillsJSONBody defines parameters for PostUsersSetSkills.
+type PostUsersSetSkillsJSONBody struct {
+	Skills []string `json:"skills"`
+	UserId string   `json:"user_id"`
+}
+
+// PostWebhooksRedeliverJSONBody defines parameters for PostWebhooksRedeliver.
+type PostWebhooksRedeliverJSONBody struct {
+	DeliveryId int64 `json:"delivery_id"`
+}
+
+// PostWebhooksSubscribeJSONBody defines parameters for PostWebhooksSubscribe.
+type PostWebhooksSubscribeJSONBody struct {
+	EventTypes []string `json:"event_types"`
+	Secret     *string  `json:"secret,omitempty"`
+	Url        string   `json:"url"`
+}
+
+// PostWebhooksUnsubscribeJSONBody defines parameters for PostWebhooksUnsubscribe.
+type PostWebhooksUnsubscribeJSONBody struct {
+	SubscriptionId int64 `json:"subscription_id"`
+}
+
+// PostIntegrationsAccountsRemoveJSONRequestBody defines body for PostIntegrationsAccountsRemove for application/json ContentType.
+type PostIntegrationsAccountsRemoveJSONRequestBody PostIntegrationsAccountsRemoveJSONBody
+
+// PostIntegrationsAccountsSetJSONRequestBody defines body for PostIntegrationsAccountsSet for application/json ContentType.
+type PostIntegrationsAccountsSetJSONRequestBody PostIntegrationsAccountsSetJSONBody
+
+// PostIntegrationsProjectsRemoveJSONRequestBody defines body for PostIntegrationsProjectsRemove for application/json ContentType.
+type PostIntegrationsProjectsRemoveJSONRequestBody PostIntegrationsProjectsRemoveJSONBody
+
+// PostIntegrationsProjectsSetJSONRequestBody defines body for PostIntegrationsProjectsSet for application/json ContentType.
+type PostIntegrationsProjectsSetJSONRequestBody PostIntegrationsProjectsSetJSONBody
+
+// PostPullRequestAddReviewerJSONRequestBody defines body for PostPullRequestAddReviewer for application/json ContentType.
+type PostPullRequestAddReviewerJSONRequestBody PostPullRequestAddReviewerJSONBody
+
+// PostPullRequestCloseJSONRequestBody defines body for PostPullRequestClose for application/json ContentType.
+type PostPullRequestCloseJSONRequestBody PostPullRequestCloseJSONBody
+
 // PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
 type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
 
+// PostPullRequestDeclineJSONRequestBody defines body for PostPullRequestDecline for application/json ContentType.
+type PostPullRequestDeclineJSONRequestBody PostPullRequestDeclineJSONBody
+
 // PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
 type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
 
 // PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
 type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
 
+// PostPullRequestRemoveReviewerJSONRequestBody defines body for PostPullRequestRemoveReviewer for application/json ContentType.
+type PostPullRequestRemoveReviewerJSONRequestBody PostPullRequestRemoveReviewerJSONBody
+
+// PostPullRequestReopenJSONRequestBody defines body for PostPullRequestReopen for application/json ContentType.
+type PostPullRequestReopenJSONRequestBody PostPullRequestReopenJSONBody
+
 // PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
 type PostTeamAddJSONRequestBody = Team
 
 // PostTeamDeactivateJSONRequestBody defines body for PostTeamDeactivate for application/json ContentType.
 type PostTeamDeactivateJSONRequestBody = TeamDeactivateRequest
 
+// PostTeamRemoveChatChannelJSONRequestBody defines body for PostTeamRemoveChatChannel for application/json ContentType.
+type PostTeamRemoveChatChannelJSONRequestBody PostTeamRemoveChatChannelJSONBody
+
+// PostTeamSetChatChannelJSONRequestBody defines body for PostTeamSetChatChannel for application/json ContentType.
+type PostTeamSetChatChannelJSONRequestBody PostTeamSetChatChannelJSONBody
+
+// PostTeamSetCodeownersJSONRequestBody defines body for PostTeamSetCodeowners for application/json ContentType.
+type PostTeamSetCodeownersJSONRequestBody PostTeamSetCodeownersJSONBody
+
+// PostTeamSetHolidaysJSONRequestBody defines body for PostTeamSetHolidays for application/json ContentType.
+type PostTeamSetHolidaysJSONRequestBody PostTeamSetHolidaysJSONBody
+
+// PostTeamSetSettingsJSONRequestBody defines body for PostTeamSetSettings for application/json ContentType.
+type PostTeamSetSettingsJSONRequestBody PostTeamSetSettingsJSONBody
+
+// PostUsersAddExclusionJSONRequestBody defines body for PostUsersAddExclusion for application/json ContentType.
+type PostUsersAddExclusionJSONRequestBody PostUsersAddExclusionJSONBody
+
+// PostUsersImportAvailabilityJSONRequestBody defines body for PostUsersImportAvailability for application/json ContentType.
+type PostUsersImportAvailabilityJSONRequestBody PostUsersImportAvailabilityJSONBody
+
+// PostUsersRemoveExclusionJSONRequestBody defines body for PostUsersRemoveExclusion for application/json ContentType.
+type PostUsersRemoveExclusionJSONRequestBody PostUsersRemoveExclusionJSONBody
+
+// PostUsersSetAvailabilityJSONRequestBody defines body for PostUsersSetAvailability for application/json ContentType.
+type PostUsersSetAvailabilityJSONRequestBody PostUsersSetAvailabilityJSONBody
+
+// PostUsersSetDigestJSONRequestBody defines body for PostUsersSetDigest for application/json ContentType.
+type PostUsersSetDigestJSONRequestBody PostUsersSetDigestJSONBody
+
 // PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
 type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
+
+// PostUsersSetLevelJSONRequestBody defines body for PostUsersSetLevel for application/json ContentType.
+type PostUsersSetLevelJSONRequestBody PostUsersSetLevelJSONBody
+
+// PostUsersSetMaxOpenReviewsJSONRequestBody defines body for PostUsersSetMaxOpenReviews for application/json ContentType.
+type PostUsersSetMaxOpenReviewsJSONRequestBody PostUsersSetMaxOpenReviewsJSONBody
+
+// PostUsersSetReviewWeightJSONRequestBody defines body for PostUsersSetReviewWeight for application/json ContentType.
+type PostUsersSetReviewWeightJSONRequestBody PostUsersSetReviewWeightJSONBody
+
+// PostUsersSetScheduleJSONRequestBody defines body for PostUsersSetSchedule for application/json ContentType.
+type PostUsersSetScheduleJSONRequestBody PostUsersSetScheduleJSONBody
+
+// PostUsersSetSkillsJSONRequestBody defines body for PostUsersSetSkills for application/json ContentType.
+type PostUsersSetSkillsJSONRequestBody PostUsersSetSkillsJSONBody
+
+// PostWebhooksRedeliverJSONRequestBody defines body for PostWebhooksRedeliver for application/json ContentType.
+type PostWebhooksRedeliverJSONRequestBody PostWebhooksRedeliverJSONBody
+
+// PostWebhooksSubscribeJSONRequestBody defines body for PostWebhooksSubscribe for application/json ContentType.
+type PostWebhooksSubscribeJSONRequestBody PostWebhooksSubscribeJSONBody
+
+// PostWebhooksUnsubscribeJSONRequestBody defines body for PostWebhooksUnsubscribe for application/json ContentType.
+type PostWebhooksUnsubscribeJSONRequestBody PostWebhooksUnsubscribeJSONBody
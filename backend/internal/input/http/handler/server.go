@@ -2,6 +2,8 @@ package handler
 
 import (
 	"context"
+	"io"
+	"strings"
 	entity2 "test_task_avito/backend/internal/entity"
 	gen2 "test_task_avito/backend/internal/input/http/gen"
 	"test_task_avito/backend/internal/port"
@@ -10,16 +12,24 @@ import (
 var _ gen2.StrictServerInterface = (*Handler)(nil)
 
 type Handler struct {
-	teamUseCase        port.TeamUseCase
-	userUseCase        port.UserUseCase
-	pullRequestUseCase port.PullRequestUseCase
+	teamUseCase         port.TeamUseCase
+	userUseCase         port.UserUseCase
+	pullRequestUseCase  port.PullRequestUseCase
+	webhookUseCase      port.WebhookUseCase
+	codeHostUseCase     port.CodeHostUseCase
+	notificationUseCase port.NotificationUseCase
+	digestUseCase       port.DigestUseCase
 }
 
-func NewHandler(teamUseCase port.TeamUseCase, userUseCase port.UserUseCase, pullRequestUseCase port.PullRequestUseCase) *Handler {
+func NewHandler(teamUseCase port.TeamUseCase, userUseCase port.UserUseCase, pullRequestUseCase port.PullRequestUseCase, webhookUseCase port.WebhookUseCase, codeHostUseCase port.CodeHostUseCase, notificationUseCase port.NotificationUseCase, digestUseCase port.DigestUseCase) *Handler {
 	return &Handler{
-		teamUseCase:        teamUseCase,
-		userUseCase:        userUseCase,
-		pullRequestUseCase: pullRequestUseCase,
+		teamUseCase:         teamUseCase,
+		userUseCase:         userUseCase,
+		pullRequestUseCase:  pullRequestUseCase,
+		webhookUseCase:      webhookUseCase,
+		codeHostUseCase:     codeHostUseCase,
+		notificationUseCase: notificationUseCase,
+		digestUseCase:       digestUseCase,
 	}
 }
 
@@ -176,23 +186,1455 @@ func (h *Handler) GetTeamGet(ctx context.Context, request gen2.GetTeamGetRequest
 	return gen2.GetTeamGet200JSONResponse(genTeam), nil
 }
 
+func (h *Handler) GetTeamGetSettings(ctx context.Context, request gen2.GetTeamGetSettingsRequestObject) (gen2.GetTeamGetSettingsResponseObject, error) {
+	settings, err := h.teamUseCase.GetTeamSettings(ctx, request.Params.TeamName)
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok && domainErr.Code == entity2.ErrorCodeNotFound {
+			return gen2.GetTeamGetSettings404JSONResponse{
+				Error: struct {
+					Code    gen2.ErrorResponseErrorCode `json:"code"`
+					Message string                      `json:"message"`
+				}{
+					Code:    gen2.NOTFOUND,
+					Message: domainErr.Message,
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen2.GetTeamGetSettings200JSONResponse(entityToGenTeamSettings(settings)), nil
+}
+
+func (h *Handler) PostTeamSetSettings(ctx context.Context, request gen2.PostTeamSetSettingsRequestObject) (gen2.PostTeamSetSettingsResponseObject, error) {
+	if request.Body == nil {
+		return gen2.PostTeamSetSettings400JSONResponse{
+			Error: struct {
+				Code    gen2.ErrorResponseErrorCode `json:"code"`
+				Message string                      `json:"message"`
+			}{
+				Code:    gen2.INVALIDINPUT,
+				Message: "request body is required",
+			},
+		}, nil
+	}
+
+	update := entity2.TeamSettingsUpdate{
+		DefaultMaxOpenReviews: request.Body.DefaultMaxOpenReviews,
+		RequiredReviewerTeam:  request.Body.RequiredReviewerTeam,
+		PairHistoryWindow:     request.Body.PairHistoryWindow,
+		ReviewSLAHours:        request.Body.ReviewSlaHours,
+		StaleReassignHours:    request.Body.StaleReassignHours,
+		StaleEscalationHours:  request.Body.StaleEscalationHours,
+		PreferWorkingHours:    request.Body.PreferWorkingHours,
+	}
+	if request.Body.SelectionMode != nil {
+		mode := entity2.SelectionMode(*request.Body.SelectionMode)
+		update.SelectionMode = &mode
+	}
+	if request.Body.MinReviewerLevel != nil {
+		level := entity2.UserLevel(*request.Body.MinReviewerLevel)
+		update.MinReviewerLevel = &level
+	}
+
+	settings, err := h.teamUseCase.UpdateTeamSettings(ctx, request.Body.TeamName, update)
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok {
+			switch domainErr.Code {
+			case entity2.ErrorCodeNotFound:
+				return gen2.PostTeamSetSettings404JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.NOTFOUND,
+						Message: domainErr.Message,
+					},
+				}, nil
+			case entity2.ErrorCodeInvalidInput:
+				return gen2.PostTeamSetSettings400JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.INVALIDINPUT,
+						Message: domainErr.Message,
+					},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return gen2.PostTeamSetSettings200JSONResponse(entityToGenTeamSettings(settings)), nil
+}
+
+func (h *Handler) PostTeamSetCodeowners(ctx context.Context, request gen2.PostTeamSetCodeownersRequestObject) (gen2.PostTeamSetCodeownersResponseObject, error) {
+	if request.Body == nil {
+		return gen2.PostTeamSetCodeowners400JSONResponse{
+			Error: struct {
+				Code    gen2.ErrorResponseErrorCode `json:"code"`
+				Message string                      `json:"message"`
+			}{
+				Code:    gen2.INVALIDINPUT,
+				Message: "request body is required",
+			},
+		}, nil
+	}
+
+	rules, err := h.teamUseCase.SetTeamCodeowners(ctx, request.Body.TeamName, request.Body.Codeowners)
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok {
+			switch domainErr.Code {
+			case entity2.ErrorCodeNotFound:
+				return gen2.PostTeamSetCodeowners404JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.NOTFOUND,
+						Message: domainErr.Message,
+					},
+				}, nil
+			case entity2.ErrorCodeInvalidInput:
+				return gen2.PostTeamSetCodeowners400JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.INVALIDINPUT,
+						Message: domainErr.Message,
+					},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return gen2.PostTeamSetCodeowners200JSONResponse{
+		TeamName: request.Body.TeamName,
+		Rules:    rules,
+	}, nil
+}
+
+func (h *Handler) GetTeamGetCodeowners(ctx context.Context, request gen2.GetTeamGetCodeownersRequestObject) (gen2.GetTeamGetCodeownersResponseObject, error) {
+	content, err := h.teamUseCase.GetTeamCodeowners(ctx, request.Params.TeamName)
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok && domainErr.Code == entity2.ErrorCodeNotFound {
+			return gen2.GetTeamGetCodeowners404JSONResponse{
+				Error: struct {
+					Code    gen2.ErrorResponseErrorCode `json:"code"`
+					Message string                      `json:"message"`
+				}{
+					Code:    gen2.NOTFOUND,
+					Message: domainErr.Message,
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen2.GetTeamGetCodeowners200JSONResponse{
+		TeamName:   request.Params.TeamName,
+		Codeowners: content,
+	}, nil
+}
+
+func (h *Handler) PostTeamSetChatChannel(ctx context.Context, request gen2.PostTeamSetChatChannelRequestObject) (gen2.PostTeamSetChatChannelResponseObject, error) {
+	if request.Body == nil {
+		return gen2.PostTeamSetChatChannel400JSONResponse{
+			Error: struct {
+				Code    gen2.ErrorResponseErrorCode `json:"code"`
+				Message string                      `json:"message"`
+			}{
+				Code:    gen2.INVALIDINPUT,
+				Message: "request body is required",
+			},
+		}, nil
+	}
+
+	templates := make(map[entity2.NotificationKind]string)
+	if request.Body.Templates != nil {
+		if request.Body.Templates.Assigned != nil {
+			templates[entity2.NotificationAssigned] = *request.Body.Templates.Assigned
+		}
+		if request.Body.Templates.Reassigned != nil {
+			templates[entity2.NotificationReassigned] = *request.Body.Templates.Reassigned
+		}
+		if request.Body.Templates.Overdue != nil {
+			templates[entity2.NotificationOverdue] = *request.Body.Templates.Overdue
+		}
+	}
+
+	channel, err := h.notificationUseCase.SetChatChannel(ctx, request.Body.TeamName, request.Body.WebhookUrl, templates)
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok {
+			switch domainErr.Code {
+			case entity2.ErrorCodeNotFound:
+				return gen2.PostTeamSetChatChannel404JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.NOTFOUND,
+						Message: domainErr.Message,
+					},
+				}, nil
+			case entity2.ErrorCodeInvalidInput:
+				return gen2.PostTeamSetChatChannel400JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.INVALIDINPUT,
+						Message: domainErr.Message,
+					},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return gen2.PostTeamSetChatChannel200JSONResponse{Channel: entityToGenChatChannel(channel)}, nil
+}
+
+func (h *Handler) GetTeamGetChatChannel(ctx context.Context, request gen2.GetTeamGetChatChannelRequestObject) (gen2.GetTeamGetChatChannelResponseObject, error) {
+	channel, err := h.notificationUseCase.GetChatChannel(ctx, request.Params.TeamName)
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok && domainErr.Code == entity2.ErrorCodeNotFound {
+			return gen2.GetTeamGetChatChannel404JSONResponse{
+				Error: struct {
+					Code    gen2.ErrorResponseErrorCode `json:"code"`
+					Message string                      `json:"message"`
+				}{
+					Code:    gen2.NOTFOUND,
+					Message: domainErr.Message,
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen2.GetTeamGetChatChannel200JSONResponse{Channel: entityToGenChatChannel(channel)}, nil
+}
+
+func (h *Handler) PostTeamRemoveChatChannel(ctx context.Context, request gen2.PostTeamRemoveChatChannelRequestObject) (gen2.PostTeamRemoveChatChannelResponseObject, error) {
+	if request.Body == nil {
+		return gen2.PostTeamRemoveChatChannel400JSONResponse{
+			Error: struct {
+				Code    gen2.ErrorResponseErrorCode `json:"code"`
+				Message string                      `json:"message"`
+			}{
+				Code:    gen2.INVALIDINPUT,
+				Message: "request body is required",
+			},
+		}, nil
+	}
+
+	if err := h.notificationUseCase.RemoveChatChannel(ctx, request.Body.TeamName); err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok && domainErr.Code == entity2.ErrorCodeNotFound {
+			return gen2.PostTeamRemoveChatChannel404JSONResponse{
+				Error: struct {
+					Code    gen2.ErrorResponseErrorCode `json:"code"`
+					Message string                      `json:"message"`
+				}{
+					Code:    gen2.NOTFOUND,
+					Message: domainErr.Message,
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen2.PostTeamRemoveChatChannel200JSONResponse{TeamName: request.Body.TeamName}, nil
+}
+
+func (h *Handler) PostTeamSetHolidays(ctx context.Context, request gen2.PostTeamSetHolidaysRequestObject) (gen2.PostTeamSetHolidaysResponseObject, error) {
+	if request.Body == nil {
+		return gen2.PostTeamSetHolidays400JSONResponse{
+			Error: struct {
+				Code    gen2.ErrorResponseErrorCode `json:"code"`
+				Message string                      `json:"message"`
+			}{
+				Code:    gen2.INVALIDINPUT,
+				Message: "request body is required",
+			},
+		}, nil
+	}
+
+	holidays := make([]entity2.TeamHoliday, 0, len(request.Body.Holidays))
+	for _, holiday := range request.Body.Holidays {
+		holidays = append(holidays, entity2.TeamHoliday{
+			Date: holiday.Date,
+			Name: holiday.Name,
+		})
+	}
+
+	saved, err := h.teamUseCase.SetTeamHolidays(ctx, request.Body.TeamName, holidays)
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok {
+			switch domainErr.Code {
+			case entity2.ErrorCodeNotFound:
+				return gen2.PostTeamSetHolidays404JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.NOTFOUND,
+						Message: domainErr.Message,
+					},
+				}, nil
+			case entity2.ErrorCodeInvalidInput:
+				return gen2.PostTeamSetHolidays400JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.INVALIDINPUT,
+						Message: domainErr.Message,
+					},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return gen2.PostTeamSetHolidays200JSONResponse(entityToGenTeamHolidays(request.Body.TeamName, saved)), nil
+}
+
+func (h *Handler) GetTeamGetHolidays(ctx context.Context, request gen2.GetTeamGetHolidaysRequestObject) (gen2.GetTeamGetHolidaysResponseObject, error) {
+	holidays, err := h.teamUseCase.GetTeamHolidays(ctx, request.Params.TeamName)
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok && domainErr.Code == entity2.ErrorCodeNotFound {
+			return gen2.GetTeamGetHolidays404JSONResponse{
+				Error: struct {
+					Code    gen2.ErrorResponseErrorCode `json:"code"`
+					Message string                      `json:"message"`
+				}{
+					Code:    gen2.NOTFOUND,
+					Message: domainErr.Message,
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen2.GetTeamGetHolidays200JSONResponse(entityToGenTeamHolidays(request.Params.TeamName, holidays)), nil
+}
+
 func (h *Handler) PostUsersSetIsActive(ctx context.Context, request gen2.PostUsersSetIsActiveRequestObject) (gen2.PostUsersSetIsActiveResponseObject, error) {
 	if request.Body == nil {
-		return gen2.PostUsersSetIsActive404JSONResponse{
+		return gen2.PostUsersSetIsActive404JSONResponse{
+			Error: struct {
+				Code    gen2.ErrorResponseErrorCode `json:"code"`
+				Message string                      `json:"message"`
+			}{
+				Code:    gen2.NOTFOUND,
+				Message: "request body is required",
+			},
+		}, nil
+	}
+
+	user, err := h.userUseCase.SetUserIsActive(ctx, request.Body.UserId, request.Body.IsActive)
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok && domainErr.Code == entity2.ErrorCodeNotFound {
+			return gen2.PostUsersSetIsActive404JSONResponse{
+				Error: struct {
+					Code    gen2.ErrorResponseErrorCode `json:"code"`
+					Message string                      `json:"message"`
+				}{
+					Code:    gen2.NOTFOUND,
+					Message: domainErr.Message,
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen2.PostUsersSetIsActive200JSONResponse{
+		User: entityToGenUser(user),
+	}, nil
+}
+
+func (h *Handler) PostUsersSetMaxOpenReviews(ctx context.Context, request gen2.PostUsersSetMaxOpenReviewsRequestObject) (gen2.PostUsersSetMaxOpenReviewsResponseObject, error) {
+	if request.Body == nil {
+		return gen2.PostUsersSetMaxOpenReviews400JSONResponse{
+			Error: struct {
+				Code    gen2.ErrorResponseErrorCode `json:"code"`
+				Message string                      `json:"message"`
+			}{
+				Code:    gen2.INVALIDINPUT,
+				Message: "request body is required",
+			},
+		}, nil
+	}
+
+	user, err := h.userUseCase.SetUserMaxOpenReviews(ctx, request.Body.UserId, request.Body.MaxOpenReviews)
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok {
+			switch domainErr.Code {
+			case entity2.ErrorCodeNotFound:
+				return gen2.PostUsersSetMaxOpenReviews404JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.NOTFOUND,
+						Message: domainErr.Message,
+					},
+				}, nil
+			case entity2.ErrorCodeInvalidInput:
+				return gen2.PostUsersSetMaxOpenReviews400JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.INVALIDINPUT,
+						Message: domainErr.Message,
+					},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return gen2.PostUsersSetMaxOpenReviews200JSONResponse{
+		User: entityToGenUser(user),
+	}, nil
+}
+
+func (h *Handler) PostUsersSetReviewWeight(ctx context.Context, request gen2.PostUsersSetReviewWeightRequestObject) (gen2.PostUsersSetReviewWeightResponseObject, error) {
+	if request.Body == nil {
+		return gen2.PostUsersSetReviewWeight400JSONResponse{
+			Error: struct {
+				Code    gen2.ErrorResponseErrorCode `json:"code"`
+				Message string                      `json:"message"`
+			}{
+				Code:    gen2.INVALIDINPUT,
+				Message: "request body is required",
+			},
+		}, nil
+	}
+
+	user, err := h.userUseCase.SetUserReviewWeight(ctx, request.Body.UserId, request.Body.ReviewWeight)
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok {
+			switch domainErr.Code {
+			case entity2.ErrorCodeNotFound:
+				return gen2.PostUsersSetReviewWeight404JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.NOTFOUND,
+						Message: domainErr.Message,
+					},
+				}, nil
+			case entity2.ErrorCodeInvalidInput:
+				return gen2.PostUsersSetReviewWeight400JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.INVALIDINPUT,
+						Message: domainErr.Message,
+					},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return gen2.PostUsersSetReviewWeight200JSONResponse{
+		User: entityToGenUser(user),
+	}, nil
+}
+
+func (h *Handler) PostUsersSetLevel(ctx context.Context, request gen2.PostUsersSetLevelRequestObject) (gen2.PostUsersSetLevelResponseObject, error) {
+	if request.Body == nil {
+		return gen2.PostUsersSetLevel400JSONResponse{
+			Error: struct {
+				Code    gen2.ErrorResponseErrorCode `json:"code"`
+				Message string                      `json:"message"`
+			}{
+				Code:    gen2.INVALIDINPUT,
+				Message: "request body is required",
+			},
+		}, nil
+	}
+
+	user, err := h.userUseCase.SetUserLevel(ctx, request.Body.UserId, entity2.UserLevel(request.Body.Level))
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok {
+			switch domainErr.Code {
+			case entity2.ErrorCodeNotFound:
+				return gen2.PostUsersSetLevel404JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.NOTFOUND,
+						Message: domainErr.Message,
+					},
+				}, nil
+			case entity2.ErrorCodeInvalidInput:
+				return gen2.PostUsersSetLevel400JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.INVALIDINPUT,
+						Message: domainErr.Message,
+					},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return gen2.PostUsersSetLevel200JSONResponse{
+		User: entityToGenUser(user),
+	}, nil
+}
+
+func (h *Handler) PostUsersSetSchedule(ctx context.Context, request gen2.PostUsersSetScheduleRequestObject) (gen2.PostUsersSetScheduleResponseObject, error) {
+	if request.Body == nil {
+		return gen2.PostUsersSetSchedule400JSONResponse{
+			Error: struct {
+				Code    gen2.ErrorResponseErrorCode `json:"code"`
+				Message string                      `json:"message"`
+			}{
+				Code:    gen2.INVALIDINPUT,
+				Message: "request body is required",
+			},
+		}, nil
+	}
+
+	user, err := h.userUseCase.SetUserSchedule(ctx, request.Body.UserId, request.Body.Timezone, request.Body.WorkStart, request.Body.WorkEnd)
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok {
+			switch domainErr.Code {
+			case entity2.ErrorCodeNotFound:
+				return gen2.PostUsersSetSchedule404JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.NOTFOUND,
+						Message: domainErr.Message,
+					},
+				}, nil
+			case entity2.ErrorCodeInvalidInput:
+				return gen2.PostUsersSetSchedule400JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.INVALIDINPUT,
+						Message: domainErr.Message,
+					},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return gen2.PostUsersSetSchedule200JSONResponse{
+		User: entityToGenUser(user),
+	}, nil
+}
+
+func (h *Handler) PostUsersSetSkills(ctx context.Context, request gen2.PostUsersSetSkillsRequestObject) (gen2.PostUsersSetSkillsResponseObject, error) {
+	if request.Body == nil {
+		return gen2.PostUsersSetSkills400JSONResponse{
+			Error: struct {
+				Code    gen2.ErrorResponseErrorCode `json:"code"`
+				Message string                      `json:"message"`
+			}{
+				Code:    gen2.INVALIDINPUT,
+				Message: "request body is required",
+			},
+		}, nil
+	}
+
+	skills, err := h.userUseCase.SetUserSkills(ctx, request.Body.UserId, request.Body.Skills)
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok {
+			switch domainErr.Code {
+			case entity2.ErrorCodeNotFound:
+				return gen2.PostUsersSetSkills404JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.NOTFOUND,
+						Message: domainErr.Message,
+					},
+				}, nil
+			case entity2.ErrorCodeInvalidInput:
+				return gen2.PostUsersSetSkills400JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.INVALIDINPUT,
+						Message: domainErr.Message,
+					},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return gen2.PostUsersSetSkills200JSONResponse{
+		UserId: request.Body.UserId,
+		Skills: skills,
+	}, nil
+}
+
+func (h *Handler) PostUsersAddExclusion(ctx context.Context, request gen2.PostUsersAddExclusionRequestObject) (gen2.PostUsersAddExclusionResponseObject, error) {
+	if request.Body == nil {
+		return gen2.PostUsersAddExclusion400JSONResponse{
+			Error: struct {
+				Code    gen2.ErrorResponseErrorCode `json:"code"`
+				Message string                      `json:"message"`
+			}{
+				Code:    gen2.INVALIDINPUT,
+				Message: "request body is required",
+			},
+		}, nil
+	}
+
+	reason := ""
+	if request.Body.Reason != nil {
+		reason = *request.Body.Reason
+	}
+
+	exclusion, err := h.userUseCase.AddReviewerExclusion(ctx, request.Body.UserId, request.Body.ExcludedUserId, reason)
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok {
+			switch domainErr.Code {
+			case entity2.ErrorCodeNotFound:
+				return gen2.PostUsersAddExclusion404JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.NOTFOUND,
+						Message: domainErr.Message,
+					},
+				}, nil
+			case entity2.ErrorCodeInvalidInput:
+				return gen2.PostUsersAddExclusion400JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.INVALIDINPUT,
+						Message: domainErr.Message,
+					},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return gen2.PostUsersAddExclusion200JSONResponse{
+		Exclusion: entityToGenReviewerExclusion(exclusion),
+	}, nil
+}
+
+func (h *Handler) PostUsersRemoveExclusion(ctx context.Context, request gen2.PostUsersRemoveExclusionRequestObject) (gen2.PostUsersRemoveExclusionResponseObject, error) {
+	if request.Body == nil {
+		return gen2.PostUsersRemoveExclusion404JSONResponse{
+			Error: struct {
+				Code    gen2.ErrorResponseErrorCode `json:"code"`
+				Message string                      `json:"message"`
+			}{
+				Code:    gen2.NOTFOUND,
+				Message: "request body is required",
+			},
+		}, nil
+	}
+
+	exclusion, err := h.userUseCase.RemoveReviewerExclusion(ctx, request.Body.UserId, request.Body.ExcludedUserId)
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok && domainErr.Code == entity2.ErrorCodeNotFound {
+			return gen2.PostUsersRemoveExclusion404JSONResponse{
+				Error: struct {
+					Code    gen2.ErrorResponseErrorCode `json:"code"`
+					Message string                      `json:"message"`
+				}{
+					Code:    gen2.NOTFOUND,
+					Message: domainErr.Message,
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen2.PostUsersRemoveExclusion200JSONResponse{
+		Exclusion: entityToGenReviewerExclusion(exclusion),
+	}, nil
+}
+
+func (h *Handler) GetUsersGetExclusions(ctx context.Context, request gen2.GetUsersGetExclusionsRequestObject) (gen2.GetUsersGetExclusionsResponseObject, error) {
+	exclusions, err := h.userUseCase.GetReviewerExclusions(ctx, request.Params.UserId)
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok && domainErr.Code == entity2.ErrorCodeNotFound {
+			return gen2.GetUsersGetExclusions404JSONResponse{
+				Error: struct {
+					Code    gen2.ErrorResponseErrorCode `json:"code"`
+					Message string                      `json:"message"`
+				}{
+					Code:    gen2.NOTFOUND,
+					Message: domainErr.Message,
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	genExclusions := make([]gen2.ReviewerExclusion, 0, len(exclusions))
+	for _, exclusion := range exclusions {
+		genExclusions = append(genExclusions, entityToGenReviewerExclusion(exclusion))
+	}
+
+	return gen2.GetUsersGetExclusions200JSONResponse{
+		UserId:     request.Params.UserId,
+		Exclusions: genExclusions,
+	}, nil
+}
+
+func (h *Handler) PostUsersSetAvailability(ctx context.Context, request gen2.PostUsersSetAvailabilityRequestObject) (gen2.PostUsersSetAvailabilityResponseObject, error) {
+	if request.Body == nil {
+		return gen2.PostUsersSetAvailability400JSONResponse{
+			Error: struct {
+				Code    gen2.ErrorResponseErrorCode `json:"code"`
+				Message string                      `json:"message"`
+			}{
+				Code:    gen2.INVALIDINPUT,
+				Message: "request body is required",
+			},
+		}, nil
+	}
+
+	var reason string
+	if request.Body.Reason != nil {
+		reason = *request.Body.Reason
+	}
+
+	availability, reassigned, err := h.userUseCase.SetUserAvailability(ctx, request.Body.UserId, request.Body.Start, request.Body.End, reason)
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok {
+			switch domainErr.Code {
+			case entity2.ErrorCodeNotFound:
+				return gen2.PostUsersSetAvailability404JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.NOTFOUND,
+						Message: domainErr.Message,
+					},
+				}, nil
+			case entity2.ErrorCodeInvalidInput:
+				return gen2.PostUsersSetAvailability400JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.INVALIDINPUT,
+						Message: domainErr.Message,
+					},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return gen2.PostUsersSetAvailability200JSONResponse{
+		Availability: gen2.UserAvailability{
+			Id:     availability.ID,
+			UserId: availability.UserID,
+			Start:  availability.StartsAt,
+			End:    availability.EndsAt,
+			Reason: availability.Reason,
+		},
+		ReassignedPrs: reassigned,
+	}, nil
+}
+
+func (h *Handler) PostUsersImportAvailability(ctx context.Context, request gen2.PostUsersImportAvailabilityRequestObject) (gen2.PostUsersImportAvailabilityResponseObject, error) {
+	// Без содержимого календаря используется файл, настроенный на сервере
+	var calendar io.Reader
+	if request.Body != nil && request.Body.Ics != nil {
+		calendar = strings.NewReader(*request.Body.Ics)
+	}
+
+	result, err := h.userUseCase.ImportAvailabilityCalendar(ctx, calendar)
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok && domainErr.Code == entity2.ErrorCodeInvalidInput {
+			return gen2.PostUsersImportAvailability400JSONResponse{
+				Error: struct {
+					Code    gen2.ErrorResponseErrorCode `json:"code"`
+					Message string                      `json:"message"`
+				}{
+					Code:    gen2.INVALIDINPUT,
+					Message: domainErr.Message,
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen2.PostUsersImportAvailability200JSONResponse{
+		Imported:        result.Imported,
+		Skipped:         result.Skipped,
+		UnmatchedEvents: result.UnmatchedEvents,
+		ReassignedPrs:   result.ReassignedPRs,
+	}, nil
+}
+
+func (h *Handler) PostPullRequestCreate(ctx context.Context, request gen2.PostPullRequestCreateRequestObject) (gen2.PostPullRequestCreateResponseObject, error) {
+	if request.Body == nil {
+		return gen2.PostPullRequestCreate404JSONResponse{
+			Error: struct {
+				Code    gen2.ErrorResponseErrorCode `json:"code"`
+				Message string                      `json:"message"`
+			}{
+				Code:    gen2.NOTFOUND,
+				Message: "request body is required",
+			},
+		}, nil
+	}
+
+	input := entity2.CreatePullRequestInput{
+		PullRequestID:   request.Body.PullRequestId,
+		PullRequestName: request.Body.PullRequestName,
+		AuthorID:        request.Body.AuthorId,
+	}
+	if request.Body.ChangedFiles != nil {
+		input.ChangedFiles = *request.Body.ChangedFiles
+	}
+	if request.Body.RequiredTags != nil {
+		input.RequiredTags = *request.Body.RequiredTags
+	}
+
+	pr, uncoveredTags, err := h.pullRequestUseCase.CreatePullRequest(ctx, input)
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok {
+			switch domainErr.Code {
+			case entity2.ErrorCodePRExists:
+				return gen2.PostPullRequestCreate409JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.PREXISTS,
+						Message: domainErr.Message,
+					},
+				}, nil
+			case entity2.ErrorCodeNotFound:
+				return gen2.PostPullRequestCreate404JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.NOTFOUND,
+						Message: domainErr.Message,
+					},
+				}, nil
+			case entity2.ErrorCodeInvalidInput:
+				return gen2.PostPullRequestCreate400JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.INVALIDINPUT,
+						Message: domainErr.Message,
+					},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return gen2.PostPullRequestCreate201JSONResponse{
+		Pr:            entityToGenPullRequest(pr),
+		UncoveredTags: &uncoveredTags,
+	}, nil
+}
+
+func (h *Handler) PostPullRequestMerge(ctx context.Context, request gen2.PostPullRequestMergeRequestObject) (gen2.PostPullRequestMergeResponseObject, error) {
+	if request.Body == nil {
+		return gen2.PostPullRequestMerge404JSONResponse{
+			Error: struct {
+				Code    gen2.ErrorResponseErrorCode `json:"code"`
+				Message string                      `json:"message"`
+			}{
+				Code:    gen2.NOTFOUND,
+				Message: "request body is required",
+			},
+		}, nil
+	}
+
+	pr, err := h.pullRequestUseCase.MergePullRequest(ctx, request.Body.PullRequestId)
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok {
+			switch domainErr.Code {
+			case entity2.ErrorCodeNotFound:
+				return gen2.PostPullRequestMerge404JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.NOTFOUND,
+						Message: domainErr.Message,
+					},
+				}, nil
+			case entity2.ErrorCodePRMerged, entity2.ErrorCodePRClosed:
+				return gen2.PostPullRequestMerge409JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    entityErrorCodeToGen(domainErr.Code),
+						Message: domainErr.Message,
+					},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return gen2.PostPullRequestMerge200JSONResponse{
+		Pr: entityToGenPullRequest(pr),
+	}, nil
+}
+
+func (h *Handler) PostPullRequestClose(ctx context.Context, request gen2.PostPullRequestCloseRequestObject) (gen2.PostPullRequestCloseResponseObject, error) {
+	if request.Body == nil {
+		return gen2.PostPullRequestClose404JSONResponse{
+			Error: struct {
+				Code    gen2.ErrorResponseErrorCode `json:"code"`
+				Message string                      `json:"message"`
+			}{
+				Code:    gen2.NOTFOUND,
+				Message: "request body is required",
+			},
+		}, nil
+	}
+
+	pr, err := h.pullRequestUseCase.ClosePullRequest(ctx, request.Body.PullRequestId)
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok {
+			switch domainErr.Code {
+			case entity2.ErrorCodeNotFound:
+				return gen2.PostPullRequestClose404JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.NOTFOUND,
+						Message: domainErr.Message,
+					},
+				}, nil
+			case entity2.ErrorCodePRMerged, entity2.ErrorCodePRClosed:
+				return gen2.PostPullRequestClose409JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    entityErrorCodeToGen(domainErr.Code),
+						Message: domainErr.Message,
+					},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return gen2.PostPullRequestClose200JSONResponse{
+		Pr: entityToGenPullRequest(pr),
+	}, nil
+}
+
+func (h *Handler) PostPullRequestReopen(ctx context.Context, request gen2.PostPullRequestReopenRequestObject) (gen2.PostPullRequestReopenResponseObject, error) {
+	if request.Body == nil {
+		return gen2.PostPullRequestReopen404JSONResponse{
+			Error: struct {
+				Code    gen2.ErrorResponseErrorCode `json:"code"`
+				Message string                      `json:"message"`
+			}{
+				Code:    gen2.NOTFOUND,
+				Message: "request body is required",
+			},
+		}, nil
+	}
+
+	pr, err := h.pullRequestUseCase.ReopenPullRequest(ctx, request.Body.PullRequestId)
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok {
+			switch domainErr.Code {
+			case entity2.ErrorCodeNotFound:
+				return gen2.PostPullRequestReopen404JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.NOTFOUND,
+						Message: domainErr.Message,
+					},
+				}, nil
+			case entity2.ErrorCodePRMerged, entity2.ErrorCodePRClosed:
+				return gen2.PostPullRequestReopen409JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    entityErrorCodeToGen(domainErr.Code),
+						Message: domainErr.Message,
+					},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return gen2.PostPullRequestReopen200JSONResponse{
+		Pr: entityToGenPullRequest(pr),
+	}, nil
+}
+
+func (h *Handler) PostPullRequestReassign(ctx context.Context, request gen2.PostPullRequestReassignRequestObject) (gen2.PostPullRequestReassignResponseObject, error) {
+	if request.Body == nil {
+		return gen2.PostPullRequestReassign404JSONResponse{
+			Error: struct {
+				Code    gen2.ErrorResponseErrorCode `json:"code"`
+				Message string                      `json:"message"`
+			}{
+				Code:    gen2.NOTFOUND,
+				Message: "request body is required",
+			},
+		}, nil
+	}
+
+	newUserID := ""
+	if request.Body.NewUserId != nil {
+		newUserID = *request.Body.NewUserId
+	}
+
+	pr, replacedBy, err := h.pullRequestUseCase.ReassignReviewer(ctx, request.Body.PullRequestId, request.Body.OldUserId, newUserID)
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok {
+			switch domainErr.Code {
+			case entity2.ErrorCodeInvalidInput:
+				return gen2.PostPullRequestReassign400JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.INVALIDINPUT,
+						Message: domainErr.Message,
+					},
+				}, nil
+			case entity2.ErrorCodeNotFound:
+				return gen2.PostPullRequestReassign404JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.NOTFOUND,
+						Message: domainErr.Message,
+					},
+				}, nil
+			case entity2.ErrorCodePRMerged, entity2.ErrorCodePRClosed, entity2.ErrorCodeNotAssigned, entity2.ErrorCodeAlreadyAssigned, entity2.ErrorCodeNoCandidate:
+				return gen2.PostPullRequestReassign409JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    entityErrorCodeToGen(domainErr.Code),
+						Message: domainErr.Message,
+					},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return gen2.PostPullRequestReassign200JSONResponse{
+		Pr:         *entityToGenPullRequest(pr),
+		ReplacedBy: replacedBy,
+	}, nil
+}
+
+func (h *Handler) PostPullRequestDecline(ctx context.Context, request gen2.PostPullRequestDeclineRequestObject) (gen2.PostPullRequestDeclineResponseObject, error) {
+	if request.Body == nil {
+		return gen2.PostPullRequestDecline400JSONResponse{
+			Error: struct {
+				Code    gen2.ErrorResponseErrorCode `json:"code"`
+				Message string                      `json:"message"`
+			}{
+				Code:    gen2.INVALIDINPUT,
+				Message: "request body is required",
+			},
+		}, nil
+	}
+
+	pr, replacedBy, err := h.pullRequestUseCase.DeclineReview(ctx, request.Body.PullRequestId, request.Body.UserId, request.Body.Reason)
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok {
+			switch domainErr.Code {
+			case entity2.ErrorCodeInvalidInput:
+				return gen2.PostPullRequestDecline400JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.INVALIDINPUT,
+						Message: domainErr.Message,
+					},
+				}, nil
+			case entity2.ErrorCodeNotFound:
+				return gen2.PostPullRequestDecline404JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.NOTFOUND,
+						Message: domainErr.Message,
+					},
+				}, nil
+			case entity2.ErrorCodePRMerged, entity2.ErrorCodePRClosed, entity2.ErrorCodeNotAssigned, entity2.ErrorCodeNoCandidate:
+				return gen2.PostPullRequestDecline409JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    entityErrorCodeToGen(domainErr.Code),
+						Message: domainErr.Message,
+					},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return gen2.PostPullRequestDecline200JSONResponse{
+		Pr:         *entityToGenPullRequest(pr),
+		ReplacedBy: replacedBy,
+	}, nil
+}
+
+func (h *Handler) PostPullRequestAddReviewer(ctx context.Context, request gen2.PostPullRequestAddReviewerRequestObject) (gen2.PostPullRequestAddReviewerResponseObject, error) {
+	if request.Body == nil {
+		return gen2.PostPullRequestAddReviewer400JSONResponse{
+			Error: struct {
+				Code    gen2.ErrorResponseErrorCode `json:"code"`
+				Message string                      `json:"message"`
+			}{
+				Code:    gen2.INVALIDINPUT,
+				Message: "request body is required",
+			},
+		}, nil
+	}
+
+	pr, err := h.pullRequestUseCase.AddReviewer(ctx, request.Body.PullRequestId, request.Body.UserId)
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok {
+			switch domainErr.Code {
+			case entity2.ErrorCodeNotFound:
+				return gen2.PostPullRequestAddReviewer404JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.NOTFOUND,
+						Message: domainErr.Message,
+					},
+				}, nil
+			case entity2.ErrorCodeInvalidInput:
+				return gen2.PostPullRequestAddReviewer400JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.INVALIDINPUT,
+						Message: domainErr.Message,
+					},
+				}, nil
+			case entity2.ErrorCodePRMerged, entity2.ErrorCodePRClosed, entity2.ErrorCodeAlreadyAssigned:
+				return gen2.PostPullRequestAddReviewer409JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    entityErrorCodeToGen(domainErr.Code),
+						Message: domainErr.Message,
+					},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return gen2.PostPullRequestAddReviewer200JSONResponse{
+		Pr: *entityToGenPullRequest(pr),
+	}, nil
+}
+
+func (h *Handler) PostPullRequestRemoveReviewer(ctx context.Context, request gen2.PostPullRequestRemoveReviewerRequestObject) (gen2.PostPullRequestRemoveReviewerResponseObject, error) {
+	if request.Body == nil {
+		return gen2.PostPullRequestRemoveReviewer404JSONResponse{
+			Error: struct {
+				Code    gen2.ErrorResponseErrorCode `json:"code"`
+				Message string                      `json:"message"`
+			}{
+				Code:    gen2.NOTFOUND,
+				Message: "request body is required",
+			},
+		}, nil
+	}
+
+	pr, err := h.pullRequestUseCase.RemoveReviewer(ctx, request.Body.PullRequestId, request.Body.UserId)
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok {
+			switch domainErr.Code {
+			case entity2.ErrorCodeNotFound:
+				return gen2.PostPullRequestRemoveReviewer404JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.NOTFOUND,
+						Message: domainErr.Message,
+					},
+				}, nil
+			case entity2.ErrorCodePRMerged, entity2.ErrorCodePRClosed, entity2.ErrorCodeNotAssigned:
+				return gen2.PostPullRequestRemoveReviewer409JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    entityErrorCodeToGen(domainErr.Code),
+						Message: domainErr.Message,
+					},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return gen2.PostPullRequestRemoveReviewer200JSONResponse{
+		Pr: *entityToGenPullRequest(pr),
+	}, nil
+}
+
+func (h *Handler) GetUsersGetReview(ctx context.Context, request gen2.GetUsersGetReviewRequestObject) (gen2.GetUsersGetReviewResponseObject, error) {
+	prs, err := h.userUseCase.GetUserReviews(ctx, request.Params.UserId)
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok && domainErr.Code == entity2.ErrorCodeNotFound {
+			return gen2.GetUsersGetReview404JSONResponse{
+				Error: struct {
+					Code    gen2.ErrorResponseErrorCode `json:"code"`
+					Message string                      `json:"message"`
+				}{
+					Code:    gen2.NOTFOUND,
+					Message: domainErr.Message,
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen2.GetUsersGetReview200JSONResponse{
+		UserId:       request.Params.UserId,
+		PullRequests: entityToGenPullRequestsShort(prs),
+	}, nil
+}
+
+func (h *Handler) PostUsersSetDigest(ctx context.Context, request gen2.PostUsersSetDigestRequestObject) (gen2.PostUsersSetDigestResponseObject, error) {
+	if request.Body == nil {
+		return gen2.PostUsersSetDigest400JSONResponse{
+			Error: struct {
+				Code    gen2.ErrorResponseErrorCode `json:"code"`
+				Message string                      `json:"message"`
+			}{
+				Code:    gen2.INVALIDINPUT,
+				Message: "request body is required",
+			},
+		}, nil
+	}
+
+	settings, err := h.digestUseCase.SetDigestSettings(ctx, request.Body.UserId, entity2.DigestSettingsUpdate{
+		Email:   request.Body.Email,
+		Enabled: request.Body.Enabled,
+	})
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok {
+			switch domainErr.Code {
+			case entity2.ErrorCodeNotFound:
+				return gen2.PostUsersSetDigest404JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.NOTFOUND,
+						Message: domainErr.Message,
+					},
+				}, nil
+			case entity2.ErrorCodeInvalidInput:
+				return gen2.PostUsersSetDigest400JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.INVALIDINPUT,
+						Message: domainErr.Message,
+					},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return gen2.PostUsersSetDigest200JSONResponse{Digest: entityToGenDigestSettings(settings)}, nil
+}
+
+func (h *Handler) GetDigestPreview(ctx context.Context, request gen2.GetDigestPreviewRequestObject) (gen2.GetDigestPreviewResponseObject, error) {
+	digest, err := h.digestUseCase.PreviewDigest(ctx, request.Params.UserId)
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok && domainErr.Code == entity2.ErrorCodeNotFound {
+			return gen2.GetDigestPreview404JSONResponse{
+				Error: struct {
+					Code    gen2.ErrorResponseErrorCode `json:"code"`
+					Message string                      `json:"message"`
+				}{
+					Code:    gen2.NOTFOUND,
+					Message: domainErr.Message,
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen2.GetDigestPreview200JSONResponse{
+		Digest: gen2.DigestPreview{
+			UserId:       digest.UserID,
+			Email:        digest.Email,
+			Date:         digest.Date,
+			GeneratedAt:  digest.GeneratedAt,
+			Subject:      digest.Subject,
+			Text:         digest.Text,
+			Html:         digest.HTML,
+			PullRequests: entityToGenPullRequestsShort(digest.Reviews),
+		},
+	}, nil
+}
+
+func (h *Handler) GetStatsReviewers(ctx context.Context, _ gen2.GetStatsReviewersRequestObject) (gen2.GetStatsReviewersResponseObject, error) {
+	stats, total, err := h.pullRequestUseCase.GetReviewerStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := gen2.ReviewerStatsResponse{
+		Stats:        make([]gen2.ReviewerStat, 0, len(stats)),
+		TotalReviews: total,
+	}
+
+	for _, stat := range stats {
+		response.Stats = append(response.Stats, gen2.ReviewerStat{
+			UserId:       stat.UserID,
+			ReviewsCount: stat.ReviewsCount,
+		})
+	}
+
+	return gen2.GetStatsReviewers200JSONResponse(response), nil
+}
+
+func (h *Handler) GetPullRequestOverdue(ctx context.Context, _ gen2.GetPullRequestOverdueRequestObject) (gen2.GetPullRequestOverdueResponseObject, error) {
+	assignments, err := h.pullRequestUseCase.GetOverdueReviews(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews := make([]gen2.OverdueReview, 0, len(assignments))
+	for _, a := range assignments {
+		reviews = append(reviews, gen2.OverdueReview{
+			PullRequestId:   a.PullRequestID,
+			PullRequestName: a.PullRequestName,
+			AuthorId:        a.AuthorID,
+			ReviewerId:      a.ReviewerID,
+			AssignedAt:      a.AssignedAt,
+			DueAt:           *a.DueAt,
+		})
+	}
+
+	return gen2.GetPullRequestOverdue200JSONResponse{Reviews: reviews}, nil
+}
+
+func (h *Handler) PostWebhooksSubscribe(ctx context.Context, request gen2.PostWebhooksSubscribeRequestObject) (gen2.PostWebhooksSubscribeResponseObject, error) {
+	if request.Body == nil {
+		return gen2.PostWebhooksSubscribe400JSONResponse{
 			Error: struct {
 				Code    gen2.ErrorResponseErrorCode `json:"code"`
 				Message string                      `json:"message"`
 			}{
-				Code:    gen2.NOTFOUND,
+				Code:    gen2.INVALIDINPUT,
 				Message: "request body is required",
 			},
 		}, nil
 	}
 
-	user, err := h.userUseCase.SetUserIsActive(ctx, request.Body.UserId, request.Body.IsActive)
+	eventTypes := make([]entity2.EventType, 0, len(request.Body.EventTypes))
+	for _, eventType := range request.Body.EventTypes {
+		eventTypes = append(eventTypes, entity2.EventType(eventType))
+	}
+	var secret string
+	if request.Body.Secret != nil {
+		secret = *request.Body.Secret
+	}
+
+	subscription, err := h.webhookUseCase.Subscribe(ctx, request.Body.Url, secret, eventTypes)
 	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok && domainErr.Code == entity2.ErrorCodeInvalidInput {
+			return gen2.PostWebhooksSubscribe400JSONResponse{
+				Error: struct {
+					Code    gen2.ErrorResponseErrorCode `json:"code"`
+					Message string                      `json:"message"`
+				}{
+					Code:    gen2.INVALIDINPUT,
+					Message: domainErr.Message,
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	response := entityToGenWebhookSubscription(subscription)
+	response.Secret = &subscription.Secret
+	return gen2.PostWebhooksSubscribe201JSONResponse{Subscription: response}, nil
+}
+
+func (h *Handler) PostWebhooksUnsubscribe(ctx context.Context, request gen2.PostWebhooksUnsubscribeRequestObject) (gen2.PostWebhooksUnsubscribeResponseObject, error) {
+	if request.Body == nil {
+		return gen2.PostWebhooksUnsubscribe404JSONResponse{
+			Error: struct {
+				Code    gen2.ErrorResponseErrorCode `json:"code"`
+				Message string                      `json:"message"`
+			}{
+				Code:    gen2.NOTFOUND,
+				Message: "request body is required",
+			},
+		}, nil
+	}
+
+	if err := h.webhookUseCase.Unsubscribe(ctx, request.Body.SubscriptionId); err != nil {
 		if domainErr, ok := err.(*entity2.DomainError); ok && domainErr.Code == entity2.ErrorCodeNotFound {
-			return gen2.PostUsersSetIsActive404JSONResponse{
+			return gen2.PostWebhooksUnsubscribe404JSONResponse{
 				Error: struct {
 					Code    gen2.ErrorResponseErrorCode `json:"code"`
 					Message string                      `json:"message"`
@@ -205,50 +1647,71 @@ func (h *Handler) PostUsersSetIsActive(ctx context.Context, request gen2.PostUse
 		return nil, err
 	}
 
-	return gen2.PostUsersSetIsActive200JSONResponse{
-		User: &gen2.User{
-			UserId:   user.UserID,
-			Username: user.Username,
-			TeamName: user.TeamName,
-			IsActive: user.IsActive,
-		},
-	}, nil
+	return gen2.PostWebhooksUnsubscribe200JSONResponse{SubscriptionId: request.Body.SubscriptionId}, nil
 }
 
-func (h *Handler) PostPullRequestCreate(ctx context.Context, request gen2.PostPullRequestCreateRequestObject) (gen2.PostPullRequestCreateResponseObject, error) {
+func (h *Handler) GetWebhooksList(ctx context.Context, _ gen2.GetWebhooksListRequestObject) (gen2.GetWebhooksListResponseObject, error) {
+	subscriptions, err := h.webhookUseCase.GetSubscriptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]gen2.WebhookSubscription, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		response = append(response, entityToGenWebhookSubscription(subscription))
+	}
+
+	return gen2.GetWebhooksList200JSONResponse{Subscriptions: response}, nil
+}
+
+func (h *Handler) GetWebhooksDeadLetters(ctx context.Context, _ gen2.GetWebhooksDeadLettersRequestObject) (gen2.GetWebhooksDeadLettersResponseObject, error) {
+	deliveries, err := h.webhookUseCase.GetDeadLetters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]gen2.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		response = append(response, entityToGenWebhookDelivery(delivery))
+	}
+
+	return gen2.GetWebhooksDeadLetters200JSONResponse{Deliveries: response}, nil
+}
+
+func (h *Handler) PostWebhooksRedeliver(ctx context.Context, request gen2.PostWebhooksRedeliverRequestObject) (gen2.PostWebhooksRedeliverResponseObject, error) {
 	if request.Body == nil {
-		return gen2.PostPullRequestCreate404JSONResponse{
+		return gen2.PostWebhooksRedeliver400JSONResponse{
 			Error: struct {
 				Code    gen2.ErrorResponseErrorCode `json:"code"`
 				Message string                      `json:"message"`
 			}{
-				Code:    gen2.NOTFOUND,
+				Code:    gen2.INVALIDINPUT,
 				Message: "request body is required",
 			},
 		}, nil
 	}
 
-	pr, err := h.pullRequestUseCase.CreatePullRequest(ctx, request.Body.PullRequestId, request.Body.PullRequestName, request.Body.AuthorId)
+	delivery, err := h.webhookUseCase.Redeliver(ctx, request.Body.DeliveryId)
 	if err != nil {
 		if domainErr, ok := err.(*entity2.DomainError); ok {
 			switch domainErr.Code {
-			case entity2.ErrorCodePRExists:
-				return gen2.PostPullRequestCreate409JSONResponse{
+			case entity2.ErrorCodeNotFound:
+				return gen2.PostWebhooksRedeliver404JSONResponse{
 					Error: struct {
 						Code    gen2.ErrorResponseErrorCode `json:"code"`
 						Message string                      `json:"message"`
 					}{
-						Code:    gen2.PREXISTS,
+						Code:    gen2.NOTFOUND,
 						Message: domainErr.Message,
 					},
 				}, nil
-			case entity2.ErrorCodeNotFound:
-				return gen2.PostPullRequestCreate404JSONResponse{
+			case entity2.ErrorCodeInvalidInput:
+				return gen2.PostWebhooksRedeliver400JSONResponse{
 					Error: struct {
 						Code    gen2.ErrorResponseErrorCode `json:"code"`
 						Message string                      `json:"message"`
 					}{
-						Code:    gen2.NOTFOUND,
+						Code:    gen2.INVALIDINPUT,
 						Message: domainErr.Message,
 					},
 				}, nil
@@ -257,64 +1720,72 @@ func (h *Handler) PostPullRequestCreate(ctx context.Context, request gen2.PostPu
 		return nil, err
 	}
 
-	return gen2.PostPullRequestCreate201JSONResponse{
-		Pr: entityToGenPullRequest(pr),
-	}, nil
+	return gen2.PostWebhooksRedeliver200JSONResponse{Delivery: entityToGenWebhookDelivery(delivery)}, nil
 }
 
-func (h *Handler) PostPullRequestMerge(ctx context.Context, request gen2.PostPullRequestMergeRequestObject) (gen2.PostPullRequestMergeResponseObject, error) {
+func (h *Handler) PostIntegrationsAccountsSet(ctx context.Context, request gen2.PostIntegrationsAccountsSetRequestObject) (gen2.PostIntegrationsAccountsSetResponseObject, error) {
 	if request.Body == nil {
-		return gen2.PostPullRequestMerge404JSONResponse{
+		return gen2.PostIntegrationsAccountsSet400JSONResponse{
 			Error: struct {
 				Code    gen2.ErrorResponseErrorCode `json:"code"`
 				Message string                      `json:"message"`
 			}{
-				Code:    gen2.NOTFOUND,
+				Code:    gen2.INVALIDINPUT,
 				Message: "request body is required",
 			},
 		}, nil
 	}
 
-	pr, err := h.pullRequestUseCase.MergePullRequest(ctx, request.Body.PullRequestId)
+	account, err := h.codeHostUseCase.SetAccount(ctx, entity2.CodeHostProvider(request.Body.Provider), request.Body.Login, request.Body.UserId)
 	if err != nil {
-		if domainErr, ok := err.(*entity2.DomainError); ok && domainErr.Code == entity2.ErrorCodeNotFound {
-			return gen2.PostPullRequestMerge404JSONResponse{
-				Error: struct {
-					Code    gen2.ErrorResponseErrorCode `json:"code"`
-					Message string                      `json:"message"`
-				}{
-					Code:    gen2.NOTFOUND,
-					Message: domainErr.Message,
-				},
-			}, nil
+		if domainErr, ok := err.(*entity2.DomainError); ok {
+			switch domainErr.Code {
+			case entity2.ErrorCodeNotFound:
+				return gen2.PostIntegrationsAccountsSet404JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.NOTFOUND,
+						Message: domainErr.Message,
+					},
+				}, nil
+			case entity2.ErrorCodeInvalidInput:
+				return gen2.PostIntegrationsAccountsSet400JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.INVALIDINPUT,
+						Message: domainErr.Message,
+					},
+				}, nil
+			}
 		}
 		return nil, err
 	}
 
-	return gen2.PostPullRequestMerge200JSONResponse{
-		Pr: entityToGenPullRequest(pr),
-	}, nil
+	return gen2.PostIntegrationsAccountsSet200JSONResponse{Account: entityToGenCodeHostAccount(account)}, nil
 }
 
-func (h *Handler) PostPullRequestReassign(ctx context.Context, request gen2.PostPullRequestReassignRequestObject) (gen2.PostPullRequestReassignResponseObject, error) {
+func (h *Handler) PostIntegrationsAccountsRemove(ctx context.Context, request gen2.PostIntegrationsAccountsRemoveRequestObject) (gen2.PostIntegrationsAccountsRemoveResponseObject, error) {
 	if request.Body == nil {
-		return gen2.PostPullRequestReassign404JSONResponse{
+		return gen2.PostIntegrationsAccountsRemove400JSONResponse{
 			Error: struct {
 				Code    gen2.ErrorResponseErrorCode `json:"code"`
 				Message string                      `json:"message"`
 			}{
-				Code:    gen2.NOTFOUND,
+				Code:    gen2.INVALIDINPUT,
 				Message: "request body is required",
 			},
 		}, nil
 	}
 
-	pr, replacedBy, err := h.pullRequestUseCase.ReassignReviewer(ctx, request.Body.PullRequestId, request.Body.OldUserId)
-	if err != nil {
+	if err := h.codeHostUseCase.RemoveAccount(ctx, entity2.CodeHostProvider(request.Body.Provider), request.Body.Login); err != nil {
 		if domainErr, ok := err.(*entity2.DomainError); ok {
 			switch domainErr.Code {
 			case entity2.ErrorCodeNotFound:
-				return gen2.PostPullRequestReassign404JSONResponse{
+				return gen2.PostIntegrationsAccountsRemove404JSONResponse{
 					Error: struct {
 						Code    gen2.ErrorResponseErrorCode `json:"code"`
 						Message string                      `json:"message"`
@@ -323,13 +1794,13 @@ func (h *Handler) PostPullRequestReassign(ctx context.Context, request gen2.Post
 						Message: domainErr.Message,
 					},
 				}, nil
-			case entity2.ErrorCodePRMerged, entity2.ErrorCodeNotAssigned, entity2.ErrorCodeNoCandidate:
-				return gen2.PostPullRequestReassign409JSONResponse{
+			case entity2.ErrorCodeInvalidInput:
+				return gen2.PostIntegrationsAccountsRemove400JSONResponse{
 					Error: struct {
 						Code    gen2.ErrorResponseErrorCode `json:"code"`
 						Message string                      `json:"message"`
 					}{
-						Code:    entityErrorCodeToGen(domainErr.Code),
+						Code:    gen2.INVALIDINPUT,
 						Message: domainErr.Message,
 					},
 				}, nil
@@ -338,22 +1809,22 @@ func (h *Handler) PostPullRequestReassign(ctx context.Context, request gen2.Post
 		return nil, err
 	}
 
-	return gen2.PostPullRequestReassign200JSONResponse{
-		Pr:         *entityToGenPullRequest(pr),
-		ReplacedBy: replacedBy,
+	return gen2.PostIntegrationsAccountsRemove200JSONResponse{
+		Provider: request.Body.Provider,
+		Login:    entity2.NormalizeCodeHostLogin(request.Body.Login),
 	}, nil
 }
 
-func (h *Handler) GetUsersGetReview(ctx context.Context, request gen2.GetUsersGetReviewRequestObject) (gen2.GetUsersGetReviewResponseObject, error) {
-	prs, err := h.userUseCase.GetUserReviews(ctx, request.Params.UserId)
+func (h *Handler) GetIntegrationsAccountsList(ctx context.Context, request gen2.GetIntegrationsAccountsListRequestObject) (gen2.GetIntegrationsAccountsListResponseObject, error) {
+	accounts, err := h.codeHostUseCase.GetAccounts(ctx, entity2.CodeHostProvider(request.Params.Provider))
 	if err != nil {
-		if domainErr, ok := err.(*entity2.DomainError); ok && domainErr.Code == entity2.ErrorCodeNotFound {
-			return gen2.GetUsersGetReview404JSONResponse{
+		if domainErr, ok := err.(*entity2.DomainError); ok && domainErr.Code == entity2.ErrorCodeInvalidInput {
+			return gen2.GetIntegrationsAccountsList400JSONResponse{
 				Error: struct {
 					Code    gen2.ErrorResponseErrorCode `json:"code"`
 					Message string                      `json:"message"`
 				}{
-					Code:    gen2.NOTFOUND,
+					Code:    gen2.INVALIDINPUT,
 					Message: domainErr.Message,
 				},
 			}, nil
@@ -361,41 +1832,129 @@ func (h *Handler) GetUsersGetReview(ctx context.Context, request gen2.GetUsersGe
 		return nil, err
 	}
 
-	genPRs := make([]gen2.PullRequestShort, 0, len(prs))
-	for _, pr := range prs {
-		genPRs = append(genPRs, gen2.PullRequestShort{
-			PullRequestId:   pr.PullRequestID,
-			PullRequestName: pr.PullRequestName,
-			AuthorId:        pr.AuthorID,
-			Status:          entityStatusToGenShort(pr.Status),
-		})
+	response := make([]gen2.CodeHostAccount, 0, len(accounts))
+	for _, account := range accounts {
+		response = append(response, entityToGenCodeHostAccount(account))
 	}
 
-	return gen2.GetUsersGetReview200JSONResponse{
-		UserId:       request.Params.UserId,
-		PullRequests: genPRs,
-	}, nil
+	return gen2.GetIntegrationsAccountsList200JSONResponse{Accounts: response}, nil
 }
 
-func (h *Handler) GetStatsReviewers(ctx context.Context, _ gen2.GetStatsReviewersRequestObject) (gen2.GetStatsReviewersResponseObject, error) {
-	stats, total, err := h.pullRequestUseCase.GetReviewerStats(ctx)
+func (h *Handler) PostIntegrationsProjectsSet(ctx context.Context, request gen2.PostIntegrationsProjectsSetRequestObject) (gen2.PostIntegrationsProjectsSetResponseObject, error) {
+	if request.Body == nil {
+		return gen2.PostIntegrationsProjectsSet400JSONResponse{
+			Error: struct {
+				Code    gen2.ErrorResponseErrorCode `json:"code"`
+				Message string                      `json:"message"`
+			}{
+				Code:    gen2.INVALIDINPUT,
+				Message: "request body is required",
+			},
+		}, nil
+	}
+
+	project, err := h.codeHostUseCase.SetProject(ctx, entity2.CodeHostProvider(request.Body.Provider), request.Body.Repository, request.Body.TeamName)
 	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok {
+			switch domainErr.Code {
+			case entity2.ErrorCodeNotFound:
+				return gen2.PostIntegrationsProjectsSet404JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.NOTFOUND,
+						Message: domainErr.Message,
+					},
+				}, nil
+			case entity2.ErrorCodeInvalidInput:
+				return gen2.PostIntegrationsProjectsSet400JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.INVALIDINPUT,
+						Message: domainErr.Message,
+					},
+				}, nil
+			}
+		}
 		return nil, err
 	}
 
-	response := gen2.ReviewerStatsResponse{
-		Stats:        make([]gen2.ReviewerStat, 0, len(stats)),
-		TotalReviews: total,
+	return gen2.PostIntegrationsProjectsSet200JSONResponse{Project: entityToGenCodeHostProject(project)}, nil
+}
+
+func (h *Handler) PostIntegrationsProjectsRemove(ctx context.Context, request gen2.PostIntegrationsProjectsRemoveRequestObject) (gen2.PostIntegrationsProjectsRemoveResponseObject, error) {
+	if request.Body == nil {
+		return gen2.PostIntegrationsProjectsRemove400JSONResponse{
+			Error: struct {
+				Code    gen2.ErrorResponseErrorCode `json:"code"`
+				Message string                      `json:"message"`
+			}{
+				Code:    gen2.INVALIDINPUT,
+				Message: "request body is required",
+			},
+		}, nil
 	}
 
-	for _, stat := range stats {
-		response.Stats = append(response.Stats, gen2.ReviewerStat{
-			UserId:       stat.UserID,
-			ReviewsCount: stat.ReviewsCount,
-		})
+	if err := h.codeHostUseCase.RemoveProject(ctx, entity2.CodeHostProvider(request.Body.Provider), request.Body.Repository); err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok {
+			switch domainErr.Code {
+			case entity2.ErrorCodeNotFound:
+				return gen2.PostIntegrationsProjectsRemove404JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.NOTFOUND,
+						Message: domainErr.Message,
+					},
+				}, nil
+			case entity2.ErrorCodeInvalidInput:
+				return gen2.PostIntegrationsProjectsRemove400JSONResponse{
+					Error: struct {
+						Code    gen2.ErrorResponseErrorCode `json:"code"`
+						Message string                      `json:"message"`
+					}{
+						Code:    gen2.INVALIDINPUT,
+						Message: domainErr.Message,
+					},
+				}, nil
+			}
+		}
+		return nil, err
 	}
 
-	return gen2.GetStatsReviewers200JSONResponse(response), nil
+	return gen2.PostIntegrationsProjectsRemove200JSONResponse{
+		Provider:   request.Body.Provider,
+		Repository: entity2.NormalizeCodeHostRepository(request.Body.Repository),
+	}, nil
+}
+
+func (h *Handler) GetIntegrationsProjectsList(ctx context.Context, request gen2.GetIntegrationsProjectsListRequestObject) (gen2.GetIntegrationsProjectsListResponseObject, error) {
+	projects, err := h.codeHostUseCase.GetProjects(ctx, entity2.CodeHostProvider(request.Params.Provider))
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); ok && domainErr.Code == entity2.ErrorCodeInvalidInput {
+			return gen2.GetIntegrationsProjectsList400JSONResponse{
+				Error: struct {
+					Code    gen2.ErrorResponseErrorCode `json:"code"`
+					Message string                      `json:"message"`
+				}{
+					Code:    gen2.INVALIDINPUT,
+					Message: domainErr.Message,
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	response := make([]gen2.CodeHostProject, 0, len(projects))
+	for _, project := range projects {
+		response = append(response, entityToGenCodeHostProject(project))
+	}
+
+	return gen2.GetIntegrationsProjectsList200JSONResponse{Projects: response}, nil
 }
 
 // Вспомогательные функции для конвертации
@@ -410,15 +1969,186 @@ func entityToGenPullRequest(pr *entity2.PullRequest) *gen2.PullRequest {
 		CreatedAt:         pr.CreatedAt,
 		MergedAt:          pr.MergedAt,
 	}
+	if len(pr.RequiredTags) > 0 {
+		genPR.RequiredTags = &pr.RequiredTags
+	}
+	if len(pr.Declines) > 0 {
+		declines := make([]gen2.ReviewDecline, 0, len(pr.Declines))
+		for _, decline := range pr.Declines {
+			declines = append(declines, gen2.ReviewDecline{
+				UserId:     decline.UserID,
+				Reason:     decline.Reason,
+				DeclinedAt: decline.DeclinedAt,
+			})
+		}
+		genPR.Declines = &declines
+	}
+	if pr.Escalation != nil {
+		genPR.Escalation = &gen2.ReviewEscalation{
+			UserId:      pr.Escalation.UserID,
+			EscalatedAt: pr.Escalation.EscalatedAt,
+		}
+	}
 	return genPR
 }
 
+func entityToGenUser(user *entity2.User) *gen2.User {
+	genUser := &gen2.User{
+		UserId:         user.UserID,
+		Username:       user.Username,
+		TeamName:       user.TeamName,
+		IsActive:       user.IsActive,
+		MaxOpenReviews: user.MaxOpenReviews,
+		ReviewWeight:   user.ReviewWeight,
+		Level:          gen2.UserLevel(user.Level),
+	}
+	if user.Schedule != nil {
+		genUser.Schedule = &gen2.WorkSchedule{
+			Timezone:  user.Schedule.Timezone,
+			WorkStart: entity2.FormatClock(user.Schedule.WorkStart),
+			WorkEnd:   entity2.FormatClock(user.Schedule.WorkEnd),
+		}
+	}
+	return genUser
+}
+
+func entityToGenTeamHolidays(teamName string, holidays []entity2.TeamHoliday) gen2.TeamHolidaysResponse {
+	response := gen2.TeamHolidaysResponse{
+		TeamName: teamName,
+		Holidays: make([]gen2.TeamHoliday, 0, len(holidays)),
+	}
+	for _, holiday := range holidays {
+		response.Holidays = append(response.Holidays, gen2.TeamHoliday{
+			Date: holiday.Date,
+			Name: holiday.Name,
+		})
+	}
+	return response
+}
+
+func entityToGenWebhookSubscription(subscription *entity2.WebhookSubscription) gen2.WebhookSubscription {
+	eventTypes := make([]string, 0, len(subscription.EventTypes))
+	for _, eventType := range subscription.EventTypes {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+	return gen2.WebhookSubscription{
+		SubscriptionId: subscription.ID,
+		Url:            subscription.URL,
+		EventTypes:     eventTypes,
+		CreatedAt:      subscription.CreatedAt,
+	}
+}
+
+func entityToGenWebhookDelivery(delivery *entity2.WebhookDelivery) gen2.WebhookDelivery {
+	response := gen2.WebhookDelivery{
+		DeliveryId:     delivery.ID,
+		SubscriptionId: delivery.SubscriptionID,
+		Url:            delivery.URL,
+		EventId:        delivery.EventID,
+		EventType:      string(delivery.EventType),
+		Status:         gen2.WebhookDeliveryStatus(delivery.Status),
+		Attempts:       delivery.Attempts,
+		LastError:      delivery.LastError,
+		CreatedAt:      delivery.CreatedAt,
+	}
+	if delivery.Status == entity2.WebhookDeliveryPending {
+		nextAttemptAt := delivery.NextAttemptAt
+		response.NextAttemptAt = &nextAttemptAt
+	}
+	return response
+}
+
+func entityToGenCodeHostAccount(account *entity2.CodeHostAccount) gen2.CodeHostAccount {
+	return gen2.CodeHostAccount{
+		Provider: string(account.Provider),
+		Login:    account.Login,
+		UserId:   account.UserID,
+	}
+}
+
+func entityToGenChatChannel(channel *entity2.ChatChannel) gen2.ChatChannel {
+	assigned := channel.Templates[entity2.NotificationAssigned]
+	reassigned := channel.Templates[entity2.NotificationReassigned]
+	overdue := channel.Templates[entity2.NotificationOverdue]
+	return gen2.ChatChannel{
+		TeamName:   channel.TeamName,
+		WebhookUrl: channel.WebhookURL,
+		Templates: gen2.ChatTemplates{
+			Assigned:   &assigned,
+			Reassigned: &reassigned,
+			Overdue:    &overdue,
+		},
+	}
+}
+
+func entityToGenDigestSettings(settings *entity2.DigestSettings) gen2.DigestSettings {
+	result := gen2.DigestSettings{
+		UserId:  settings.UserID,
+		Email:   settings.Email,
+		Enabled: settings.Enabled,
+	}
+	if settings.LastSentOn != "" {
+		result.LastSentOn = &settings.LastSentOn
+	}
+	return result
+}
+
+func entityToGenPullRequestsShort(assignments []*entity2.ReviewAssignment) []gen2.PullRequestShort {
+	result := make([]gen2.PullRequestShort, 0, len(assignments))
+	for _, a := range assignments {
+		result = append(result, gen2.PullRequestShort{
+			PullRequestId:   a.PullRequestID,
+			PullRequestName: a.PullRequestName,
+			AuthorId:        a.AuthorID,
+			Status:          entityStatusToGenShort(a.Status),
+			AssignedAt:      a.AssignedAt,
+			DueAt:           a.DueAt,
+			Overdue:         a.Overdue,
+		})
+	}
+	return result
+}
+
+func entityToGenCodeHostProject(project *entity2.CodeHostProject) gen2.CodeHostProject {
+	return gen2.CodeHostProject{
+		Provider:   string(project.Provider),
+		Repository: project.Repository,
+		TeamName:   project.TeamName,
+	}
+}
+
+func entityToGenReviewerExclusion(exclusion *entity2.ReviewerExclusion) gen2.ReviewerExclusion {
+	return gen2.ReviewerExclusion{
+		UserId:         exclusion.UserID,
+		ExcludedUserId: exclusion.ExcludedUserID,
+		Reason:         exclusion.Reason,
+		CreatedAt:      exclusion.CreatedAt,
+	}
+}
+
+func entityToGenTeamSettings(settings *entity2.TeamSettings) gen2.TeamSettings {
+	return gen2.TeamSettings{
+		TeamName:              settings.TeamName,
+		DefaultMaxOpenReviews: settings.DefaultMaxOpenReviews,
+		SelectionMode:         gen2.SelectionMode(settings.SelectionMode),
+		RequiredReviewerTeam:  settings.RequiredReviewerTeam,
+		MinReviewerLevel:      string(settings.MinReviewerLevel),
+		PairHistoryWindow:     settings.PairHistoryWindow,
+		ReviewSlaHours:        settings.ReviewSLAHours,
+		StaleReassignHours:    settings.StaleReassignHours,
+		StaleEscalationHours:  settings.StaleEscalationHours,
+		PreferWorkingHours:    settings.PreferWorkingHours,
+	}
+}
+
 func entityStatusToGen(status entity2.PullRequestStatus) gen2.PullRequestStatus {
 	switch status {
 	case entity2.PullRequestStatusOpen:
 		return gen2.PullRequestStatusOPEN
 	case entity2.PullRequestStatusMerged:
 		return gen2.PullRequestStatusMERGED
+	case entity2.PullRequestStatusClosed:
+		return gen2.PullRequestStatusCLOSED
 	default:
 		return gen2.PullRequestStatusOPEN
 	}
@@ -430,6 +2160,8 @@ func entityStatusToGenShort(status entity2.PullRequestStatus) gen2.PullRequestSh
 		return gen2.PullRequestShortStatusOPEN
 	case entity2.PullRequestStatusMerged:
 		return gen2.PullRequestShortStatusMERGED
+	case entity2.PullRequestStatusClosed:
+		return gen2.PullRequestShortStatusCLOSED
 	default:
 		return gen2.PullRequestShortStatusOPEN
 	}
@@ -443,12 +2175,18 @@ func entityErrorCodeToGen(code entity2.ErrorCode) gen2.ErrorResponseErrorCode {
 		return gen2.PREXISTS
 	case entity2.ErrorCodePRMerged:
 		return gen2.PRMERGED
+	case entity2.ErrorCodePRClosed:
+		return gen2.PRCLOSED
 	case entity2.ErrorCodeNotAssigned:
 		return gen2.NOTASSIGNED
+	case entity2.ErrorCodeAlreadyAssigned:
+		return gen2.ALREADYASSIGNED
 	case entity2.ErrorCodeNoCandidate:
 		return gen2.NOCANDIDATE
 	case entity2.ErrorCodeNotFound:
 		return gen2.NOTFOUND
+	case entity2.ErrorCodeInvalidInput:
+		return gen2.INVALIDINPUT
 	default:
 		return gen2.NOTFOUND
 	}
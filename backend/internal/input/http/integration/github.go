@@ -0,0 +1,107 @@
+package integration
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"test_task_avito/backend/internal/entity"
+	"test_task_avito/backend/internal/port"
+	"test_task_avito/backend/pkg/hmacsig"
+
+	"go.uber.org/zap"
+)
+
+// Заголовки запросов вебхуков GitHub
+const (
+	GitHubEventHeader     = "X-GitHub-Event"
+	GitHubSignatureHeader = "X-Hub-Signature-256"
+)
+
+// maxPayloadSize ограничение размера тела вебхука
+const maxPayloadSize = 5 << 20
+
+// GitHubHandler принимает вебхуки GitHub и применяет события pull_request к PR сервиса
+type GitHubHandler struct {
+	codeHost port.CodeHostUseCase
+	secret   []byte
+	logger   *zap.Logger
+}
+
+// NewGitHubHandler создает новый экземпляр GitHubHandler; secret — секрет вебхука, заданный в настройках репозитория
+func NewGitHubHandler(codeHost port.CodeHostUseCase, secret string, logger *zap.Logger) *GitHubHandler {
+	return &GitHubHandler{
+		codeHost: codeHost,
+		secret:   []byte(secret),
+		logger:   logger,
+	}
+}
+
+// gitHubPullRequestPayload поля события pull_request, которые использует сервис
+type gitHubPullRequestPayload struct {
+	Action      string `json:"action"`
+	PullRequest struct {
+		Number int    `json:"number"`
+		Title  string `json:"title"`
+		Draft  bool   `json:"draft"`
+		Merged bool   `json:"merged"`
+		User   struct {
+			Login string `json:"login"`
+		} `json:"user"`
+	} `json:"pull_request"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+func (h *GitHubHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxPayloadSize+1))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, entity.ErrorCodeInvalidInput, "cannot read request body")
+		return
+	}
+	if len(body) > maxPayloadSize {
+		writeError(w, http.StatusRequestEntityTooLarge, entity.ErrorCodeInvalidInput, "payload is too large")
+		return
+	}
+	if !hmacsig.Verify(h.secret, body, r.Header.Get(GitHubSignatureHeader)) {
+		writeError(w, http.StatusUnauthorized, entity.ErrorCodeInvalidInput, "invalid signature")
+		return
+	}
+
+	switch r.Header.Get(GitHubEventHeader) {
+	case "ping":
+		writeJSON(w, http.StatusOK, eventResponse{Result: "pong"})
+		return
+	case "pull_request":
+	default:
+		writeJSON(w, http.StatusOK, eventResponse{Result: string(entity.CodeHostEventIgnored), Reason: "unsupported event"})
+		return
+	}
+
+	var payload gitHubPullRequestPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		writeError(w, http.StatusBadRequest, entity.ErrorCodeInvalidInput, "invalid pull_request payload")
+		return
+	}
+
+	action := entity.CodeHostAction(payload.Action)
+	if payload.Action == "closed" && payload.PullRequest.Merged {
+		action = entity.CodeHostActionMerged
+	}
+
+	result, err := h.codeHost.HandlePullRequestEvent(r.Context(), &entity.CodeHostPullRequestEvent{
+		Provider:    entity.CodeHostGitHub,
+		Action:      action,
+		Repository:  payload.Repository.FullName,
+		Number:      payload.PullRequest.Number,
+		Title:       payload.PullRequest.Title,
+		AuthorLogin: payload.PullRequest.User.Login,
+		Draft:       payload.PullRequest.Draft,
+	})
+	if err != nil {
+		writeUseCaseError(w, h.logger, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newEventResponse(result))
+}
@@ -0,0 +1,128 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"test_task_avito/backend/internal/entity"
+	"test_task_avito/backend/internal/port"
+	"test_task_avito/backend/pkg/hmacsig"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+const testSecret = "secret"
+
+// fakeCodeHost запоминает переданные события; остальные методы достаются nil-интерфейсу
+type fakeCodeHost struct {
+	port.CodeHostUseCase
+
+	events []*entity.CodeHostPullRequestEvent
+}
+
+func (f *fakeCodeHost) HandlePullRequestEvent(_ context.Context, event *entity.CodeHostPullRequestEvent) (*entity.CodeHostEventResult, error) {
+	f.events = append(f.events, event)
+	return &entity.CodeHostEventResult{Outcome: entity.CodeHostEventCreated, PullRequestID: "github:org/repo#7"}, nil
+}
+
+func serveGitHub(t *testing.T, codeHost *fakeCodeHost, event, signature, body string) (int, eventResponse) {
+	t.Helper()
+	request := httptest.NewRequest(http.MethodPost, "/integrations/github/webhook", strings.NewReader(body))
+	request.Header.Set(GitHubEventHeader, event)
+	request.Header.Set(GitHubSignatureHeader, signature)
+	recorder := httptest.NewRecorder()
+
+	NewGitHubHandler(codeHost, testSecret, zap.NewNop()).ServeHTTP(recorder, request)
+
+	var response eventResponse
+	if recorder.Code == http.StatusOK {
+		if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+	}
+	return recorder.Code, response
+}
+
+func TestGitHubHandlerRejectsBadSignature(t *testing.T) {
+	codeHost := &fakeCodeHost{}
+	body := `{"action":"opened"}`
+
+	for _, signature := range []string{"", "sha256=00", hmacsig.Sign([]byte("other"), []byte(body))} {
+		status, _ := serveGitHub(t, codeHost, "pull_request", signature, body)
+		if status != http.StatusUnauthorized {
+			t.Errorf("signature %q: expected 401, got %d", signature, status)
+		}
+	}
+	if len(codeHost.events) != 0 {
+		t.Fatalf("unsigned events must not reach the use case, got %d", len(codeHost.events))
+	}
+}
+
+func TestGitHubHandlerIgnoresUnsupportedEvent(t *testing.T) {
+	codeHost := &fakeCodeHost{}
+	body := `{"ref":"refs/heads/main"}`
+
+	status, response := serveGitHub(t, codeHost, "push", hmacsig.Sign([]byte(testSecret), []byte(body)), body)
+	if status != http.StatusOK {
+		t.Fatalf("expected 200, got %d", status)
+	}
+	if response.Result != string(entity.CodeHostEventIgnored) || response.Reason != "unsupported event" {
+		t.Errorf("unexpected response %+v", response)
+	}
+	if len(codeHost.events) != 0 {
+		t.Fatalf("unsupported event must not reach the use case, got %d", len(codeHost.events))
+	}
+}
+
+func TestGitHubHandlerAppliesPullRequestEvent(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   string
+		action entity.CodeHostAction
+		draft  bool
+	}{
+		{
+			name:   "opened",
+			body:   `{"action":"opened","pull_request":{"number":7,"title":"Add search","draft":true,"user":{"login":"octocat"}},"repository":{"full_name":"org/repo"}}`,
+			action: entity.CodeHostActionOpened,
+			draft:  true,
+		},
+		{
+			name:   "closed as merged",
+			body:   `{"action":"closed","pull_request":{"number":7,"title":"Add search","merged":true,"user":{"login":"octocat"}},"repository":{"full_name":"org/repo"}}`,
+			action: entity.CodeHostActionMerged,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			codeHost := &fakeCodeHost{}
+
+			status, response := serveGitHub(t, codeHost, "pull_request", hmacsig.Sign([]byte(testSecret), []byte(tt.body)), tt.body)
+			if status != http.StatusOK {
+				t.Fatalf("expected 200, got %d", status)
+			}
+			if response.Result != string(entity.CodeHostEventCreated) || response.PullRequestID != "github:org/repo#7" {
+				t.Errorf("unexpected response %+v", response)
+			}
+			if len(codeHost.events) != 1 {
+				t.Fatalf("expected one event, got %d", len(codeHost.events))
+			}
+			event := codeHost.events[0]
+			want := entity.CodeHostPullRequestEvent{
+				Provider:    entity.CodeHostGitHub,
+				Action:      tt.action,
+				Repository:  "org/repo",
+				Number:      7,
+				Title:       "Add search",
+				AuthorLogin: "octocat",
+				Draft:       tt.draft,
+			}
+			if *event != want {
+				t.Errorf("event = %+v, want %+v", *event, want)
+			}
+		})
+	}
+}
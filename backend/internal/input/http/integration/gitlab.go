@@ -0,0 +1,96 @@
+package integration
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"test_task_avito/backend/internal/entity"
+	"test_task_avito/backend/internal/port"
+	"test_task_avito/backend/pkg/gitlabhook"
+
+	"go.uber.org/zap"
+)
+
+// gitLabActions соответствие действий merge request действиям хостинга кода
+var gitLabActions = map[gitlabhook.Action]entity.CodeHostAction{
+	gitlabhook.ActionOpen:   entity.CodeHostActionOpened,
+	gitlabhook.ActionReady:  entity.CodeHostActionReadyForReview,
+	gitlabhook.ActionMerge:  entity.CodeHostActionMerged,
+	gitlabhook.ActionClose:  entity.CodeHostActionClosed,
+	gitlabhook.ActionReopen: entity.CodeHostActionReopened,
+}
+
+// GitLabHandler принимает вебхуки GitLab и применяет события merge request к PR сервиса
+type GitLabHandler struct {
+	codeHost port.CodeHostUseCase
+	token    string
+	logger   *zap.Logger
+}
+
+// NewGitLabHandler создает новый экземпляр GitLabHandler; token — секретный токен, заданный в настройках вебхука проекта
+func NewGitLabHandler(codeHost port.CodeHostUseCase, token string, logger *zap.Logger) *GitLabHandler {
+	return &GitLabHandler{
+		codeHost: codeHost,
+		token:    token,
+		logger:   logger,
+	}
+}
+
+func (h *GitLabHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !gitlabhook.VerifyToken(h.token, r.Header.Get(gitlabhook.TokenHeader)) {
+		writeError(w, http.StatusUnauthorized, entity.ErrorCodeInvalidInput, "invalid token")
+		return
+	}
+	if r.Header.Get(gitlabhook.EventHeader) != gitlabhook.MergeRequestHook {
+		writeJSON(w, http.StatusOK, eventResponse{Result: string(entity.CodeHostEventIgnored), Reason: "unsupported event"})
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxPayloadSize+1))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, entity.ErrorCodeInvalidInput, "cannot read request body")
+		return
+	}
+	if len(body) > maxPayloadSize {
+		writeError(w, http.StatusRequestEntityTooLarge, entity.ErrorCodeInvalidInput, "payload is too large")
+		return
+	}
+
+	payload, err := gitlabhook.ParseMergeRequest(body)
+	if errors.Is(err, gitlabhook.ErrNotMergeRequest) {
+		writeJSON(w, http.StatusOK, eventResponse{Result: string(entity.CodeHostEventIgnored), Reason: "unsupported event"})
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, entity.ErrorCodeInvalidInput, "invalid merge request payload")
+		return
+	}
+
+	event := &entity.CodeHostPullRequestEvent{
+		Provider:    entity.CodeHostGitLab,
+		Repository:  payload.Project,
+		Number:      payload.IID,
+		Title:       payload.Title,
+		AuthorLogin: payload.AuthorUsername(),
+		Draft:       payload.Draft,
+	}
+	action, ok := gitLabActions[payload.Action]
+	if !ok {
+		// Обновления MR, кроме снятия признака черновика, не меняют состояние PR
+		writeJSON(w, http.StatusOK, eventResponse{
+			Result:        string(entity.CodeHostEventIgnored),
+			PullRequestID: event.PullRequestID(),
+			Reason:        "unsupported action " + string(payload.Action),
+		})
+		return
+	}
+	event.Action = action
+
+	result, err := h.codeHost.HandlePullRequestEvent(r.Context(), event)
+	if err != nil {
+		writeUseCaseError(w, h.logger, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newEventResponse(result))
+}
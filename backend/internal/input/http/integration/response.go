@@ -0,0 +1,62 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"test_task_avito/backend/internal/entity"
+
+	"go.uber.org/zap"
+)
+
+// eventResponse ответ на вебхук хостинга кода; виден в журнале доставок хостинга
+type eventResponse struct {
+	Result        string `json:"result"`
+	PullRequestID string `json:"pull_request_id,omitempty"`
+	Reason        string `json:"reason,omitempty"`
+}
+
+func newEventResponse(result *entity.CodeHostEventResult) eventResponse {
+	return eventResponse{
+		Result:        string(result.Outcome),
+		PullRequestID: result.PullRequestID,
+		Reason:        result.Reason,
+	}
+}
+
+type errorResponse struct {
+	Error struct {
+		Code    entity.ErrorCode `json:"code"`
+		Message string           `json:"message"`
+	} `json:"error"`
+}
+
+func writeError(w http.ResponseWriter, status int, code entity.ErrorCode, message string) {
+	var response errorResponse
+	response.Error.Code = code
+	response.Error.Message = message
+	writeJSON(w, status, response)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// writeUseCaseError отвечает на ошибку обработки события: доменные ошибки — 400/404/409, остальные — 500
+func writeUseCaseError(w http.ResponseWriter, logger *zap.Logger, err error) {
+	domainErr, ok := err.(*entity.DomainError)
+	if !ok {
+		logger.Error("failed to handle code host event", zap.Error(err))
+		writeError(w, http.StatusInternalServerError, "INTERNAL", "internal error")
+		return
+	}
+	status := http.StatusConflict
+	switch domainErr.Code {
+	case entity.ErrorCodeInvalidInput:
+		status = http.StatusBadRequest
+	case entity.ErrorCodeNotFound:
+		status = http.StatusNotFound
+	}
+	writeError(w, status, domainErr.Code, domainErr.Message)
+}
@@ -0,0 +1,139 @@
+package stream
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync"
+	"test_task_avito/backend/internal/entity"
+	"test_task_avito/backend/internal/port"
+	"test_task_avito/backend/pkg/sse"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// batchSize количество событий журнала, читаемых за одно обращение
+	batchSize = 100
+	// heartbeatInterval период комментариев, не дающих прокси закрыть соединение без событий
+	heartbeatInterval = 15 * time.Second
+	// retryDelay задержка переподключения клиента после разрыва потока
+	retryDelay = 3 * time.Second
+)
+
+// EventsHandler отдает доменные события потоком Server-Sent Events. ID события в потоке — номер записи
+// в журнале событий; клиент, переподключаясь с заголовком Last-Event-ID, получает пропущенные события.
+type EventsHandler struct {
+	events       port.EventStreamUseCase
+	pollInterval time.Duration
+	logger       *zap.Logger
+
+	done     chan struct{}
+	shutdown sync.Once
+}
+
+// NewEventsHandler создает новый экземпляр EventsHandler; pollInterval — период проверки новых событий
+func NewEventsHandler(events port.EventStreamUseCase, pollInterval time.Duration, logger *zap.Logger) *EventsHandler {
+	return &EventsHandler{
+		events:       events,
+		pollInterval: pollInterval,
+		logger:       logger,
+		done:         make(chan struct{}),
+	}
+}
+
+// Shutdown завершает открытые потоки, чтобы остановка сервера не ждала их отключения
+func (h *EventsHandler) Shutdown() {
+	h.shutdown.Do(func() { close(h.done) })
+}
+
+// eventPayload данные события в потоке; совпадают с телом исходящего вебхука
+type eventPayload struct {
+	ID         string                 `json:"id"`
+	Type       entity.EventType       `json:"type"`
+	OccurredAt time.Time              `json:"occurred_at"`
+	Data       map[string]interface{} `json:"data"`
+}
+
+func (h *EventsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+	filter := entity.EventFilter{
+		TeamName: query.Get("team_name"),
+		UserID:   query.Get("user_id"),
+	}
+
+	// Браузерный EventSource передает Last-Event-ID только при переподключении, поэтому начальную позицию
+	// можно задать параметром запроса
+	lastEventID := r.Header.Get(sse.LastEventIDHeader)
+	if lastEventID == "" {
+		lastEventID = query.Get("last_event_id")
+	}
+
+	position, err := h.events.OpenStream(ctx, filter, lastEventID)
+	if err != nil {
+		writeUseCaseError(w, h.logger, err)
+		return
+	}
+
+	writer, err := sse.NewWriter(w)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL", err.Error())
+		return
+	}
+	if err := writer.Retry(retryDelay); err != nil {
+		return
+	}
+
+	poll := time.NewTicker(h.pollInterval)
+	defer poll.Stop()
+	lastWrite := time.Now()
+	for {
+		records, next, err := h.events.ReadEvents(ctx, filter, position, batchSize)
+		for _, record := range records {
+			payload, err := json.Marshal(eventPayload{
+				ID:         record.Event.ID,
+				Type:       record.Event.Type,
+				OccurredAt: record.Event.OccurredAt.UTC(),
+				Data:       record.Event.Data,
+			})
+			if err != nil {
+				h.logger.Error("failed to encode stream event", zap.Int64("id", record.ID), zap.Error(err))
+				return
+			}
+			if err := writer.Event(strconv.FormatInt(record.ID, 10), string(record.Event.Type), payload); err != nil {
+				return
+			}
+			lastWrite = time.Now()
+		}
+		if err != nil {
+			// Клиент переподключится и продолжит с последнего полученного события
+			if ctx.Err() == nil {
+				h.logger.Warn("failed to read event stream", zap.Error(err))
+			}
+			return
+		}
+
+		// Пока журнал продвигается, читаем дальше без ожидания
+		progressed := next != position
+		position = next
+		if progressed {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-h.done:
+			return
+		case <-poll.C:
+		}
+		if time.Since(lastWrite) >= heartbeatInterval {
+			if err := writer.Comment("keepalive"); err != nil {
+				return
+			}
+			lastWrite = time.Now()
+		}
+	}
+}
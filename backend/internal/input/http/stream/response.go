@@ -0,0 +1,40 @@
+package stream
+
+import (
+	"encoding/json"
+	"net/http"
+	"test_task_avito/backend/internal/entity"
+
+	"go.uber.org/zap"
+)
+
+type errorResponse struct {
+	Error struct {
+		Code    entity.ErrorCode `json:"code"`
+		Message string           `json:"message"`
+	} `json:"error"`
+}
+
+func writeError(w http.ResponseWriter, status int, code entity.ErrorCode, message string) {
+	var response errorResponse
+	response.Error.Code = code
+	response.Error.Message = message
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+// writeUseCaseError отвечает на ошибку открытия потока: доменные ошибки — 400/404, остальные — 500
+func writeUseCaseError(w http.ResponseWriter, logger *zap.Logger, err error) {
+	domainErr, ok := err.(*entity.DomainError)
+	if !ok {
+		logger.Error("failed to open event stream", zap.Error(err))
+		writeError(w, http.StatusInternalServerError, "INTERNAL", "internal error")
+		return
+	}
+	status := http.StatusBadRequest
+	if domainErr.Code == entity.ErrorCodeNotFound {
+		status = http.StatusNotFound
+	}
+	writeError(w, status, domainErr.Code, domainErr.Message)
+}
@@ -0,0 +1,11 @@
+package port
+
+import "context"
+
+// CodeHostClient вызывает API хостинга кода
+type CodeHostClient interface {
+	// RequestReviewers запрашивает ревью PR number репозитория repository у пользователей с логинами logins
+	RequestReviewers(ctx context.Context, repository string, number int, logins []string) error
+	// RemoveRequestedReviewers снимает запрос ревью PR с пользователей с логинами logins
+	RemoveRequestedReviewers(ctx context.Context, repository string, number int, logins []string) error
+}
@@ -0,0 +1,26 @@
+package port
+
+import (
+	"context"
+	entity2 "test_task_avito/backend/internal/entity"
+)
+
+// EventPublisher приемник доменных событий из outbox (вебхуки, журнал, брокер сообщений).
+// Событие может быть передано повторно: получатели отбрасывают повторы по Event.ID.
+type EventPublisher interface {
+	// Publish публикует событие; при ошибке публикация повторяется
+	Publish(ctx context.Context, event *entity2.Event) error
+}
+
+// WebhookSender отправляет тело вебхука подписчику
+type WebhookSender interface {
+	// Send отправляет POST-запрос; ответ со статусом вне 2xx считается ошибкой
+	Send(ctx context.Context, url string, body []byte, headers map[string]string) error
+}
+
+// MessageBroker публикует сообщения в брокер сообщений
+type MessageBroker interface {
+	// Publish публикует сообщение в тему subject; messageID позволяет брокеру отбросить повтор.
+	// Возвращает управление после подтверждения приема сообщения сервером.
+	Publish(ctx context.Context, subject, messageID string, payload []byte) error
+}
@@ -0,0 +1,10 @@
+package port
+
+import "context"
+
+// JobLocker блокировка фоновых задач, общая для всех реплик сервиса
+type JobLocker interface {
+	// TryRunExclusive выполняет job, если блокировку name не держит другая реплика.
+	// Возвращает false без выполнения job, если блокировка занята.
+	TryRunExclusive(ctx context.Context, name string, job func(ctx context.Context) error) (bool, error)
+}
@@ -0,0 +1,9 @@
+package port
+
+import "context"
+
+// MailSender отправляет письма
+type MailSender interface {
+	// Send отправляет письмо с текстовой и HTML-версией на адрес to
+	Send(ctx context.Context, to, subject, text, html string) error
+}
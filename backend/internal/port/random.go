@@ -0,0 +1,10 @@
+package port
+
+// RandomSource источник случайных чисел для подбора ревьюверов.
+// Реализация должна быть безопасной для использования из нескольких горутин.
+type RandomSource interface {
+	// Float64 возвращает число из [0.0, 1.0)
+	Float64() float64
+	// Shuffle перемешивает n элементов с помощью swap
+	Shuffle(n int, swap func(i, j int))
+}
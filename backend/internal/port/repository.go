@@ -14,8 +14,21 @@ type TeamRepository interface {
 	GetTeam(ctx context.Context, teamName string) (*entity2.Team, error)
 	// TeamExists проверяет существование команды
 	TeamExists(ctx context.Context, teamName string) (bool, error)
-	// BulkDeactivateUsersByTeam деактивирует пользователей команды и возвращает количество обновленных записей
-	BulkDeactivateUsersByTeam(ctx context.Context, teamName string) (int64, error)
+	// BulkDeactivateUsersByTeam деактивирует пользователей команды и возвращает количество обновленных записей;
+	// events сохраняются в outbox в той же транзакции
+	BulkDeactivateUsersByTeam(ctx context.Context, teamName string, events ...*entity2.Event) (int64, error)
+	// GetTeamSettings получает настройки команды (значения по умолчанию, если настройки не сохранялись)
+	GetTeamSettings(ctx context.Context, teamName string) (*entity2.TeamSettings, error)
+	// SaveTeamSettings сохраняет настройки команды
+	SaveTeamSettings(ctx context.Context, settings *entity2.TeamSettings) error
+	// GetTeamCodeowners получает правила CODEOWNERS команды (пустая строка, если правила не загружены)
+	GetTeamCodeowners(ctx context.Context, teamName string) (string, error)
+	// SaveTeamCodeowners сохраняет правила CODEOWNERS команды
+	SaveTeamCodeowners(ctx context.Context, teamName, content string) error
+	// GetTeamHolidays возвращает праздники команды по возрастанию даты
+	GetTeamHolidays(ctx context.Context, teamName string) ([]entity2.TeamHoliday, error)
+	// SetTeamHolidays заменяет праздники команды
+	SetTeamHolidays(ctx context.Context, teamName string, holidays []entity2.TeamHoliday) error
 }
 
 // UserRepository интерфейс для работы с пользователями
@@ -24,32 +37,176 @@ type UserRepository interface {
 	CreateOrUpdateUser(ctx context.Context, user *entity2.User) error
 	// GetUser получает пользователя по ID
 	GetUser(ctx context.Context, userID string) (*entity2.User, error)
-	// GetActiveUsersByTeam получает активных пользователей команды (исключая указанного)
+	// GetActiveUsersByTeam получает активных пользователей команды (исключая указанного и находящихся в отсутствии)
 	GetActiveUsersByTeam(ctx context.Context, teamName string, excludeUserID string) ([]*entity2.User, error)
 	// UpdateUserIsActive обновляет флаг активности пользователя
 	UpdateUserIsActive(ctx context.Context, userID string, isActive bool) error
+	// UpdateUserMaxOpenReviews обновляет лимит открытых ревью пользователя (nil — значение команды)
+	UpdateUserMaxOpenReviews(ctx context.Context, userID string, maxOpenReviews *int) error
+	// UpdateUserReviewWeight обновляет вес пользователя при взвешенном выборе ревьюверов
+	UpdateUserReviewWeight(ctx context.Context, userID string, weight float64) error
+	// UpdateUserLevel обновляет уровень пользователя
+	UpdateUserLevel(ctx context.Context, userID string, level entity2.UserLevel) error
+	// UpdateUserSchedule обновляет рабочий график пользователя (nil — сбросить)
+	UpdateUserSchedule(ctx context.Context, userID string, schedule *entity2.WorkSchedule) error
 	// GetUsersByTeam получает всех пользователей команды (включая неактивных)
 	GetUsersByTeam(ctx context.Context, teamName string) ([]*entity2.User, error)
-	// GetAllActiveUsers возвращает всех активных пользователей с возможностью исключения
+	// GetAllActiveUsers возвращает всех активных пользователей (кроме находящихся в отсутствии) с возможностью исключения
 	GetAllActiveUsers(ctx context.Context, excludeIDs []string) ([]*entity2.User, error)
+	// GetAllUsers возвращает всех пользователей (включая неактивных)
+	GetAllUsers(ctx context.Context) ([]*entity2.User, error)
+	// SetUserSkills заменяет навыки пользователя
+	SetUserSkills(ctx context.Context, userID string, tags []string) error
+	// GetUserSkills возвращает навыки пользователей
+	GetUserSkills(ctx context.Context, userIDs []string) (map[string][]string, error)
+	// SaveReviewerExclusion создает или обновляет запрет взаимного ревью двух пользователей
+	SaveReviewerExclusion(ctx context.Context, exclusion *entity2.ReviewerExclusion) error
+	// DeleteReviewerExclusion удаляет запрет взаимного ревью и возвращает удаленную запись
+	DeleteReviewerExclusion(ctx context.Context, userID, excludedUserID string) (*entity2.ReviewerExclusion, error)
+	// GetReviewerExclusions возвращает запреты, в которых участвует пользователь, с его точки зрения
+	GetReviewerExclusions(ctx context.Context, userID string) ([]*entity2.ReviewerExclusion, error)
+	// CreateUserAvailability сохраняет окно недоступности пользователя
+	CreateUserAvailability(ctx context.Context, availability *entity2.UserAvailability) error
+	// UpsertUserAvailability создает или обновляет окно недоступности по внешнему идентификатору события
+	UpsertUserAvailability(ctx context.Context, availability *entity2.UserAvailability) error
+	// GetStartedAvailabilities возвращает начавшиеся окна недоступности, по которым еще не переназначены ревью
+	GetStartedAvailabilities(ctx context.Context, at time.Time) ([]*entity2.UserAvailability, error)
+	// MarkAvailabilityProcessed отмечает, что ревью пользователя на время окна переназначены
+	MarkAvailabilityProcessed(ctx context.Context, availabilityID int64, at time.Time) error
 }
 
 // PullRequestRepository интерфейс для работы с Pull Request'ами
 type PullRequestRepository interface {
-	// CreatePullRequest создает PR
-	CreatePullRequest(ctx context.Context, pr *entity2.PullRequest) error
+	// CreatePullRequest создает PR вместе с ревьюверами и требуемыми навыками; events сохраняются в outbox в той же транзакции
+	CreatePullRequest(ctx context.Context, pr *entity2.PullRequest, events ...*entity2.Event) error
 	// GetPullRequest получает PR по ID
 	GetPullRequest(ctx context.Context, prID string) (*entity2.PullRequest, error)
+	// GetPullRequestParticipants возвращает автора, команду и ревьюверов PR из списка одним запросом (ключ — ID PR);
+	// несуществующие PR пропускаются, остальные поля не заполняются
+	GetPullRequestParticipants(ctx context.Context, prIDs []string) (map[string]*entity2.PullRequest, error)
 	// PRExists проверяет существование PR
 	PRExists(ctx context.Context, prID string) (bool, error)
-	// UpdatePullRequestStatus обновляет статус PR
-	UpdatePullRequestStatus(ctx context.Context, prID string, status entity2.PullRequestStatus, mergedAt *time.Time) error
-	// UpdatePullRequestReviewers обновляет список ревьюверов PR
-	UpdatePullRequestReviewers(ctx context.Context, prID string, reviewers []string) error
+	// UpdatePullRequestStatus обновляет статус PR; events сохраняются в outbox в той же транзакции
+	UpdatePullRequestStatus(ctx context.Context, prID string, status entity2.PullRequestStatus, mergedAt *time.Time, events ...*entity2.Event) error
+	// UpdatePullRequestReviewers обновляет список ревьюверов PR; events сохраняются в outbox в той же транзакции
+	UpdatePullRequestReviewers(ctx context.Context, prID string, reviewers []string, events ...*entity2.Event) error
 	// GetPullRequestsByReviewer получает PR'ы, где пользователь назначен ревьювером
 	GetPullRequestsByReviewer(ctx context.Context, userID string) ([]*entity2.PullRequest, error)
+	// GetReviewAssignmentsByReviewer возвращает назначения пользователя ревьювером с моментом назначения
+	GetReviewAssignmentsByReviewer(ctx context.Context, userID string) ([]*entity2.ReviewAssignment, error)
+	// GetOpenReviewAssignments возвращает назначения ревьюверов во всех открытых PR
+	GetOpenReviewAssignments(ctx context.Context) ([]*entity2.ReviewAssignment, error)
 	// GetOpenPullRequestsByReviewers возвращает ID открытых PR, где задействованы ревьюверы из списка
 	GetOpenPullRequestsByReviewers(ctx context.Context, reviewerIDs []string) ([]string, error)
+	// GetOpenReviewCounts возвращает количество открытых PR на ревью у каждого из пользователей
+	GetOpenReviewCounts(ctx context.Context, userIDs []string) (map[string]int, error)
+	// GetRecentReviewerCounts возвращает, сколько раз каждый ревьювер назначался на последние lastPRs PR автора,
+	// включая ревьюверов, которых потом сняли или переназначили
+	GetRecentReviewerCounts(ctx context.Context, authorID string, lastPRs int) (map[string]int, error)
+	// SaveReviewEscalation записывает эскалацию PR (не более одной на PR) вместе с новым списком ревьюверов;
+	// events сохраняются в outbox в той же транзакции
+	SaveReviewEscalation(ctx context.Context, prID string, reviewers []string, escalation *entity2.ReviewEscalation, events ...*entity2.Event) error
+	// GetOpenPullRequestsWithoutEscalation возвращает открытые PR, по которым еще не было эскалации
+	GetOpenPullRequestsWithoutEscalation(ctx context.Context) ([]*entity2.PullRequest, error)
+	// SaveReviewDecline записывает отказ ревьювера от ревью PR вместе с новым списком ревьюверов;
+	// events сохраняются в outbox в той же транзакции
+	SaveReviewDecline(ctx context.Context, prID string, reviewers []string, decline *entity2.ReviewDecline, events ...*entity2.Event) error
 	// GetReviewerStats возвращает статистику по назначенным ревьюверам
 	GetReviewerStats(ctx context.Context) ([]entity2.ReviewerStat, int64, error)
 }
+
+// WebhookRepository интерфейс для работы с подписками на вебхуки и очередью доставки
+type WebhookRepository interface {
+	// CreateWebhookSubscription сохраняет подписку и заполняет ее ID и время создания
+	CreateWebhookSubscription(ctx context.Context, subscription *entity2.WebhookSubscription) error
+	// DeleteWebhookSubscription удаляет подписку вместе с ее доставками
+	DeleteWebhookSubscription(ctx context.Context, id int64) error
+	// GetWebhookSubscriptions возвращает все подписки
+	GetWebhookSubscriptions(ctx context.Context) ([]*entity2.WebhookSubscription, error)
+	// CreateWebhookDeliveries ставит доставки в очередь; повтор события для той же подписки игнорируется
+	CreateWebhookDeliveries(ctx context.Context, deliveries []*entity2.WebhookDelivery) error
+	// GetDueWebhookDeliveries возвращает до limit ожидающих доставок, время попытки которых наступило к моменту at
+	GetDueWebhookDeliveries(ctx context.Context, at time.Time, limit int) ([]*entity2.WebhookDelivery, error)
+	// GetWebhookDelivery возвращает доставку по ID
+	GetWebhookDelivery(ctx context.Context, id int64) (*entity2.WebhookDelivery, error)
+	// GetDeadWebhookDeliveries возвращает доставки, исчерпавшие попытки, от новых к старым
+	GetDeadWebhookDeliveries(ctx context.Context, limit int) ([]*entity2.WebhookDelivery, error)
+	// UpdateWebhookDelivery сохраняет состояние доставки после попытки
+	UpdateWebhookDelivery(ctx context.Context, delivery *entity2.WebhookDelivery) error
+}
+
+// OutboxRepository интерфейс для чтения outbox доменных событий
+type OutboxRepository interface {
+	// GetPendingOutboxEvents возвращает до limit неопубликованных и не исчерпавших попытки событий в порядке записи
+	GetPendingOutboxEvents(ctx context.Context, limit int) ([]*entity2.OutboxRecord, error)
+	// UpdateOutboxEvent сохраняет результат попытки публикации
+	UpdateOutboxEvent(ctx context.Context, record *entity2.OutboxRecord) error
+	// GetOutboxEventsAfter возвращает до limit событий с номером больше afterID в порядке записи, в том числе опубликованные
+	GetOutboxEventsAfter(ctx context.Context, afterID int64, limit int) ([]*entity2.OutboxRecord, error)
+	// GetLastOutboxEventID возвращает номер последнего записанного события (0 — событий нет)
+	GetLastOutboxEventID(ctx context.Context) (int64, error)
+}
+
+// CodeHostAccountRepository интерфейс для связей логинов хостингов кода с пользователями
+type CodeHostAccountRepository interface {
+	// SetCodeHostAccount сохраняет связь; связь того же логина заменяется
+	SetCodeHostAccount(ctx context.Context, account *entity2.CodeHostAccount) error
+	// DeleteCodeHostAccount удаляет связь логина
+	DeleteCodeHostAccount(ctx context.Context, provider entity2.CodeHostProvider, login string) error
+	// GetCodeHostAccounts возвращает связи логинов хостинга по возрастанию логина
+	GetCodeHostAccounts(ctx context.Context, provider entity2.CodeHostProvider) ([]*entity2.CodeHostAccount, error)
+	// GetUserIDByCodeHostLogin возвращает пользователя, связанного с логином
+	GetUserIDByCodeHostLogin(ctx context.Context, provider entity2.CodeHostProvider, login string) (string, error)
+	// GetCodeHostLogin возвращает логин пользователя на хостинге (наименьший, если связей несколько)
+	GetCodeHostLogin(ctx context.Context, provider entity2.CodeHostProvider, userID string) (string, error)
+}
+
+// ChatRepository интерфейс для каналов команд и очереди сообщений в чаты
+type ChatRepository interface {
+	// SetChatChannel сохраняет канал команды; прежний канал команды заменяется
+	SetChatChannel(ctx context.Context, channel *entity2.ChatChannel) error
+	// GetChatChannel возвращает канал команды
+	GetChatChannel(ctx context.Context, teamName string) (*entity2.ChatChannel, error)
+	// DeleteChatChannel удаляет канал команды
+	DeleteChatChannel(ctx context.Context, teamName string) error
+	// CreateChatMessages ставит сообщения в очередь; сообщения с уже известным ключом пропускаются
+	CreateChatMessages(ctx context.Context, messages []*entity2.ChatMessage) error
+	// GetDueChatMessages возвращает ожидающие сообщения, время попытки которых наступило к моменту at
+	GetDueChatMessages(ctx context.Context, at time.Time, limit int) ([]*entity2.ChatMessage, error)
+	// UpdateChatMessage сохраняет результат попытки отправки
+	UpdateChatMessage(ctx context.Context, message *entity2.ChatMessage) error
+}
+
+// CodeHostSyncRepository интерфейс для очереди изменений ревьюверов, передаваемых на хостинги кода
+type CodeHostSyncRepository interface {
+	// CreateCodeHostReviewerSyncs ставит изменения в очередь; повтор изменения того же события пропускается
+	CreateCodeHostReviewerSyncs(ctx context.Context, syncs []*entity2.CodeHostReviewerSync) error
+	// GetDueCodeHostReviewerSyncs возвращает ожидающие изменения, время попытки которых наступило к моменту at
+	GetDueCodeHostReviewerSyncs(ctx context.Context, at time.Time, limit int) ([]*entity2.CodeHostReviewerSync, error)
+	// UpdateCodeHostReviewerSync сохраняет результат попытки
+	UpdateCodeHostReviewerSync(ctx context.Context, sync *entity2.CodeHostReviewerSync) error
+}
+
+// CodeHostProjectRepository интерфейс для команд, назначенных проектам хостингов кода
+type CodeHostProjectRepository interface {
+	// SetCodeHostProject сохраняет команду проекта; команда того же проекта заменяется
+	SetCodeHostProject(ctx context.Context, project *entity2.CodeHostProject) error
+	// DeleteCodeHostProject удаляет команду проекта
+	DeleteCodeHostProject(ctx context.Context, provider entity2.CodeHostProvider, repository string) error
+	// GetCodeHostProjects возвращает проекты хостинга по возрастанию имени
+	GetCodeHostProjects(ctx context.Context, provider entity2.CodeHostProvider) ([]*entity2.CodeHostProject, error)
+	// GetCodeHostProjectTeam возвращает команду проекта
+	GetCodeHostProjectTeam(ctx context.Context, provider entity2.CodeHostProvider, repository string) (string, error)
+}
+
+// DigestRepository интерфейс для настроек ежедневных сводок ревью
+type DigestRepository interface {
+	// SetDigestSettings сохраняет адрес и согласие пользователя на сводку; дата последней сводки не меняется
+	SetDigestSettings(ctx context.Context, settings *entity2.DigestSettings) error
+	// GetDigestSettings возвращает настройки сводки пользователя
+	GetDigestSettings(ctx context.Context, userID string) (*entity2.DigestSettings, error)
+	// GetDigestRecipients возвращает настройки активных пользователей с адресом, не отказавшихся от сводки
+	GetDigestRecipients(ctx context.Context) ([]*entity2.DigestSettings, error)
+	// MarkDigestSent сохраняет местную дату отправленной сводки
+	MarkDigestSent(ctx context.Context, userID, date string) error
+}
@@ -2,7 +2,9 @@ package port
 
 import (
 	"context"
+	"io"
 	entity2 "test_task_avito/backend/internal/entity"
+	"time"
 )
 
 // TeamUseCase интерфейс для бизнес-логики команд
@@ -13,24 +15,167 @@ type TeamUseCase interface {
 	GetTeam(ctx context.Context, teamName string) (*entity2.Team, error)
 	// DeactivateTeam массово деактивирует пользователей команды с безопасным переназначением
 	DeactivateTeam(ctx context.Context, teamName string, strategy entity2.ReplacementStrategy) (*entity2.TeamDeactivateResult, error)
+	// GetTeamSettings получает настройки подбора ревьюверов команды
+	GetTeamSettings(ctx context.Context, teamName string) (*entity2.TeamSettings, error)
+	// UpdateTeamSettings частично обновляет настройки подбора ревьюверов команды
+	UpdateTeamSettings(ctx context.Context, teamName string, update entity2.TeamSettingsUpdate) (*entity2.TeamSettings, error)
+	// SetTeamCodeowners проверяет и сохраняет правила CODEOWNERS команды, возвращает количество правил
+	SetTeamCodeowners(ctx context.Context, teamName, content string) (int, error)
+	// GetTeamCodeowners получает правила CODEOWNERS команды
+	GetTeamCodeowners(ctx context.Context, teamName string) (string, error)
+	// SetTeamHolidays проверяет и заменяет праздники команды, возвращает сохраненный список по возрастанию даты
+	SetTeamHolidays(ctx context.Context, teamName string, holidays []entity2.TeamHoliday) ([]entity2.TeamHoliday, error)
+	// GetTeamHolidays получает праздники команды
+	GetTeamHolidays(ctx context.Context, teamName string) ([]entity2.TeamHoliday, error)
 }
 
 // UserUseCase интерфейс для бизнес-логики пользователей
 type UserUseCase interface {
 	// SetUserIsActive устанавливает флаг активности пользователя
 	SetUserIsActive(ctx context.Context, userID string, isActive bool) (*entity2.User, error)
-	// GetUserReviews получает PR'ы, где пользователь назначен ревьювером
-	GetUserReviews(ctx context.Context, userID string) ([]*entity2.PullRequest, error)
+	// SetUserMaxOpenReviews устанавливает лимит открытых ревью пользователя (nil — значение по умолчанию команды)
+	SetUserMaxOpenReviews(ctx context.Context, userID string, maxOpenReviews *int) (*entity2.User, error)
+	// SetUserReviewWeight устанавливает вес пользователя при взвешенном выборе ревьюверов
+	SetUserReviewWeight(ctx context.Context, userID string, weight float64) (*entity2.User, error)
+	// SetUserLevel устанавливает уровень пользователя
+	SetUserLevel(ctx context.Context, userID string, level entity2.UserLevel) (*entity2.User, error)
+	// SetUserSchedule устанавливает рабочий график пользователя; пустые timezone, workStart и workEnd сбрасывают график
+	SetUserSchedule(ctx context.Context, userID, timezone, workStart, workEnd string) (*entity2.User, error)
+	// SetUserSkills заменяет навыки пользователя, возвращает нормализованный список
+	SetUserSkills(ctx context.Context, userID string, tags []string) ([]string, error)
+	// AddReviewerExclusion запрещает двум пользователям быть ревьюверами PR друг друга
+	AddReviewerExclusion(ctx context.Context, userID, excludedUserID, reason string) (*entity2.ReviewerExclusion, error)
+	// RemoveReviewerExclusion снимает запрет взаимного ревью
+	RemoveReviewerExclusion(ctx context.Context, userID, excludedUserID string) (*entity2.ReviewerExclusion, error)
+	// GetReviewerExclusions возвращает запреты взаимного ревью пользователя
+	GetReviewerExclusions(ctx context.Context, userID string) ([]*entity2.ReviewerExclusion, error)
+	// GetUserReviews получает назначения пользователя ревьювером со сроками по SLA команды автора PR
+	GetUserReviews(ctx context.Context, userID string) ([]*entity2.ReviewAssignment, error)
+	// SetUserAvailability создает окно недоступности пользователя; если окно уже началось, открытые ревью сразу переназначаются
+	SetUserAvailability(ctx context.Context, userID string, startsAt, endsAt time.Time, reason string) (*entity2.UserAvailability, int64, error)
+	// ImportAvailabilityCalendar создает окна недоступности из событий отсутствия календаря iCalendar.
+	// Если calendar равен nil, читается календарь по пути из конфигурации сервера
+	ImportAvailabilityCalendar(ctx context.Context, calendar io.Reader) (*entity2.AvailabilityImportResult, error)
+	// ReassignStartedAbsences переназначает открытые ревью пользователей, у которых началось окно недоступности
+	ReassignStartedAbsences(ctx context.Context) (int64, error)
 }
 
 // PullRequestUseCase интерфейс для бизнес-логики Pull Request'ов
 type PullRequestUseCase interface {
-	// CreatePullRequest создает PR и автоматически назначает до 2 ревьюверов из команды автора
-	CreatePullRequest(ctx context.Context, prID, prName, authorID string) (*entity2.PullRequest, error)
+	// CreatePullRequest создает PR и автоматически назначает до 2 ревьюверов: сначала покрывающих требуемые навыки,
+	// затем владельцев измененных путей и участников команды автора. Возвращает навыки, оставшиеся без ревьювера
+	CreatePullRequest(ctx context.Context, input entity2.CreatePullRequestInput) (*entity2.PullRequest, []string, error)
 	// MergePullRequest помечает PR как MERGED (идемпотентная операция)
 	MergePullRequest(ctx context.Context, prID string) (*entity2.PullRequest, error)
+	// ClosePullRequest закрывает открытый PR без слияния (идемпотентная операция)
+	ClosePullRequest(ctx context.Context, prID string) (*entity2.PullRequest, error)
+	// ReopenPullRequest снова открывает закрытый PR (идемпотентная операция)
+	ReopenPullRequest(ctx context.Context, prID string) (*entity2.PullRequest, error)
 	// ReassignReviewer переназначает конкретного ревьювера на другого из его команды
-	ReassignReviewer(ctx context.Context, prID, oldUserID string) (*entity2.PullRequest, string, error)
+	// или, если задан newUserID, на указанного пользователя
+	ReassignReviewer(ctx context.Context, prID, oldUserID, newUserID string) (*entity2.PullRequest, string, error)
+	// AddReviewer вручную назначает пользователя ревьювером PR
+	AddReviewer(ctx context.Context, prID, userID string) (*entity2.PullRequest, error)
+	// RemoveReviewer снимает ревьювера с PR без замены
+	RemoveReviewer(ctx context.Context, prID, userID string) (*entity2.PullRequest, error)
+	// DeclineReview фиксирует отказ ревьювера от ревью и назначает ему замену
+	DeclineReview(ctx context.Context, prID, userID, reason string) (*entity2.PullRequest, string, error)
 	// GetReviewerStats возвращает статистику назначений ревьюверов
 	GetReviewerStats(ctx context.Context) ([]entity2.ReviewerStat, int64, error)
+	// GetOverdueReviews возвращает назначения в открытых PR, нарушившие SLA команды автора
+	GetOverdueReviews(ctx context.Context) ([]*entity2.ReviewAssignment, error)
+	// ProcessStaleReviews заменяет ревьюверов, простаивающих дольше порога команды автора,
+	// и добавляет тимлида в PR, открытые дольше порога эскалации
+	ProcessStaleReviews(ctx context.Context) (*entity2.StaleReviewResult, error)
+}
+
+// WebhookUseCase интерфейс для бизнес-логики вебхуков
+type WebhookUseCase interface {
+	// Subscribe создает подписку на события; если secret пуст, он генерируется и возвращается в подписке
+	Subscribe(ctx context.Context, url, secret string, eventTypes []entity2.EventType) (*entity2.WebhookSubscription, error)
+	// Unsubscribe удаляет подписку
+	Unsubscribe(ctx context.Context, id int64) error
+	// GetSubscriptions возвращает подписки без секретов
+	GetSubscriptions(ctx context.Context) ([]*entity2.WebhookSubscription, error)
+	// Enqueue ставит событие в очередь доставки подписчикам соответствующего типа
+	Enqueue(ctx context.Context, event *entity2.Event) error
+	// DeliverPending выполняет наступившие попытки доставки
+	DeliverPending(ctx context.Context) (*entity2.WebhookDeliveryResult, error)
+	// GetDeadLetters возвращает доставки, исчерпавшие попытки
+	GetDeadLetters(ctx context.Context) ([]*entity2.WebhookDelivery, error)
+	// Redeliver возвращает недоставленное событие в очередь с обнуленным счетчиком попыток
+	Redeliver(ctx context.Context, deliveryID int64) (*entity2.WebhookDelivery, error)
+}
+
+// EventRelayUseCase интерфейс для публикации событий из outbox
+type EventRelayUseCase interface {
+	// RelayPending публикует неопубликованные события во все приемники
+	RelayPending(ctx context.Context) (*entity2.OutboxRelayResult, error)
+}
+
+// CodeHostUseCase интерфейс для интеграции с хостингами кода
+type CodeHostUseCase interface {
+	// SetAccount связывает логин на хостинге с пользователем (повторная связь логина заменяет прежнюю)
+	SetAccount(ctx context.Context, provider entity2.CodeHostProvider, login, userID string) (*entity2.CodeHostAccount, error)
+	// RemoveAccount удаляет связь логина с пользователем
+	RemoveAccount(ctx context.Context, provider entity2.CodeHostProvider, login string) error
+	// GetAccounts возвращает связи логинов хостинга с пользователями
+	GetAccounts(ctx context.Context, provider entity2.CodeHostProvider) ([]*entity2.CodeHostAccount, error)
+	// SetProject назначает проекту хостинга команду, по правилам которой выбираются ревьюверы его PR
+	SetProject(ctx context.Context, provider entity2.CodeHostProvider, repository, teamName string) (*entity2.CodeHostProject, error)
+	// RemoveProject удаляет команду проекта; PR проекта снова назначаются по команде автора
+	RemoveProject(ctx context.Context, provider entity2.CodeHostProvider, repository string) error
+	// GetProjects возвращает проекты хостинга с назначенными командами
+	GetProjects(ctx context.Context, provider entity2.CodeHostProvider) ([]*entity2.CodeHostProject, error)
+	// HandlePullRequestEvent применяет событие PR хостинга к PR сервиса
+	HandlePullRequestEvent(ctx context.Context, event *entity2.CodeHostPullRequestEvent) (*entity2.CodeHostEventResult, error)
+}
+
+// NotificationUseCase интерфейс для уведомлений ревьюверов в чатах команд
+type NotificationUseCase interface {
+	// SetChatChannel проверяет и сохраняет канал команды и шаблоны сообщений
+	SetChatChannel(ctx context.Context, teamName, webhookURL string, templates map[entity2.NotificationKind]string) (*entity2.ChatChannel, error)
+	// GetChatChannel возвращает канал команды с действующими шаблонами (заданными или по умолчанию)
+	GetChatChannel(ctx context.Context, teamName string) (*entity2.ChatChannel, error)
+	// RemoveChatChannel удаляет канал команды
+	RemoveChatChannel(ctx context.Context, teamName string) error
+	// Notify ставит в очередь уведомления о назначении или замене ревьювера из доменного события
+	Notify(ctx context.Context, event *entity2.Event) error
+	// NotifyOverdue ставит в очередь уведомления о просроченных ревью; о каждом назначении уведомляет один раз
+	NotifyOverdue(ctx context.Context) error
+	// DeliverPending выполняет наступившие попытки отправки сообщений
+	DeliverPending(ctx context.Context) (*entity2.ChatDeliveryResult, error)
+}
+
+// EventStreamUseCase интерфейс для чтения журнала доменных событий подписчиками потока
+type EventStreamUseCase interface {
+	// OpenStream проверяет фильтр и возвращает позицию начала потока: после события lastEventID
+	// или, если он пуст, после последнего записанного события
+	OpenStream(ctx context.Context, filter entity2.EventFilter, lastEventID string) (int64, error)
+	// ReadEvents возвращает подходящие под фильтр события после позиции afterID и позицию для следующего чтения
+	ReadEvents(ctx context.Context, filter entity2.EventFilter, afterID int64, limit int) ([]*entity2.OutboxRecord, int64, error)
+}
+
+// CodeHostSyncUseCase интерфейс для передачи назначенных ревьюверов на хостинги кода
+type CodeHostSyncUseCase interface {
+	// Enqueue ставит в очередь изменения ревьюверов из события назначения или замены ревьювера
+	Enqueue(ctx context.Context, event *entity2.Event) error
+	// SyncPending выполняет наступившие попытки передачи изменений
+	SyncPending(ctx context.Context) (*entity2.CodeHostSyncResult, error)
+}
+
+// DigestUseCase интерфейс для ежедневных сводок открытых ревью на email
+type DigestUseCase interface {
+	// SetDigestSettings обновляет адрес и согласие пользователя на сводку
+	SetDigestSettings(ctx context.Context, userID string, update entity2.DigestSettingsUpdate) (*entity2.DigestSettings, error)
+	// PreviewDigest формирует сводку пользователя на текущий момент без отправки
+	PreviewDigest(ctx context.Context, userID string) (*entity2.Digest, error)
+	// SendDue отправляет сводки пользователям, у которых наступило утро рабочего дня и сводка за день еще не отправлена
+	SendDue(ctx context.Context) (*entity2.DigestResult, error)
+}
+
+// BrokerUseCase интерфейс для публикации доменных событий в брокер сообщений
+type BrokerUseCase interface {
+	// Publish публикует событие в тему команды и типа события
+	Publish(ctx context.Context, event *entity2.Event) error
 }
@@ -1,6 +1,7 @@
 package integration_test
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"database/sql"
@@ -9,19 +10,31 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os/exec"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/go-chi/chi/v5"
 	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/nats-io/nats-server/v2/server"
+	natsserver "github.com/nats-io/nats-server/v2/test"
+	"github.com/nats-io/nats.go"
 	"github.com/stretchr/testify/require"
 	tcpostgres "github.com/testcontainers/testcontainers-go/modules/postgres"
+	"go.uber.org/zap"
 
+	"test_task_avito/backend/internal/adapter/broker"
+	"test_task_avito/backend/internal/adapter/email"
+	"test_task_avito/backend/internal/adapter/eventsink"
 	"test_task_avito/backend/internal/adapter/repository/postgres"
+	"test_task_avito/backend/internal/adapter/webhook"
 	"test_task_avito/backend/internal/input/http/gen"
 	handlerpkg "test_task_avito/backend/internal/input/http/handler"
+	"test_task_avito/backend/internal/input/http/stream"
 	"test_task_avito/backend/internal/usecase"
 	migrations "test_task_avito/backend/pkg/migration"
+	"test_task_avito/backend/pkg/random"
+	"test_task_avito/backend/pkg/slack/slacktest"
 )
 
 type errorResponse struct {
@@ -81,15 +94,22 @@ func TestEndToEndFlow(t *testing.T) {
 	require.NoError(t, migrations.Migrate(db))
 
 	repo := postgres.NewPostgresRepository(db)
-	teamUC := usecase.NewTeamUseCase(repo, repo, repo)
-	userUC := usecase.NewUserUseCase(repo, repo)
-	prUC := usecase.NewPullRequestUseCase(repo, repo, repo)
-
-	h := handlerpkg.NewHandler(teamUC, userUC, prUC)
+	rnd := random.New(1)
+	teamUC := usecase.NewTeamUseCase(repo, repo, repo, rnd)
+	prUC := usecase.NewPullRequestUseCase(repo, repo, repo, rnd)
+	userUC := usecase.NewUserUseCase(repo, repo, repo, prUC, "")
+	// Тестовые получатели слушают loopback, поэтому внутренние адреса разрешены
+	webhookUC := usecase.NewWebhookUseCase(repo, webhook.NewHTTPSender(true), true)
+	codeHostUC := usecase.NewCodeHostUseCase(repo, repo, repo, repo, prUC)
+	notificationUC := usecase.NewNotificationUseCase(repo, repo, repo, repo, prUC, webhook.NewHTTPSender(true), true)
+	digestUC := usecase.NewDigestUseCase(repo, repo, repo, repo, email.NewSMTPSender("localhost", 25, "", "", "reviews@example.com"), 9)
+	h := handlerpkg.NewHandler(teamUC, userUC, prUC, webhookUC, codeHostUC, notificationUC, digestUC)
 	strictHandler := gen.NewStrictHandler(h, nil)
 
 	r := chi.NewRouter()
 	gen.HandlerFromMux(strictHandler, r)
+	eventsHandler := stream.NewEventsHandler(usecase.NewEventStreamUseCase(repo, repo, repo, repo), 100*time.Millisecond, zap.NewNop())
+	r.Get("/events/stream", eventsHandler.ServeHTTP)
 
 	srv := httptest.NewServer(r)
 	t.Cleanup(srv.Close)
@@ -113,12 +133,109 @@ func TestEndToEndFlow(t *testing.T) {
 		},
 	}, http.StatusCreated)
 
+	chat := slacktest.NewServer()
+	t.Cleanup(chat.Close)
+	mustDo(t, client, srv, http.MethodPost, "/team/setChatChannel", map[string]any{
+		"team_name":   "backend",
+		"webhook_url": chat.URL,
+		"templates":   map[string]any{"assigned": "{{.Reviewer}} reviews {{.PullRequestName}} by {{.Author}}"},
+	}, http.StatusOK)
+
 	mustDo(t, client, srv, http.MethodPost, "/pullRequest/create", map[string]any{
 		"pull_request_id":   "pr-1",
 		"pull_request_name": "Feature",
 		"author_id":         "u1",
 	}, http.StatusCreated)
 
+	// События доходят до брокера сообщений в темы команды автора
+	natsOpts := natsserver.DefaultTestOptions
+	natsOpts.Port = server.RANDOM_PORT
+	natsServer := natsserver.RunServer(&natsOpts)
+	t.Cleanup(natsServer.Shutdown)
+	subscriber, err := nats.Connect(natsServer.ClientURL())
+	require.NoError(t, err)
+	t.Cleanup(subscriber.Close)
+	subscription, err := subscriber.SubscribeSync("reviews.>")
+	require.NoError(t, err)
+	require.NoError(t, subscriber.Flush())
+	natsBroker, err := broker.NewNATSBroker(natsServer.ClientURL(), "integration-test")
+	require.NoError(t, err)
+	t.Cleanup(natsBroker.Close)
+	brokerUC := usecase.NewBrokerUseCase(repo, repo, natsBroker, "reviews")
+
+	// Назначения ревьюверов доходят до чата команды через outbox и очередь сообщений
+	_, err = usecase.NewEventRelayUseCase(repo, eventsink.NewChatSink(notificationUC), eventsink.NewBrokerSink(brokerUC)).RelayPending(ctx)
+	require.NoError(t, err)
+	var published []*nats.Msg
+	for range 3 {
+		message, err := subscription.NextMsg(5 * time.Second)
+		require.NoError(t, err)
+		published = append(published, message)
+	}
+	require.Equal(t, "reviews.backend.pr.created", published[0].Subject)
+	for _, message := range published[1:] {
+		require.Equal(t, "reviews.backend.reviewer.assigned", message.Subject)
+		require.NotEmpty(t, message.Header.Get(nats.MsgIdHdr))
+	}
+	_, err = notificationUC.DeliverPending(ctx)
+	require.NoError(t, err)
+	messages := chat.Messages()
+	require.Len(t, messages, 2)
+	for _, message := range messages {
+		require.Contains(t, message.Text, "reviews Feature by User1")
+	}
+
+	// Поток событий с начала журнала: создание PR и назначения ревьюверов, затрагивающие u2
+	streamCtx, cancelStream := context.WithTimeout(ctx, 10*time.Second)
+	defer cancelStream()
+	streamReq, err := http.NewRequestWithContext(streamCtx, http.MethodGet, srv.URL+"/events/stream?user_id=u2", nil)
+	require.NoError(t, err)
+	streamReq.Header.Set("Last-Event-ID", "0")
+	streamResp, err := http.DefaultClient.Do(streamReq)
+	require.NoError(t, err)
+	defer streamResp.Body.Close()
+	require.Equal(t, http.StatusOK, streamResp.StatusCode)
+	require.Equal(t, "text/event-stream", streamResp.Header.Get("Content-Type"))
+
+	var streamed []string
+	scanner := bufio.NewScanner(streamResp.Body)
+	for len(streamed) < 3 && scanner.Scan() {
+		if name, ok := strings.CutPrefix(scanner.Text(), "event: "); ok {
+			streamed = append(streamed, name)
+		}
+	}
+	require.Equal(t, []string{"pr.created", "reviewer.assigned", "reviewer.assigned"}, streamed)
+	cancelStream()
+
+	mustDo(t, client, srv, http.MethodPost, "/users/setDigest", map[string]any{
+		"user_id": "u2",
+		"email":   "not an address",
+	}, http.StatusBadRequest)
+	mustDo(t, client, srv, http.MethodPost, "/users/setDigest", map[string]any{
+		"user_id": "u2",
+		"email":   "user2@example.com",
+	}, http.StatusOK)
+
+	previewResp := mustDo(t, client, srv, http.MethodGet, "/digest/preview?user_id=u2", nil, http.StatusOK)
+	var preview struct {
+		Digest struct {
+			Email        string `json:"email"`
+			Subject      string `json:"subject"`
+			Text         string `json:"text"`
+			HTML         string `json:"html"`
+			PullRequests []struct {
+				PullRequestID string `json:"pull_request_id"`
+			} `json:"pull_requests"`
+		} `json:"digest"`
+	}
+	decodeJSON(t, previewResp.Body, &preview)
+	require.Equal(t, "user2@example.com", preview.Digest.Email)
+	require.Len(t, preview.Digest.PullRequests, 1)
+	require.Equal(t, "pr-1", preview.Digest.PullRequests[0].PullRequestID)
+	require.Contains(t, preview.Digest.Subject, "1 open pull request(s)")
+	require.Contains(t, preview.Digest.Text, "Feature (pr-1) by User1")
+	require.Contains(t, preview.Digest.HTML, "<b>Feature</b> (pr-1)")
+
 	resp := mustDo(t, client, srv, http.MethodGet, "/stats/reviewers", nil, http.StatusOK)
 	var stats reviewerStats
 	decodeJSON(t, resp.Body, &stats)
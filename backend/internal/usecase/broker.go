@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	entity2 "test_task_avito/backend/internal/entity"
+	port2 "test_task_avito/backend/internal/port"
+)
+
+// brokerNoTeam токен темы для событий, команду которых определить не удалось (например, автор удален)
+const brokerNoTeam = "_"
+
+// brokerSubjectReplacer заменяет символы, недопустимые в токене темы NATS: разделитель, шаблоны и пробелы
+var brokerSubjectReplacer = strings.NewReplacer(".", "_", "*", "_", ">", "_", " ", "_", "\t", "_", "\r", "_", "\n", "_")
+
+type brokerUseCase struct {
+	userRepo      port2.UserRepository
+	prRepo        port2.PullRequestRepository
+	broker        port2.MessageBroker
+	subjectPrefix string
+}
+
+// NewBrokerUseCase создает новый экземпляр BrokerUseCase; события публикуются в темы subjectPrefix.<команда>.<тип события>
+func NewBrokerUseCase(
+	userRepo port2.UserRepository,
+	prRepo port2.PullRequestRepository,
+	broker port2.MessageBroker,
+	subjectPrefix string,
+) port2.BrokerUseCase {
+	return &brokerUseCase{
+		userRepo:      userRepo,
+		prRepo:        prRepo,
+		broker:        broker,
+		subjectPrefix: subjectPrefix,
+	}
+}
+
+func (uc *brokerUseCase) Publish(ctx context.Context, event *entity2.Event) error {
+	team, err := uc.eventTeam(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(webhookEnvelope{
+		ID:         event.ID,
+		Type:       event.Type,
+		OccurredAt: event.OccurredAt.UTC(),
+		Data:       event.Data,
+	})
+	if err != nil {
+		return err
+	}
+	return uc.broker.Publish(ctx, brokerSubject(uc.subjectPrefix, team, event.Type), event.ID, payload)
+}
+
+// eventTeam возвращает команду события: команду из данных события, иначе команду PR, по правилам которой
+// выбирались ревьюверы, иначе команду автора
+func (uc *brokerUseCase) eventTeam(ctx context.Context, event *entity2.Event) (string, error) {
+	if team := eventString(event, "team_name"); team != "" {
+		return team, nil
+	}
+
+	authorID := eventString(event, "author_id")
+	if prID := eventString(event, "pull_request_id"); prID != "" {
+		pr, err := uc.prRepo.GetPullRequest(ctx, prID)
+		if err != nil && !isDomainError(err, entity2.ErrorCodeNotFound) {
+			return "", err
+		}
+		if pr != nil {
+			if pr.TeamName != "" {
+				return pr.TeamName, nil
+			}
+			authorID = pr.AuthorID
+		}
+	}
+	if authorID == "" {
+		return "", nil
+	}
+
+	author, err := uc.userRepo.GetUser(ctx, authorID)
+	if err != nil {
+		if isDomainError(err, entity2.ErrorCodeNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	return author.TeamName, nil
+}
+
+// brokerSubject формирует тему события; недопустимые символы названия команды заменяются на «_»
+func brokerSubject(prefix, team string, eventType entity2.EventType) string {
+	token := brokerSubjectReplacer.Replace(team)
+	if token == "" {
+		token = brokerNoTeam
+	}
+	return prefix + "." + token + "." + string(eventType)
+}
@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	entity2 "test_task_avito/backend/internal/entity"
+	port2 "test_task_avito/backend/internal/port"
+	"test_task_avito/backend/pkg/worktime"
+	"time"
+)
+
+// workCalendar строит рабочие графики пользователей с учетом праздников их команд.
+// Пользователи и праздники кешируются на время жизни календаря, поэтому он создается на одну операцию.
+type workCalendar struct {
+	userRepo port2.UserRepository
+	teamRepo port2.TeamRepository
+	users    map[string]*entity2.User
+	holidays map[string]map[string]bool
+}
+
+func newWorkCalendar(userRepo port2.UserRepository, teamRepo port2.TeamRepository) *workCalendar {
+	return &workCalendar{
+		userRepo: userRepo,
+		teamRepo: teamRepo,
+		users:    make(map[string]*entity2.User),
+		holidays: make(map[string]map[string]bool),
+	}
+}
+
+// scheduleOf возвращает рабочий график пользователя по ID; для удаленного пользователя — worktime.FullDay
+func (c *workCalendar) scheduleOf(ctx context.Context, userID string) (worktime.Schedule, error) {
+	user, ok := c.users[userID]
+	if !ok {
+		var err error
+		user, err = c.userRepo.GetUser(ctx, userID)
+		if err != nil {
+			if domainErr, ok := err.(*entity2.DomainError); !ok || domainErr.Code != entity2.ErrorCodeNotFound {
+				return worktime.Schedule{}, err
+			}
+			user = nil
+		}
+		c.users[userID] = user
+	}
+	return c.schedule(ctx, user)
+}
+
+// schedule возвращает рабочий график пользователя с праздниками его команды.
+// Без собственного графика рабочими считаются все часы будних дней по UTC.
+func (c *workCalendar) schedule(ctx context.Context, user *entity2.User) (worktime.Schedule, error) {
+	schedule := worktime.FullDay
+	if user == nil {
+		return schedule, nil
+	}
+
+	holidays, err := c.teamHolidays(ctx, user.TeamName)
+	if err != nil {
+		return worktime.Schedule{}, err
+	}
+	schedule.Holidays = holidays
+
+	if user.Schedule != nil {
+		// Часовой пояс проверяется при сохранении; если он пропал из базы tzdata, график считается по UTC
+		if location, err := user.Schedule.Location(); err == nil {
+			schedule.Location = location
+		}
+		schedule.StartMinute = user.Schedule.WorkStart
+		schedule.EndMinute = user.Schedule.WorkEnd
+	}
+	return schedule, nil
+}
+
+// isWorking проверяет, что у пользователя сейчас рабочее время
+func (c *workCalendar) isWorking(ctx context.Context, user *entity2.User, now time.Time) (bool, error) {
+	schedule, err := c.schedule(ctx, user)
+	if err != nil {
+		return false, err
+	}
+	return schedule.IsWorkingTime(now), nil
+}
+
+func (c *workCalendar) teamHolidays(ctx context.Context, teamName string) (map[string]bool, error) {
+	if holidays, ok := c.holidays[teamName]; ok {
+		return holidays, nil
+	}
+
+	list, err := c.teamRepo.GetTeamHolidays(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	holidays := make(map[string]bool, len(list))
+	for _, holiday := range list {
+		holidays[holiday.Date] = true
+	}
+	c.holidays[teamName] = holidays
+	return holidays, nil
+}
@@ -0,0 +1,222 @@
+package usecase
+
+import (
+	"context"
+	entity2 "test_task_avito/backend/internal/entity"
+	port2 "test_task_avito/backend/internal/port"
+	"unicode/utf8"
+)
+
+type codeHostUseCase struct {
+	accountRepo port2.CodeHostAccountRepository
+	projectRepo port2.CodeHostProjectRepository
+	userRepo    port2.UserRepository
+	teamRepo    port2.TeamRepository
+	prUseCase   port2.PullRequestUseCase
+}
+
+// NewCodeHostUseCase создает новый экземпляр CodeHostUseCase
+func NewCodeHostUseCase(
+	accountRepo port2.CodeHostAccountRepository,
+	projectRepo port2.CodeHostProjectRepository,
+	userRepo port2.UserRepository,
+	teamRepo port2.TeamRepository,
+	prUseCase port2.PullRequestUseCase,
+) port2.CodeHostUseCase {
+	return &codeHostUseCase{
+		accountRepo: accountRepo,
+		projectRepo: projectRepo,
+		userRepo:    userRepo,
+		teamRepo:    teamRepo,
+		prUseCase:   prUseCase,
+	}
+}
+
+func (uc *codeHostUseCase) SetAccount(ctx context.Context, provider entity2.CodeHostProvider, login, userID string) (*entity2.CodeHostAccount, error) {
+	if !provider.Valid() {
+		return nil, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "unknown code host provider")
+	}
+	login = entity2.NormalizeCodeHostLogin(login)
+	if login == "" {
+		return nil, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "login is required")
+	}
+	if utf8.RuneCountInString(login) > maxVarcharLength {
+		return nil, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "login is too long")
+	}
+
+	if _, err := uc.userRepo.GetUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	account := &entity2.CodeHostAccount{
+		Provider: provider,
+		Login:    login,
+		UserID:   userID,
+	}
+	if err := uc.accountRepo.SetCodeHostAccount(ctx, account); err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+func (uc *codeHostUseCase) RemoveAccount(ctx context.Context, provider entity2.CodeHostProvider, login string) error {
+	if !provider.Valid() {
+		return entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "unknown code host provider")
+	}
+	return uc.accountRepo.DeleteCodeHostAccount(ctx, provider, entity2.NormalizeCodeHostLogin(login))
+}
+
+func (uc *codeHostUseCase) GetAccounts(ctx context.Context, provider entity2.CodeHostProvider) ([]*entity2.CodeHostAccount, error) {
+	if !provider.Valid() {
+		return nil, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "unknown code host provider")
+	}
+	return uc.accountRepo.GetCodeHostAccounts(ctx, provider)
+}
+
+func (uc *codeHostUseCase) SetProject(ctx context.Context, provider entity2.CodeHostProvider, repository, teamName string) (*entity2.CodeHostProject, error) {
+	if !provider.Valid() {
+		return nil, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "unknown code host provider")
+	}
+	repository = entity2.NormalizeCodeHostRepository(repository)
+	if repository == "" {
+		return nil, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "repository is required")
+	}
+	if utf8.RuneCountInString(repository) > maxVarcharLength {
+		return nil, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "repository is too long")
+	}
+
+	exists, err := uc.teamRepo.TeamExists(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, entity2.NewDomainError(entity2.ErrorCodeNotFound, "team not found")
+	}
+
+	project := &entity2.CodeHostProject{
+		Provider:   provider,
+		Repository: repository,
+		TeamName:   teamName,
+	}
+	if err := uc.projectRepo.SetCodeHostProject(ctx, project); err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
+
+func (uc *codeHostUseCase) RemoveProject(ctx context.Context, provider entity2.CodeHostProvider, repository string) error {
+	if !provider.Valid() {
+		return entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "unknown code host provider")
+	}
+	return uc.projectRepo.DeleteCodeHostProject(ctx, provider, entity2.NormalizeCodeHostRepository(repository))
+}
+
+func (uc *codeHostUseCase) GetProjects(ctx context.Context, provider entity2.CodeHostProvider) ([]*entity2.CodeHostProject, error) {
+	if !provider.Valid() {
+		return nil, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "unknown code host provider")
+	}
+	return uc.projectRepo.GetCodeHostProjects(ctx, provider)
+}
+
+// HandlePullRequestEvent применяет событие PR хостинга. Хостинги повторяют доставку событий, поэтому
+// повторы и события о PR, которых сервис не знает, пропускаются без ошибки.
+func (uc *codeHostUseCase) HandlePullRequestEvent(ctx context.Context, event *entity2.CodeHostPullRequestEvent) (*entity2.CodeHostEventResult, error) {
+	prID := event.PullRequestID()
+	if event.Repository == "" || event.Number <= 0 || utf8.RuneCountInString(prID) > maxVarcharLength {
+		return nil, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "invalid pull request reference")
+	}
+	result := &entity2.CodeHostEventResult{PullRequestID: prID}
+
+	switch event.Action {
+	case entity2.CodeHostActionOpened, entity2.CodeHostActionReadyForReview:
+		if event.Draft {
+			return ignored(result, "pull request is a draft"), nil
+		}
+		return uc.create(ctx, event, result)
+
+	case entity2.CodeHostActionMerged:
+		if _, err := uc.prUseCase.MergePullRequest(ctx, prID); err != nil {
+			if isDomainError(err, entity2.ErrorCodeNotFound) {
+				return ignored(result, "unknown pull request"), nil
+			}
+			return nil, err
+		}
+		result.Outcome = entity2.CodeHostEventMerged
+		return result, nil
+
+	case entity2.CodeHostActionClosed:
+		if _, err := uc.prUseCase.ClosePullRequest(ctx, prID); err != nil {
+			if isDomainError(err, entity2.ErrorCodeNotFound) {
+				return ignored(result, "unknown pull request"), nil
+			}
+			return nil, err
+		}
+		result.Outcome = entity2.CodeHostEventClosed
+		return result, nil
+
+	case entity2.CodeHostActionReopened:
+		if _, err := uc.prUseCase.ReopenPullRequest(ctx, prID); err != nil {
+			if !isDomainError(err, entity2.ErrorCodeNotFound) {
+				return nil, err
+			}
+			// PR, открытый до подключения интеграции, создается при переоткрытии
+			if event.Draft {
+				return ignored(result, "pull request is a draft"), nil
+			}
+			return uc.create(ctx, event, result)
+		}
+		result.Outcome = entity2.CodeHostEventReopened
+		return result, nil
+	}
+
+	return ignored(result, "unsupported action "+string(event.Action)), nil
+}
+
+// create создает PR от имени автора, связанного с логином на хостинге, по правилам команды проекта
+// (команды автора, если проекту команда не назначена)
+func (uc *codeHostUseCase) create(ctx context.Context, event *entity2.CodeHostPullRequestEvent, result *entity2.CodeHostEventResult) (*entity2.CodeHostEventResult, error) {
+	if event.AuthorLogin == "" {
+		return ignored(result, "author login is unknown"), nil
+	}
+	authorID, err := uc.accountRepo.GetUserIDByCodeHostLogin(ctx, event.Provider, entity2.NormalizeCodeHostLogin(event.AuthorLogin))
+	if err != nil {
+		if isDomainError(err, entity2.ErrorCodeNotFound) {
+			return ignored(result, "unknown author "+event.AuthorLogin), nil
+		}
+		return nil, err
+	}
+
+	teamName, err := uc.projectRepo.GetCodeHostProjectTeam(ctx, event.Provider, entity2.NormalizeCodeHostRepository(event.Repository))
+	if err != nil && !isDomainError(err, entity2.ErrorCodeNotFound) {
+		return nil, err
+	}
+
+	_, _, err = uc.prUseCase.CreatePullRequest(ctx, entity2.CreatePullRequestInput{
+		PullRequestID:   result.PullRequestID,
+		PullRequestName: truncate(event.Title, maxVarcharLength),
+		AuthorID:        authorID,
+		TeamName:        teamName,
+	})
+	if err != nil {
+		if isDomainError(err, entity2.ErrorCodePRExists) {
+			return ignored(result, "pull request already exists"), nil
+		}
+		return nil, err
+	}
+
+	result.Outcome = entity2.CodeHostEventCreated
+	return result, nil
+}
+
+func ignored(result *entity2.CodeHostEventResult, reason string) *entity2.CodeHostEventResult {
+	result.Outcome = entity2.CodeHostEventIgnored
+	result.Reason = reason
+	return result
+}
+
+func isDomainError(err error, code entity2.ErrorCode) bool {
+	domainErr, ok := err.(*entity2.DomainError)
+	return ok && domainErr.Code == code
+}
@@ -0,0 +1,201 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	entity2 "test_task_avito/backend/internal/entity"
+	port2 "test_task_avito/backend/internal/port"
+	"time"
+)
+
+const (
+	// codeHostSyncMaxAttempts количество попыток, после которого изменение ревьюверов больше не передается
+	codeHostSyncMaxAttempts = 8
+	// codeHostSyncBatchSize количество изменений, обрабатываемых за один проход
+	codeHostSyncBatchSize = 100
+	// codeHostSyncMaxErrorLength ограничение длины сохраняемой ошибки хостинга
+	codeHostSyncMaxErrorLength = 1000
+)
+
+type codeHostSyncUseCase struct {
+	syncRepo    port2.CodeHostSyncRepository
+	accountRepo port2.CodeHostAccountRepository
+	prRepo      port2.PullRequestRepository
+	clients     map[entity2.CodeHostProvider]port2.CodeHostClient
+}
+
+// NewCodeHostSyncUseCase создает новый экземпляр CodeHostSyncUseCase; clients — клиенты API хостингов,
+// на которые передаются ревьюверы (PR хостингов без клиента пропускаются)
+func NewCodeHostSyncUseCase(
+	syncRepo port2.CodeHostSyncRepository,
+	accountRepo port2.CodeHostAccountRepository,
+	prRepo port2.PullRequestRepository,
+	clients map[entity2.CodeHostProvider]port2.CodeHostClient,
+) port2.CodeHostSyncUseCase {
+	return &codeHostSyncUseCase{
+		syncRepo:    syncRepo,
+		accountRepo: accountRepo,
+		prRepo:      prRepo,
+		clients:     clients,
+	}
+}
+
+// reviewerOperation изменение запроса ревью для одного пользователя
+type reviewerOperation struct {
+	operation entity2.CodeHostReviewOperation
+	userID    string
+}
+
+// Enqueue ставит в очередь запрос ревью у назначенного ревьювера и снятие запроса с замененного или снятого.
+// Пользователи без логина на хостинге пропускаются.
+func (uc *codeHostSyncUseCase) Enqueue(ctx context.Context, event *entity2.Event) error {
+	var operations []reviewerOperation
+	switch event.Type {
+	case entity2.EventReviewerAssigned:
+		operations = []reviewerOperation{
+			{entity2.CodeHostReviewRequest, eventString(event, "user_id")},
+		}
+	case entity2.EventReviewerRemoved:
+		operations = []reviewerOperation{
+			{entity2.CodeHostReviewRemove, eventString(event, "user_id")},
+		}
+	case entity2.EventReviewerReassigned:
+		operations = []reviewerOperation{
+			{entity2.CodeHostReviewRemove, eventString(event, "old_user_id")},
+			{entity2.CodeHostReviewRequest, eventString(event, "new_user_id")},
+		}
+	default:
+		return nil
+	}
+
+	prID := eventString(event, "pull_request_id")
+	provider, repository, number, ok := entity2.ParseCodeHostPullRequestID(prID)
+	if !ok || uc.clients[provider] == nil {
+		return nil
+	}
+
+	syncs := make([]*entity2.CodeHostReviewerSync, 0, len(operations))
+	for _, op := range operations {
+		if op.userID == "" {
+			continue
+		}
+		login, err := uc.accountRepo.GetCodeHostLogin(ctx, provider, op.userID)
+		if err != nil {
+			if isDomainError(err, entity2.ErrorCodeNotFound) {
+				continue
+			}
+			return err
+		}
+		syncs = append(syncs, &entity2.CodeHostReviewerSync{
+			EventID:       event.ID,
+			PullRequestID: prID,
+			Provider:      provider,
+			Repository:    repository,
+			Number:        number,
+			Operation:     op.operation,
+			UserID:        op.userID,
+			Login:         login,
+			Status:        entity2.CodeHostSyncPending,
+			NextAttemptAt: event.OccurredAt,
+		})
+	}
+
+	if len(syncs) == 0 {
+		return nil
+	}
+	return uc.syncRepo.CreateCodeHostReviewerSyncs(ctx, syncs)
+}
+
+func (uc *codeHostSyncUseCase) SyncPending(ctx context.Context) (*entity2.CodeHostSyncResult, error) {
+	syncs, err := uc.syncRepo.GetDueCodeHostReviewerSyncs(ctx, time.Now(), codeHostSyncBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &entity2.CodeHostSyncResult{}
+	for _, sync := range syncs {
+		if ctx.Err() != nil {
+			return result, ctx.Err()
+		}
+
+		current, err := uc.current(ctx, sync)
+		if err != nil {
+			return result, err
+		}
+
+		sync.Attempts++
+		if !current {
+			sync.Status = entity2.CodeHostSyncDone
+			result.Skipped++
+		} else {
+			syncErr := uc.send(ctx, sync)
+			switch {
+			case syncErr == nil:
+				sync.Status = entity2.CodeHostSyncDone
+				sync.LastError = ""
+				result.Done++
+			case sync.Attempts >= codeHostSyncMaxAttempts:
+				sync.Status = entity2.CodeHostSyncDead
+				sync.LastError = truncate(syncErr.Error(), codeHostSyncMaxErrorLength)
+				result.Dead++
+			default:
+				// Повторы идут по тому же расписанию, что и доставки вебхуков
+				sync.NextAttemptAt = time.Now().Add(webhookBackoff(sync.Attempts))
+				sync.LastError = truncate(syncErr.Error(), codeHostSyncMaxErrorLength)
+				result.Retried++
+			}
+		}
+
+		if err := uc.syncRepo.UpdateCodeHostReviewerSync(ctx, sync); err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+// send передает изменение клиенту хостинга
+func (uc *codeHostSyncUseCase) send(ctx context.Context, sync *entity2.CodeHostReviewerSync) error {
+	client := uc.clients[sync.Provider]
+	if client == nil {
+		return fmt.Errorf("no client for code host %s", sync.Provider)
+	}
+	if sync.Operation == entity2.CodeHostReviewRemove {
+		return client.RemoveRequestedReviewers(ctx, sync.Repository, sync.Number, []string{sync.Login})
+	}
+	return client.RequestReviewers(ctx, sync.Repository, sync.Number, []string{sync.Login})
+}
+
+// current проверяет, что изменение все еще отражает состояние PR: запрос ревью передается, только пока
+// пользователь назначен ревьювером открытого PR, снятие запроса — только пока он не назначен снова.
+// Так отложенный повтор не отменяет более позднее изменение.
+func (uc *codeHostSyncUseCase) current(ctx context.Context, sync *entity2.CodeHostReviewerSync) (bool, error) {
+	pr, err := uc.prRepo.GetPullRequest(ctx, sync.PullRequestID)
+	if err != nil {
+		if isDomainError(err, entity2.ErrorCodeNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	if pr.Status != entity2.PullRequestStatusOpen {
+		return false, nil
+	}
+
+	assigned := false
+	for _, reviewerID := range pr.AssignedReviewers {
+		if reviewerID == sync.UserID {
+			assigned = true
+			break
+		}
+	}
+	if sync.Operation == entity2.CodeHostReviewRemove {
+		return !assigned, nil
+	}
+	return assigned, nil
+}
+
+// eventString возвращает строковое поле данных события (пустую строку, если поля нет)
+func eventString(event *entity2.Event, key string) string {
+	value, _ := event.Data[key].(string)
+	return value
+}
@@ -0,0 +1,245 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	entity2 "test_task_avito/backend/internal/entity"
+	port2 "test_task_avito/backend/internal/port"
+	"testing"
+	"time"
+)
+
+// fakeCodeHostSyncRepo очередь изменений ревьюверов в памяти; время попытки не учитывается —
+// возвращаются все ожидающие изменения, как будто задержка уже прошла
+type fakeCodeHostSyncRepo struct {
+	port2.CodeHostSyncRepository
+
+	syncs []*entity2.CodeHostReviewerSync
+}
+
+func (r *fakeCodeHostSyncRepo) CreateCodeHostReviewerSyncs(_ context.Context, syncs []*entity2.CodeHostReviewerSync) error {
+	for _, sync := range syncs {
+		copied := *sync
+		copied.ID = int64(len(r.syncs) + 1)
+		r.syncs = append(r.syncs, &copied)
+	}
+	return nil
+}
+
+func (r *fakeCodeHostSyncRepo) GetDueCodeHostReviewerSyncs(_ context.Context, _ time.Time, limit int) ([]*entity2.CodeHostReviewerSync, error) {
+	var due []*entity2.CodeHostReviewerSync
+	for _, sync := range r.syncs {
+		if sync.Status == entity2.CodeHostSyncPending && len(due) < limit {
+			copied := *sync
+			due = append(due, &copied)
+		}
+	}
+	return due, nil
+}
+
+func (r *fakeCodeHostSyncRepo) UpdateCodeHostReviewerSync(_ context.Context, sync *entity2.CodeHostReviewerSync) error {
+	copied := *sync
+	r.syncs[sync.ID-1] = &copied
+	return nil
+}
+
+// fakeCodeHostAccounts логины GitHub пользователей: user_id → логин
+type fakeCodeHostAccounts struct {
+	port2.CodeHostAccountRepository
+
+	logins map[string]string
+}
+
+func (r *fakeCodeHostAccounts) GetCodeHostLogin(_ context.Context, _ entity2.CodeHostProvider, userID string) (string, error) {
+	login, ok := r.logins[userID]
+	if !ok {
+		return "", notFound("code host account not found")
+	}
+	return login, nil
+}
+
+// recordingCodeHostClient запоминает вызовы API; пока failures > 0, вызовы завершаются ошибкой
+type recordingCodeHostClient struct {
+	failures int
+	calls    []string
+}
+
+func (c *recordingCodeHostClient) RequestReviewers(_ context.Context, repository string, number int, logins []string) error {
+	return c.call("request", repository, number, logins)
+}
+
+func (c *recordingCodeHostClient) RemoveRequestedReviewers(_ context.Context, repository string, number int, logins []string) error {
+	return c.call("remove", repository, number, logins)
+}
+
+func (c *recordingCodeHostClient) call(operation, repository string, number int, logins []string) error {
+	if c.failures > 0 {
+		c.failures--
+		return errors.New("github is unavailable")
+	}
+	c.calls = append(c.calls, fmt.Sprintf("%s %s#%d %v", operation, repository, number, logins))
+	return nil
+}
+
+// newTestCodeHostSync создает use case передачи ревьюверов над фейками; у u1 и u2 есть логины GitHub
+func newTestCodeHostSync(repo *fakeRepo, client *recordingCodeHostClient) (*codeHostSyncUseCase, *fakeCodeHostSyncRepo) {
+	syncRepo := &fakeCodeHostSyncRepo{}
+	accounts := &fakeCodeHostAccounts{logins: map[string]string{"u1": "octo-u1", "u2": "octo-u2"}}
+	clients := map[entity2.CodeHostProvider]port2.CodeHostClient{entity2.CodeHostGitHub: client}
+	return NewCodeHostSyncUseCase(syncRepo, accounts, repo, clients).(*codeHostSyncUseCase), syncRepo
+}
+
+func TestCodeHostSyncEnqueueSplitsReassignment(t *testing.T) {
+	uc, syncRepo := newTestCodeHostSync(newFakeRepo(), &recordingCodeHostClient{})
+
+	events := []*entity2.Event{
+		reviewerReassignedEvent("org/repo#7", "u1", "u2", ""),
+		// Пользователь без логина и PR, созданный не хостингом, пропускаются
+		reviewerAssignedEvent("org/repo#7", "u3"),
+		reviewerAssignedEvent("pr-1", "u1"),
+	}
+	for _, event := range events {
+		if err := uc.Enqueue(context.Background(), event); err != nil {
+			t.Fatalf("enqueue %s: %v", event.Type, err)
+		}
+	}
+
+	if len(syncRepo.syncs) != 2 {
+		t.Fatalf("expected 2 syncs, got %+v", syncRepo.syncs)
+	}
+	remove, request := syncRepo.syncs[0], syncRepo.syncs[1]
+	if remove.Operation != entity2.CodeHostReviewRemove || remove.UserID != "u1" || remove.Login != "octo-u1" {
+		t.Errorf("unexpected remove %+v", remove)
+	}
+	if request.Operation != entity2.CodeHostReviewRequest || request.UserID != "u2" || request.Login != "octo-u2" {
+		t.Errorf("unexpected request %+v", request)
+	}
+	for _, sync := range syncRepo.syncs {
+		if sync.Repository != "org/repo" || sync.Number != 7 || sync.Status != entity2.CodeHostSyncPending {
+			t.Errorf("unexpected target %+v", sync)
+		}
+	}
+}
+
+func TestCodeHostSyncRemovesReviewRequest(t *testing.T) {
+	repo := newFakeRepo(member("author", "backend"), member("u1", "backend"), member("u2", "backend"))
+	repo.addPullRequest(&entity2.PullRequest{
+		PullRequestID: "org/repo#7", AuthorID: "author", Status: entity2.PullRequestStatusOpen, AssignedReviewers: []string{"u2"},
+	})
+	client := &recordingCodeHostClient{}
+	uc, syncRepo := newTestCodeHostSync(repo, client)
+
+	if err := uc.Enqueue(context.Background(), reviewerRemovedEvent("org/repo#7", "u1")); err != nil {
+		t.Fatalf("enqueue: %v", err)
+	}
+	if len(syncRepo.syncs) != 1 || syncRepo.syncs[0].Operation != entity2.CodeHostReviewRemove || syncRepo.syncs[0].Login != "octo-u1" {
+		t.Fatalf("unexpected syncs %+v", syncRepo.syncs)
+	}
+
+	if _, err := uc.SyncPending(context.Background()); err != nil {
+		t.Fatalf("sync: %v", err)
+	}
+	if len(client.calls) != 1 || client.calls[0] != "remove org/repo#7 [octo-u1]" {
+		t.Fatalf("calls %v, want the review request removed", client.calls)
+	}
+}
+
+func TestCodeHostSyncRetriesAndGivesUp(t *testing.T) {
+	repo := newFakeRepo(member("author", "backend"), member("u1", "backend"))
+	repo.addPullRequest(&entity2.PullRequest{
+		PullRequestID: "org/repo#7", AuthorID: "author", Status: entity2.PullRequestStatusOpen, AssignedReviewers: []string{"u1"},
+	})
+
+	tests := []struct {
+		name     string
+		failures int
+		want     entity2.CodeHostSyncStatus
+		calls    int
+	}{
+		{name: "retried until the host accepts", failures: 2, want: entity2.CodeHostSyncDone, calls: 1},
+		{name: "dead after max attempts", failures: codeHostSyncMaxAttempts, want: entity2.CodeHostSyncDead, calls: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &recordingCodeHostClient{failures: tt.failures}
+			uc, syncRepo := newTestCodeHostSync(repo, client)
+			if err := uc.Enqueue(context.Background(), reviewerAssignedEvent("org/repo#7", "u1")); err != nil {
+				t.Fatalf("enqueue: %v", err)
+			}
+
+			var total entity2.CodeHostSyncResult
+			for pass := 0; pass < codeHostSyncMaxAttempts+2; pass++ {
+				result, err := uc.SyncPending(context.Background())
+				if err != nil {
+					t.Fatalf("pass %d: %v", pass, err)
+				}
+				total.Done += result.Done
+				total.Retried += result.Retried
+				total.Dead += result.Dead
+			}
+
+			sync := syncRepo.syncs[0]
+			if sync.Status != tt.want {
+				t.Fatalf("status %s, want %s (%+v)", sync.Status, tt.want, sync)
+			}
+			if len(client.calls) != tt.calls {
+				t.Fatalf("calls %v, want %d", client.calls, tt.calls)
+			}
+			if tt.want == entity2.CodeHostSyncDone {
+				if total.Retried != tt.failures || total.Done != 1 || sync.Attempts != tt.failures+1 || sync.LastError != "" {
+					t.Errorf("result %+v, sync %+v", total, sync)
+				}
+			} else {
+				if total.Retried != codeHostSyncMaxAttempts-1 || total.Dead != 1 || sync.Attempts != codeHostSyncMaxAttempts || sync.LastError == "" {
+					t.Errorf("result %+v, sync %+v", total, sync)
+				}
+			}
+		})
+	}
+}
+
+func TestCodeHostSyncSkipsStaleChanges(t *testing.T) {
+	tests := []struct {
+		name      string
+		event     *entity2.Event
+		status    entity2.PullRequestStatus
+		reviewers []string
+	}{
+		{name: "request for a reviewer replaced again", event: reviewerAssignedEvent("org/repo#7", "u1"), status: entity2.PullRequestStatusOpen, reviewers: []string{"u2"}},
+		{name: "removal of a reviewer assigned again", event: reviewerReassignedEvent("org/repo#7", "u1", "u2", ""), status: entity2.PullRequestStatusOpen, reviewers: []string{"u1", "u2"}},
+		{name: "request on a merged PR", event: reviewerAssignedEvent("org/repo#7", "u1"), status: entity2.PullRequestStatusMerged, reviewers: []string{"u1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeRepo(member("author", "backend"), member("u1", "backend"), member("u2", "backend"))
+			repo.addPullRequest(&entity2.PullRequest{
+				PullRequestID: "org/repo#7", AuthorID: "author", Status: tt.status, AssignedReviewers: tt.reviewers,
+			})
+			client := &recordingCodeHostClient{}
+			uc, syncRepo := newTestCodeHostSync(repo, client)
+			if err := uc.Enqueue(context.Background(), tt.event); err != nil {
+				t.Fatalf("enqueue: %v", err)
+			}
+
+			result, err := uc.SyncPending(context.Background())
+			if err != nil {
+				t.Fatalf("sync: %v", err)
+			}
+
+			// Устаревшее изменение закрывается без вызова API; актуальное (запрос u2) передается
+			wantSkipped := len(syncRepo.syncs)
+			if tt.event.Type == entity2.EventReviewerReassigned {
+				wantSkipped = 1
+			}
+			if result.Skipped != wantSkipped || len(client.calls) != len(syncRepo.syncs)-wantSkipped {
+				t.Fatalf("result %+v, calls %v", result, client.calls)
+			}
+			for _, sync := range syncRepo.syncs {
+				if sync.Status != entity2.CodeHostSyncDone {
+					t.Errorf("sync %+v is not done", sync)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"strings"
+	entity2 "test_task_avito/backend/internal/entity"
+	"test_task_avito/backend/pkg/codeowners"
+)
+
+// resolveCodeOwners сопоставляет владельцев измененных путей с пользователями.
+// @login сравнивается с user_id и username, @org/team — с названием команды,
+// для email используется локальная часть адреса.
+func resolveCodeOwners(ruleset *codeowners.Ruleset, changedFiles []string, users []*entity2.User) []*entity2.User {
+	userSet := make(map[string]struct{})
+	teamSet := make(map[string]struct{})
+	for _, path := range changedFiles {
+		for _, raw := range ruleset.Owners(path) {
+			owner := codeowners.ParseOwner(raw)
+			switch {
+			case owner.Team != "":
+				teamSet[strings.ToLower(owner.Team)] = struct{}{}
+			case owner.User != "":
+				userSet[strings.ToLower(owner.User)] = struct{}{}
+			case owner.Email != "":
+				localPart, _, _ := strings.Cut(owner.Email, "@")
+				userSet[strings.ToLower(localPart)] = struct{}{}
+			}
+		}
+	}
+
+	if len(userSet) == 0 && len(teamSet) == 0 {
+		return nil
+	}
+
+	var owners []*entity2.User
+	for _, user := range users {
+		_, byID := userSet[strings.ToLower(user.UserID)]
+		_, byName := userSet[strings.ToLower(user.Username)]
+		_, byTeam := teamSet[strings.ToLower(user.TeamName)]
+		if byID || byName || byTeam {
+			owners = append(owners, user)
+		}
+	}
+	return owners
+}
@@ -0,0 +1,283 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"sort"
+	"strings"
+	entity2 "test_task_avito/backend/internal/entity"
+	port2 "test_task_avito/backend/internal/port"
+	"test_task_avito/backend/pkg/mail"
+	"test_task_avito/backend/pkg/worktime"
+	"text/template"
+	"time"
+)
+
+// digestTemplates шаблоны письма сводки: HTML-версия и текстовая для клиентов без HTML
+//
+//go:embed templates/digest.html templates/digest.txt
+var digestTemplates embed.FS
+
+var (
+	digestHTML = htmltemplate.Must(htmltemplate.ParseFS(digestTemplates, "templates/digest.html"))
+	digestText = template.Must(template.ParseFS(digestTemplates, "templates/digest.txt"))
+)
+
+// digestView данные шаблонов сводки
+type digestView struct {
+	Username string
+	Date     string
+	Reviews  []digestReview
+	// Overdue количество просроченных ревью
+	Overdue int
+}
+
+// digestReview строка сводки: PR, возраст назначения и состояние по SLA
+type digestReview struct {
+	PullRequestID   string
+	PullRequestName string
+	Author          string
+	Age             string
+	SLA             string
+	Overdue         bool
+}
+
+type digestUseCase struct {
+	digestRepo port2.DigestRepository
+	userRepo   port2.UserRepository
+	teamRepo   port2.TeamRepository
+	prRepo     port2.PullRequestRepository
+	sender     port2.MailSender
+	sendHour   int
+}
+
+// NewDigestUseCase создает новый экземпляр DigestUseCase; сводка отправляется в рабочий день
+// начиная с часа sendHour по местному времени пользователя
+func NewDigestUseCase(
+	digestRepo port2.DigestRepository,
+	userRepo port2.UserRepository,
+	teamRepo port2.TeamRepository,
+	prRepo port2.PullRequestRepository,
+	sender port2.MailSender,
+	sendHour int,
+) port2.DigestUseCase {
+	return &digestUseCase{
+		digestRepo: digestRepo,
+		userRepo:   userRepo,
+		teamRepo:   teamRepo,
+		prRepo:     prRepo,
+		sender:     sender,
+		sendHour:   sendHour,
+	}
+}
+
+func (uc *digestUseCase) SetDigestSettings(ctx context.Context, userID string, update entity2.DigestSettingsUpdate) (*entity2.DigestSettings, error) {
+	if update.Email != nil {
+		email := strings.TrimSpace(*update.Email)
+		if email != "" && (len(email) > maxVarcharLength || mail.ValidateAddress(email) != nil) {
+			return nil, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "email must be a single address like user@example.com")
+		}
+		update.Email = &email
+	}
+
+	// Проверяем существование пользователя
+	if _, err := uc.userRepo.GetUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	settings, err := uc.settings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	settings.Apply(update)
+	if err := uc.digestRepo.SetDigestSettings(ctx, settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+func (uc *digestUseCase) PreviewDigest(ctx context.Context, userID string) (*entity2.Digest, error) {
+	user, err := uc.userRepo.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := uc.settings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := newWorkCalendar(uc.userRepo, uc.teamRepo).schedule(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	return uc.build(ctx, user, settings.Email, schedule, time.Now())
+}
+
+func (uc *digestUseCase) SendDue(ctx context.Context) (*entity2.DigestResult, error) {
+	return uc.sendDue(ctx, time.Now())
+}
+
+// sendDue отправляет сводки, срок которых наступил к моменту now
+func (uc *digestUseCase) sendDue(ctx context.Context, now time.Time) (*entity2.DigestResult, error) {
+	recipients, err := uc.digestRepo.GetDigestRecipients(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar := newWorkCalendar(uc.userRepo, uc.teamRepo)
+	result := &entity2.DigestResult{}
+	var failures []error
+	for _, settings := range recipients {
+		if ctx.Err() != nil {
+			return result, ctx.Err()
+		}
+
+		user, err := uc.userRepo.GetUser(ctx, settings.UserID)
+		if err != nil {
+			return result, err
+		}
+		schedule, err := calendar.schedule(ctx, user)
+		if err != nil {
+			return result, err
+		}
+
+		// Сводка уходит утром рабочего дня пользователя, не больше одной за день
+		local := now.In(schedule.Location)
+		if !schedule.IsWorkday(now) || local.Hour() < uc.sendHour || settings.LastSentOn == local.Format(entity2.HolidayDateLayout) {
+			continue
+		}
+
+		digest, err := uc.build(ctx, user, settings.Email, schedule, now)
+		if err != nil {
+			return result, err
+		}
+		if len(digest.Reviews) == 0 {
+			result.Skipped++
+		} else if err := uc.sender.Send(ctx, digest.Email, digest.Subject, digest.Text, digest.HTML); err != nil {
+			// Дата не сохраняется, поэтому сводка повторится при следующем проходе
+			result.Failed++
+			failures = append(failures, fmt.Errorf("send digest to user %s: %w", user.UserID, err))
+			continue
+		} else {
+			result.Sent++
+		}
+
+		if err := uc.digestRepo.MarkDigestSent(ctx, user.UserID, digest.Date); err != nil {
+			return result, err
+		}
+	}
+
+	return result, errors.Join(failures...)
+}
+
+// settings возвращает настройки сводки пользователя; без сохраненных настроек сводка включена, но адреса нет
+func (uc *digestUseCase) settings(ctx context.Context, userID string) (*entity2.DigestSettings, error) {
+	settings, err := uc.digestRepo.GetDigestSettings(ctx, userID)
+	if err != nil {
+		if isDomainError(err, entity2.ErrorCodeNotFound) {
+			return &entity2.DigestSettings{UserID: userID, Enabled: true}, nil
+		}
+		return nil, err
+	}
+	return settings, nil
+}
+
+// build формирует сводку открытых ревью пользователя на момент now
+func (uc *digestUseCase) build(ctx context.Context, user *entity2.User, email string, schedule worktime.Schedule, now time.Time) (*entity2.Digest, error) {
+	assignments, err := uc.prRepo.GetReviewAssignmentsByReviewer(ctx, user.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	open := make([]*entity2.ReviewAssignment, 0, len(assignments))
+	for _, a := range assignments {
+		if a.Status == entity2.PullRequestStatusOpen {
+			open = append(open, a)
+		}
+	}
+	if err := setReviewDeadlines(ctx, uc.userRepo, uc.teamRepo, open, now); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(open, func(i, j int) bool {
+		if open[i].Overdue != open[j].Overdue {
+			return open[i].Overdue
+		}
+		return open[i].AssignedAt.Before(open[j].AssignedAt)
+	})
+
+	view := digestView{
+		Username: user.Username,
+		Date:     now.In(schedule.Location).Format(entity2.HolidayDateLayout),
+		Reviews:  make([]digestReview, 0, len(open)),
+	}
+	for _, a := range open {
+		review := digestReview{
+			PullRequestID:   a.PullRequestID,
+			PullRequestName: a.PullRequestName,
+			Author:          displayName(ctx, uc.userRepo, a.AuthorID),
+			Age:             formatAge(now.Sub(a.AssignedAt)),
+			SLA:             "no SLA",
+			Overdue:         a.Overdue,
+		}
+		switch {
+		case a.Overdue:
+			review.SLA = "overdue by " + formatAge(now.Sub(*a.DueAt))
+			view.Overdue++
+		case a.DueAt != nil:
+			review.SLA = "due in " + formatAge(a.DueAt.Sub(now))
+		}
+		view.Reviews = append(view.Reviews, review)
+	}
+
+	var text, html bytes.Buffer
+	if err := digestText.Execute(&text, view); err != nil {
+		return nil, err
+	}
+	if err := digestHTML.Execute(&html, view); err != nil {
+		return nil, err
+	}
+
+	return &entity2.Digest{
+		UserID:      user.UserID,
+		Email:       email,
+		Date:        view.Date,
+		GeneratedAt: now,
+		Reviews:     open,
+		Subject:     digestSubject(view),
+		Text:        text.String(),
+		HTML:        html.String(),
+	}, nil
+}
+
+// digestSubject тема письма сводки
+func digestSubject(view digestView) string {
+	switch {
+	case len(view.Reviews) == 0:
+		return "Review digest for " + view.Date + ": no open pull requests"
+	case view.Overdue > 0:
+		return fmt.Sprintf("Review digest for %s: %d open pull request(s), %d overdue", view.Date, len(view.Reviews), view.Overdue)
+	default:
+		return fmt.Sprintf("Review digest for %s: %d open pull request(s)", view.Date, len(view.Reviews))
+	}
+}
+
+// formatAge округляет длительность до часов: «less than an hour», «5h», «2d 3h»
+func formatAge(d time.Duration) string {
+	if d < time.Hour {
+		return "less than an hour"
+	}
+	hours := int(d / time.Hour)
+	days, hours := hours/24, hours%24
+	switch {
+	case days == 0:
+		return fmt.Sprintf("%dh", hours)
+	case hours == 0:
+		return fmt.Sprintf("%dd", days)
+	default:
+		return fmt.Sprintf("%dd %dh", days, hours)
+	}
+}
@@ -0,0 +1,228 @@
+package usecase
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"test_task_avito/backend/internal/adapter/email"
+	entity2 "test_task_avito/backend/internal/entity"
+	"test_task_avito/backend/pkg/mail/mailtest"
+	"testing"
+	"time"
+)
+
+// fakeDigestRepo настройки сводок в памяти
+type fakeDigestRepo struct {
+	settings map[string]*entity2.DigestSettings
+}
+
+func (r *fakeDigestRepo) SetDigestSettings(_ context.Context, settings *entity2.DigestSettings) error {
+	copied := *settings
+	if existing, ok := r.settings[settings.UserID]; ok {
+		copied.LastSentOn = existing.LastSentOn
+	}
+	r.settings[settings.UserID] = &copied
+	return nil
+}
+
+func (r *fakeDigestRepo) GetDigestSettings(_ context.Context, userID string) (*entity2.DigestSettings, error) {
+	settings, ok := r.settings[userID]
+	if !ok {
+		return nil, notFound("digest settings not found")
+	}
+	copied := *settings
+	return &copied, nil
+}
+
+func (r *fakeDigestRepo) GetDigestRecipients(_ context.Context) ([]*entity2.DigestSettings, error) {
+	var recipients []*entity2.DigestSettings
+	for _, settings := range r.settings {
+		if settings.Enabled && settings.Email != "" {
+			copied := *settings
+			recipients = append(recipients, &copied)
+		}
+	}
+	sort.Slice(recipients, func(i, j int) bool {
+		return recipients[i].UserID < recipients[j].UserID
+	})
+	return recipients, nil
+}
+
+func (r *fakeDigestRepo) MarkDigestSent(_ context.Context, userID, date string) error {
+	r.settings[userID].LastSentOn = date
+	return nil
+}
+
+// digestSendHour час отправки сводок в тестах
+const digestSendHour = 9
+
+// monday10 понедельник 10:00 UTC — сводка пользователя без графика уже должна уйти
+var monday10 = time.Date(2025, 11, 10, 10, 0, 0, 0, time.UTC)
+
+// digestFixture use case сводок над fakeRepo, в котором у b1 одно открытое ревью, и локальным SMTP-сервером
+type digestFixture struct {
+	uc      *digestUseCase
+	repo    *fakeRepo
+	digests *fakeDigestRepo
+	server  *mailtest.Server
+}
+
+func newDigestFixture(t *testing.T) *digestFixture {
+	t.Helper()
+	server, err := mailtest.NewServer()
+	if err != nil {
+		t.Fatalf("NewServer: %v", err)
+	}
+	t.Cleanup(server.Close)
+	host, portValue, _ := net.SplitHostPort(server.Addr)
+	port, _ := strconv.Atoi(portValue)
+
+	repo := newFakeRepo(member("author", "backend"), member("b1", "backend"))
+	createdAt := monday10.Add(-26 * time.Hour)
+	repo.addPullRequest(&entity2.PullRequest{
+		PullRequestID: "pr-1", PullRequestName: "Add search", AuthorID: "author", Status: entity2.PullRequestStatusOpen,
+		AssignedReviewers: []string{"b1"}, CreatedAt: &createdAt,
+	})
+	digests := &fakeDigestRepo{settings: map[string]*entity2.DigestSettings{
+		"b1": {UserID: "b1", Email: "b1@example.com", Enabled: true},
+	}}
+	sender := email.NewSMTPSender(host, port, "", "", "reviews@example.com")
+
+	return &digestFixture{
+		uc:      NewDigestUseCase(digests, repo, repo, repo, sender, digestSendHour).(*digestUseCase),
+		repo:    repo,
+		digests: digests,
+		server:  server,
+	}
+}
+
+func TestSendDueGates(t *testing.T) {
+	tests := []struct {
+		name       string
+		now        time.Time
+		timezone   string
+		holiday    string
+		lastSentOn string
+		wantSent   int
+	}{
+		{name: "working morning", now: monday10, wantSent: 1},
+		{name: "before the send hour", now: monday10.Add(-2 * time.Hour)},
+		{name: "weekend", now: monday10.AddDate(0, 0, -2)},
+		{name: "team holiday", now: monday10, holiday: "2025-11-10"},
+		{name: "already sent today", now: monday10, lastSentOn: "2025-11-10"},
+		{name: "sent yesterday", now: monday10, lastSentOn: "2025-11-07", wantSent: 1},
+		{name: "send hour by local time", now: monday10.Add(-3 * time.Hour), timezone: "Europe/Moscow", wantSent: 1},
+		{name: "local weekend", now: time.Date(2025, 11, 14, 20, 0, 0, 0, time.UTC), timezone: "Pacific/Kiritimati"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newDigestFixture(t)
+			if tt.timezone != "" {
+				schedule, err := entity2.NewWorkSchedule(tt.timezone, "00:00", "24:00")
+				if err != nil {
+					t.Fatalf("schedule: %v", err)
+				}
+				f.repo.users[1].Schedule = schedule
+			}
+			if tt.holiday != "" {
+				f.repo.holidays["backend"] = []entity2.TeamHoliday{{Date: tt.holiday}}
+			}
+			f.digests.settings["b1"].LastSentOn = tt.lastSentOn
+
+			result, err := f.uc.sendDue(context.Background(), tt.now)
+			if err != nil {
+				t.Fatalf("send: %v", err)
+			}
+			if result.Sent != tt.wantSent || len(f.server.Messages()) != tt.wantSent {
+				t.Fatalf("sent %d, delivered %d, want %d", result.Sent, len(f.server.Messages()), tt.wantSent)
+			}
+		})
+	}
+}
+
+func TestSendDueOncePerLocalDay(t *testing.T) {
+	f := newDigestFixture(t)
+
+	for _, now := range []time.Time{monday10, monday10.Add(5 * time.Hour)} {
+		if _, err := f.uc.sendDue(context.Background(), now); err != nil {
+			t.Fatalf("send at %s: %v", now, err)
+		}
+	}
+
+	messages := f.server.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("expected one digest per day, got %d", len(messages))
+	}
+	if message := messages[0]; message.To[0] != "b1@example.com" || !strings.Contains(string(message.Data), "Add search") {
+		t.Fatalf("unexpected digest %+v", message)
+	}
+	if sentOn := f.digests.settings["b1"].LastSentOn; sentOn != "2025-11-10" {
+		t.Fatalf("last sent on %q, want 2025-11-10", sentOn)
+	}
+
+	result, err := f.uc.sendDue(context.Background(), monday10.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("send next day: %v", err)
+	}
+	if result.Sent != 1 {
+		t.Fatalf("next day sent %d, want 1", result.Sent)
+	}
+}
+
+func TestSendDueSkipsOptedOutUser(t *testing.T) {
+	f := newDigestFixture(t)
+	disabled := false
+	if _, err := f.uc.SetDigestSettings(context.Background(), "b1", entity2.DigestSettingsUpdate{Enabled: &disabled}); err != nil {
+		t.Fatalf("opt out: %v", err)
+	}
+
+	result, err := f.uc.sendDue(context.Background(), monday10)
+	if err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	if result.Sent != 0 || len(f.server.Messages()) != 0 {
+		t.Fatalf("opted out user got %d digest(s)", len(f.server.Messages()))
+	}
+}
+
+func TestSendDueWithoutReviewsIsSkipped(t *testing.T) {
+	f := newDigestFixture(t)
+	f.repo.prs["pr-1"].Status = entity2.PullRequestStatusMerged
+
+	result, err := f.uc.sendDue(context.Background(), monday10)
+	if err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	if result.Skipped != 1 || len(f.server.Messages()) != 0 {
+		t.Fatalf("result %+v, delivered %d, want an empty digest skipped", result, len(f.server.Messages()))
+	}
+	if sentOn := f.digests.settings["b1"].LastSentOn; sentOn != "2025-11-10" {
+		t.Fatalf("last sent on %q, want the day to be marked", sentOn)
+	}
+}
+
+func TestSendDueRetriesAfterSMTPFailure(t *testing.T) {
+	f := newDigestFixture(t)
+	f.server.FailNext(1)
+
+	result, err := f.uc.sendDue(context.Background(), monday10)
+	if err == nil || !strings.Contains(err.Error(), "451") {
+		t.Fatalf("expected the SMTP error, got %v", err)
+	}
+	if result.Failed != 1 || result.Sent != 0 {
+		t.Fatalf("first run %+v, want one failure", result)
+	}
+	if sentOn := f.digests.settings["b1"].LastSentOn; sentOn != "" {
+		t.Fatalf("failed digest must not be marked sent, got %q", sentOn)
+	}
+
+	result, err = f.uc.sendDue(context.Background(), monday10.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("retry: %v", err)
+	}
+	if result.Sent != 1 || len(f.server.Messages()) != 1 {
+		t.Fatalf("retry %+v, delivered %d, want the digest sent", result, len(f.server.Messages()))
+	}
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	entity2 "test_task_avito/backend/internal/entity"
+	"time"
+)
+
+// newEvent создает доменное событие со случайным ID
+func newEvent(eventType entity2.EventType, data map[string]interface{}) *entity2.Event {
+	id, err := randomHex(16)
+	if err != nil {
+		// crypto/rand не возвращает ошибок на поддерживаемых платформах; ID на основе времени сохраняет уникальность на практике
+		id = time.Now().UTC().Format("20060102150405.000000000")
+	}
+	return &entity2.Event{
+		ID:         id,
+		Type:       eventType,
+		OccurredAt: time.Now(),
+		Data:       data,
+	}
+}
+
+func pullRequestCreatedEvent(pr *entity2.PullRequest) *entity2.Event {
+	return newEvent(entity2.EventPullRequestCreated, map[string]interface{}{
+		"pull_request_id":    pr.PullRequestID,
+		"pull_request_name":  pr.PullRequestName,
+		"author_id":          pr.AuthorID,
+		"assigned_reviewers": pr.AssignedReviewers,
+	})
+}
+
+func reviewerAssignedEvent(prID, userID string) *entity2.Event {
+	return newEvent(entity2.EventReviewerAssigned, map[string]interface{}{
+		"pull_request_id": prID,
+		"user_id":         userID,
+	})
+}
+
+func reviewerRemovedEvent(prID, userID string) *entity2.Event {
+	return newEvent(entity2.EventReviewerRemoved, map[string]interface{}{
+		"pull_request_id": prID,
+		"user_id":         userID,
+	})
+}
+
+// reviewerReassignedEvent событие замены ревьювера; reason — причина отказа, если ревьювер отказался сам
+func reviewerReassignedEvent(prID, oldUserID, newUserID, reason string) *entity2.Event {
+	data := map[string]interface{}{
+		"pull_request_id": prID,
+		"old_user_id":     oldUserID,
+		"new_user_id":     newUserID,
+	}
+	if reason != "" {
+		data["reason"] = reason
+	}
+	return newEvent(entity2.EventReviewerReassigned, data)
+}
+
+func pullRequestMergedEvent(pr *entity2.PullRequest) *entity2.Event {
+	return newEvent(entity2.EventPullRequestMerged, map[string]interface{}{
+		"pull_request_id": pr.PullRequestID,
+		"author_id":       pr.AuthorID,
+		"merged_at":       pr.MergedAt,
+	})
+}
+
+func pullRequestClosedEvent(pr *entity2.PullRequest) *entity2.Event {
+	return newEvent(entity2.EventPullRequestClosed, map[string]interface{}{
+		"pull_request_id": pr.PullRequestID,
+		"author_id":       pr.AuthorID,
+	})
+}
+
+func pullRequestReopenedEvent(pr *entity2.PullRequest) *entity2.Event {
+	return newEvent(entity2.EventPullRequestReopened, map[string]interface{}{
+		"pull_request_id":    pr.PullRequestID,
+		"author_id":          pr.AuthorID,
+		"assigned_reviewers": pr.AssignedReviewers,
+	})
+}
+
+func teamDeactivatedEvent(result *entity2.TeamDeactivateResult, userIDs []string) *entity2.Event {
+	return newEvent(entity2.EventTeamDeactivated, map[string]interface{}{
+		"team_name":      result.TeamName,
+		"user_ids":       userIDs,
+		"reassigned_prs": result.ReassignedPRs,
+		"skipped_prs":    result.SkippedPRs,
+	})
+}
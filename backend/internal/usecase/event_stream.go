@@ -0,0 +1,202 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	entity2 "test_task_avito/backend/internal/entity"
+	port2 "test_task_avito/backend/internal/port"
+	"time"
+)
+
+// eventGapTimeout время, в течение которого пропуск в номерах событий считается незавершенной транзакцией.
+// Номера выдаются до коммита, поэтому событие с меньшим номером может стать видимым позже следующего;
+// поток не переходит через пропуск, пока следующее событие моложе этого времени. Пропуски от откаченных
+// транзакций не заполняются никогда и пропускаются по истечении времени.
+const eventGapTimeout = 5 * time.Second
+
+// eventUserKeys поля данных событий с ID пользователей
+var eventUserKeys = []string{"author_id", "user_id", "old_user_id", "new_user_id", "assigned_reviewers", "user_ids"}
+
+type eventStreamUseCase struct {
+	outboxRepo port2.OutboxRepository
+	userRepo   port2.UserRepository
+	teamRepo   port2.TeamRepository
+	prRepo     port2.PullRequestRepository
+}
+
+// NewEventStreamUseCase создает новый экземпляр EventStreamUseCase
+func NewEventStreamUseCase(
+	outboxRepo port2.OutboxRepository,
+	userRepo port2.UserRepository,
+	teamRepo port2.TeamRepository,
+	prRepo port2.PullRequestRepository,
+) port2.EventStreamUseCase {
+	return &eventStreamUseCase{
+		outboxRepo: outboxRepo,
+		userRepo:   userRepo,
+		teamRepo:   teamRepo,
+		prRepo:     prRepo,
+	}
+}
+
+func (uc *eventStreamUseCase) OpenStream(ctx context.Context, filter entity2.EventFilter, lastEventID string) (int64, error) {
+	if filter.TeamName != "" {
+		exists, err := uc.teamRepo.TeamExists(ctx, filter.TeamName)
+		if err != nil {
+			return 0, err
+		}
+		if !exists {
+			return 0, entity2.NewDomainError(entity2.ErrorCodeNotFound, "team not found")
+		}
+	}
+	if filter.UserID != "" {
+		if _, err := uc.userRepo.GetUser(ctx, filter.UserID); err != nil {
+			return 0, err
+		}
+	}
+
+	lastEventID = strings.TrimSpace(lastEventID)
+	if lastEventID == "" {
+		return uc.outboxRepo.GetLastOutboxEventID(ctx)
+	}
+	position, err := strconv.ParseInt(lastEventID, 10, 64)
+	if err != nil || position < 0 {
+		return 0, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "Last-Event-ID must be an event id from this stream")
+	}
+	return position, nil
+}
+
+func (uc *eventStreamUseCase) ReadEvents(ctx context.Context, filter entity2.EventFilter, afterID int64, limit int) ([]*entity2.OutboxRecord, int64, error) {
+	records, err := uc.outboxRepo.GetOutboxEventsAfter(ctx, afterID, limit)
+	if err != nil {
+		return nil, afterID, err
+	}
+
+	// Читаются события до первого пропуска в номерах, который еще может заполниться
+	now := time.Now()
+	readable := make([]*entity2.OutboxRecord, 0, len(records))
+	for i, record := range records {
+		previousID := afterID
+		if i > 0 {
+			previousID = records[i-1].ID
+		}
+		if record.ID != previousID+1 && now.Sub(record.Event.OccurredAt) < eventGapTimeout {
+			break
+		}
+		readable = append(readable, record)
+	}
+
+	prs, err := uc.eventPullRequests(ctx, filter, readable)
+	if err != nil {
+		return nil, afterID, err
+	}
+
+	teams := make(map[string]string)
+	matched := make([]*entity2.OutboxRecord, 0, len(readable))
+	for _, record := range readable {
+		ok, err := uc.matches(ctx, filter, record.Event, prs, teams)
+		if err != nil {
+			return matched, afterID, err
+		}
+		if ok {
+			matched = append(matched, record)
+		}
+		afterID = record.ID
+	}
+
+	return matched, afterID, nil
+}
+
+// eventPullRequests загружает участников PR прочитанных событий одним запросом; без фильтра PR не нужны
+func (uc *eventStreamUseCase) eventPullRequests(ctx context.Context, filter entity2.EventFilter, records []*entity2.OutboxRecord) (map[string]*entity2.PullRequest, error) {
+	if filter.TeamName == "" && filter.UserID == "" {
+		return nil, nil
+	}
+
+	seen := make(map[string]bool)
+	var prIDs []string
+	for _, record := range records {
+		if prID := eventString(record.Event, "pull_request_id"); prID != "" && !seen[prID] {
+			seen[prID] = true
+			prIDs = append(prIDs, prID)
+		}
+	}
+	if len(prIDs) == 0 {
+		return nil, nil
+	}
+	return uc.prRepo.GetPullRequestParticipants(ctx, prIDs)
+}
+
+// matches проверяет, что событие затрагивает пользователя и команду фильтра. Кроме пользователей из данных события
+// учитываются автор и ревьюверы PR события из prs, а для команды — и команда PR; команда пользователя определяется
+// по его текущей команде. teams кеширует команды пользователей на время чтения.
+func (uc *eventStreamUseCase) matches(ctx context.Context, filter entity2.EventFilter, event *entity2.Event, prs map[string]*entity2.PullRequest, teams map[string]string) (bool, error) {
+	if filter.TeamName == "" && filter.UserID == "" {
+		return true, nil
+	}
+
+	userMatched := filter.UserID == ""
+	teamMatched := filter.TeamName == "" || eventString(event, "team_name") == filter.TeamName
+
+	userIDs := eventUserIDs(event)
+	if pr := prs[eventString(event, "pull_request_id")]; pr != nil {
+		userIDs = append(userIDs, pr.AuthorID)
+		userIDs = append(userIDs, pr.AssignedReviewers...)
+		teamMatched = teamMatched || pr.TeamName == filter.TeamName
+	}
+
+	for _, userID := range userIDs {
+		if userID == filter.UserID {
+			userMatched = true
+		}
+		if !teamMatched {
+			team, err := uc.teamOf(ctx, userID, teams)
+			if err != nil {
+				return false, err
+			}
+			teamMatched = team == filter.TeamName
+		}
+	}
+	return userMatched && teamMatched, nil
+}
+
+// teamOf возвращает команду пользователя; для удаленного пользователя — пустую строку
+func (uc *eventStreamUseCase) teamOf(ctx context.Context, userID string, teams map[string]string) (string, error) {
+	if team, ok := teams[userID]; ok {
+		return team, nil
+	}
+	user, err := uc.userRepo.GetUser(ctx, userID)
+	if err != nil && !isDomainError(err, entity2.ErrorCodeNotFound) {
+		return "", err
+	}
+	team := ""
+	if user != nil {
+		team = user.TeamName
+	}
+	teams[userID] = team
+	return team, nil
+}
+
+// eventUserIDs возвращает ID пользователей из данных события (одиночные поля и списки)
+func eventUserIDs(event *entity2.Event) []string {
+	var userIDs []string
+	for _, key := range eventUserKeys {
+		switch value := event.Data[key].(type) {
+		case string:
+			if value != "" {
+				userIDs = append(userIDs, value)
+			}
+		case []string:
+			userIDs = append(userIDs, value...)
+		case []interface{}:
+			// Данные события, прочитанные из outbox, приходят из JSON
+			for _, item := range value {
+				if id, ok := item.(string); ok && id != "" {
+					userIDs = append(userIDs, id)
+				}
+			}
+		}
+	}
+	return userIDs
+}
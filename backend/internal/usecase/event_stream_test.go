@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	entity2 "test_task_avito/backend/internal/entity"
+	"testing"
+	"time"
+)
+
+// streamRecord запись outbox с событием, произошедшим age назад
+func streamRecord(id int64, age time.Duration, eventType entity2.EventType, data map[string]interface{}) *entity2.OutboxRecord {
+	return &entity2.OutboxRecord{
+		ID:    id,
+		Event: &entity2.Event{ID: "e" + strconv.FormatInt(id, 10), Type: eventType, OccurredAt: time.Now().Add(-age), Data: data},
+	}
+}
+
+// recordIDs возвращает номера записей
+func recordIDs(records []*entity2.OutboxRecord) []int64 {
+	ids := make([]int64, 0, len(records))
+	for _, record := range records {
+		ids = append(ids, record.ID)
+	}
+	return ids
+}
+
+func TestReadEventsFilter(t *testing.T) {
+	repo := newFakeRepo(member("author", "backend"), member("b1", "backend"), member("p1", "platform"), member("f1", "frontend"))
+	repo.addPullRequest(&entity2.PullRequest{PullRequestID: "pr-1", AuthorID: "author", AssignedReviewers: []string{"b1", "p1"}})
+	repo.addPullRequest(&entity2.PullRequest{PullRequestID: "pr-2", AuthorID: "f1", TeamName: "payments"})
+	outbox := &fakeOutboxRepo{records: []*entity2.OutboxRecord{
+		streamRecord(1, time.Minute, entity2.EventPullRequestCreated, map[string]interface{}{"pull_request_id": "pr-1", "author_id": "author"}),
+		streamRecord(2, time.Minute, entity2.EventReviewerAssigned, map[string]interface{}{"pull_request_id": "pr-1", "user_id": "b1"}),
+		streamRecord(3, time.Minute, entity2.EventPullRequestCreated, map[string]interface{}{"pull_request_id": "pr-2", "author_id": "f1"}),
+		streamRecord(4, time.Minute, entity2.EventTeamDeactivated, map[string]interface{}{"team_name": "platform", "user_ids": []interface{}{"p1"}}),
+	}}
+	uc := NewEventStreamUseCase(outbox, repo, repo, repo)
+
+	tests := []struct {
+		name   string
+		filter entity2.EventFilter
+		want   []int64
+	}{
+		{name: "no filter", want: []int64{1, 2, 3, 4}},
+		{name: "author team", filter: entity2.EventFilter{TeamName: "backend"}, want: []int64{1, 2}},
+		{name: "reviewer team and team event", filter: entity2.EventFilter{TeamName: "platform"}, want: []int64{1, 2, 4}},
+		{name: "rules team of the PR", filter: entity2.EventFilter{TeamName: "payments"}, want: []int64{3}},
+		{name: "reviewer of the PR", filter: entity2.EventFilter{UserID: "p1"}, want: []int64{1, 2, 4}},
+		{name: "user and team", filter: entity2.EventFilter{TeamName: "frontend", UserID: "f1"}, want: []int64{3}},
+		{name: "user outside team", filter: entity2.EventFilter{TeamName: "frontend", UserID: "b1"}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo.participantQueries = 0
+
+			records, next, err := uc.ReadEvents(context.Background(), tt.filter, 0, 100)
+			if err != nil {
+				t.Fatalf("read: %v", err)
+			}
+			if got := recordIDs(records); !slices.Equal(got, tt.want) {
+				t.Fatalf("events %v, want %v", got, tt.want)
+			}
+			if next != 4 {
+				t.Fatalf("next position %d, want 4 — skipped events are passed too", next)
+			}
+			wantQueries := 1
+			if tt.filter == (entity2.EventFilter{}) {
+				wantQueries = 0
+			}
+			if repo.participantQueries != wantQueries {
+				t.Fatalf("PR participants loaded %d times, want %d per read", repo.participantQueries, wantQueries)
+			}
+		})
+	}
+}
+
+func TestReadEventsWaitsForGap(t *testing.T) {
+	tests := []struct {
+		name     string
+		afterID  int64
+		records  []*entity2.OutboxRecord
+		want     []int64
+		wantNext int64
+	}{
+		{
+			name:     "young event after a gap waits",
+			records:  []*entity2.OutboxRecord{streamRecord(1, 0, entity2.EventPullRequestCreated, nil), streamRecord(2, 0, entity2.EventPullRequestCreated, nil), streamRecord(4, 0, entity2.EventPullRequestCreated, nil)},
+			want:     []int64{1, 2},
+			wantNext: 2,
+		},
+		{
+			name:     "gap right after the position",
+			afterID:  2,
+			records:  []*entity2.OutboxRecord{streamRecord(4, time.Second, entity2.EventPullRequestCreated, nil)},
+			wantNext: 2,
+		},
+		{
+			name:     "old event after a gap passes it",
+			afterID:  2,
+			records:  []*entity2.OutboxRecord{streamRecord(4, 2*eventGapTimeout, entity2.EventPullRequestCreated, nil), streamRecord(5, 0, entity2.EventPullRequestCreated, nil)},
+			want:     []int64{4, 5},
+			wantNext: 5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeRepo()
+			uc := NewEventStreamUseCase(&fakeOutboxRepo{records: tt.records}, repo, repo, repo)
+
+			records, next, err := uc.ReadEvents(context.Background(), entity2.EventFilter{}, tt.afterID, 100)
+			if err != nil {
+				t.Fatalf("read: %v", err)
+			}
+			if got := recordIDs(records); !slices.Equal(got, tt.want) {
+				t.Fatalf("events %v, want %v", got, tt.want)
+			}
+			if next != tt.wantNext {
+				t.Fatalf("next position %d, want %d", next, tt.wantNext)
+			}
+		})
+	}
+}
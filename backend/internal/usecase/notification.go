@@ -0,0 +1,336 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"strings"
+	entity2 "test_task_avito/backend/internal/entity"
+	port2 "test_task_avito/backend/internal/port"
+	"test_task_avito/backend/pkg/slack"
+	"text/template"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// chatMaxAttempts количество попыток, после которого сообщение больше не отправляется
+	chatMaxAttempts = 8
+	// chatBatchSize количество сообщений, отправляемых за один проход
+	chatBatchSize = 100
+	// chatMaxErrorLength ограничение длины сохраняемой ошибки отправки
+	chatMaxErrorLength = 1000
+	// chatMaxTemplateLength ограничение длины шаблона сообщения
+	chatMaxTemplateLength = 4000
+)
+
+// defaultNotificationTemplates шаблоны сообщений, если команда не задала свои
+var defaultNotificationTemplates = map[entity2.NotificationKind]string{
+	entity2.NotificationAssigned: "{{.Reviewer}}, you have been assigned to review *{{.PullRequestName}}* " +
+		"({{.PullRequestID}}) by {{.Author}}. Reviewers: {{join .Reviewers \", \"}}.",
+	entity2.NotificationReassigned: "{{.Reviewer}}, you have been assigned to review *{{.PullRequestName}}* " +
+		"({{.PullRequestID}}) by {{.Author}} instead of {{.PreviousReviewer}}. Reviewers: {{join .Reviewers \", \"}}.",
+	entity2.NotificationOverdue: "{{.Reviewer}}, review of *{{.PullRequestName}}* ({{.PullRequestID}}) by {{.Author}} " +
+		"is overdue{{if .DueAt}} since {{.DueAt.UTC.Format \"2006-01-02 15:04 MST\"}}{{end}}.",
+}
+
+// templateFuncs функции, доступные в шаблонах сообщений
+var templateFuncs = template.FuncMap{"join": strings.Join}
+
+// sampleNotificationData данные для проверки шаблона вида kind при сохранении. Данные устроены так же,
+// как при отправке: срок ревью есть только у просроченных, предыдущий ревьювер — только у замены.
+func sampleNotificationData(kind entity2.NotificationKind) entity2.NotificationData {
+	data := entity2.NotificationData{
+		PullRequestID:   "pr-1",
+		PullRequestName: "Sample",
+		Author:          "author",
+		Reviewer:        "reviewer",
+		Reviewers:       []string{"reviewer", "other"},
+	}
+	switch kind {
+	case entity2.NotificationReassigned:
+		data.PreviousReviewer = "previous"
+	case entity2.NotificationOverdue:
+		data.DueAt = &time.Time{}
+	}
+	return data
+}
+
+type notificationUseCase struct {
+	chatRepo  port2.ChatRepository
+	teamRepo  port2.TeamRepository
+	userRepo  port2.UserRepository
+	prRepo    port2.PullRequestRepository
+	prUseCase port2.PullRequestUseCase
+	sender    port2.WebhookSender
+	// allowPrivateTargets разрешает адреса каналов во внутренней сети
+	allowPrivateTargets bool
+}
+
+// NewNotificationUseCase создает новый экземпляр NotificationUseCase; сообщения отправляются через sender.
+// allowPrivateTargets разрешает адреса каналов в loopback и частных сетях (для локального окружения).
+func NewNotificationUseCase(
+	chatRepo port2.ChatRepository,
+	teamRepo port2.TeamRepository,
+	userRepo port2.UserRepository,
+	prRepo port2.PullRequestRepository,
+	prUseCase port2.PullRequestUseCase,
+	sender port2.WebhookSender,
+	allowPrivateTargets bool,
+) port2.NotificationUseCase {
+	return &notificationUseCase{
+		chatRepo:            chatRepo,
+		teamRepo:            teamRepo,
+		userRepo:            userRepo,
+		prRepo:              prRepo,
+		prUseCase:           prUseCase,
+		sender:              sender,
+		allowPrivateTargets: allowPrivateTargets,
+	}
+}
+
+func (uc *notificationUseCase) SetChatChannel(ctx context.Context, teamName, webhookURL string, templates map[entity2.NotificationKind]string) (*entity2.ChatChannel, error) {
+	if !isHTTPURL(webhookURL) {
+		return nil, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "webhook_url must be an absolute http(s) URL")
+	}
+	if err := checkTargetURL(webhookURL, uc.allowPrivateTargets); err != nil {
+		return nil, err
+	}
+
+	stored := make(map[entity2.NotificationKind]string, len(templates))
+	for kind, text := range templates {
+		if !kind.Valid() {
+			return nil, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "unknown notification kind "+strconv.Quote(string(kind)))
+		}
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+		if utf8.RuneCountInString(text) > chatMaxTemplateLength {
+			return nil, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "template "+string(kind)+" is too long")
+		}
+		if _, err := renderNotification(text, sampleNotificationData(kind)); err != nil {
+			return nil, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "invalid template "+string(kind)+": "+err.Error())
+		}
+		stored[kind] = text
+	}
+
+	exists, err := uc.teamRepo.TeamExists(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, entity2.NewDomainError(entity2.ErrorCodeNotFound, "team not found")
+	}
+
+	channel := &entity2.ChatChannel{
+		TeamName:   teamName,
+		WebhookURL: webhookURL,
+		Templates:  stored,
+	}
+	if err := uc.chatRepo.SetChatChannel(ctx, channel); err != nil {
+		return nil, err
+	}
+
+	return withDefaultTemplates(channel), nil
+}
+
+func (uc *notificationUseCase) GetChatChannel(ctx context.Context, teamName string) (*entity2.ChatChannel, error) {
+	channel, err := uc.chatRepo.GetChatChannel(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	return withDefaultTemplates(channel), nil
+}
+
+func (uc *notificationUseCase) RemoveChatChannel(ctx context.Context, teamName string) error {
+	return uc.chatRepo.DeleteChatChannel(ctx, teamName)
+}
+
+// Notify уведомляет назначенного ревьювера в канале его команды. Команды без канала пропускаются.
+func (uc *notificationUseCase) Notify(ctx context.Context, event *entity2.Event) error {
+	var kind entity2.NotificationKind
+	var reviewerID, previousID string
+	switch event.Type {
+	case entity2.EventReviewerAssigned:
+		kind = entity2.NotificationAssigned
+		reviewerID = eventString(event, "user_id")
+	case entity2.EventReviewerReassigned:
+		kind = entity2.NotificationReassigned
+		reviewerID = eventString(event, "new_user_id")
+		previousID = eventString(event, "old_user_id")
+	default:
+		return nil
+	}
+
+	pr, err := uc.prRepo.GetPullRequest(ctx, eventString(event, "pull_request_id"))
+	if err != nil {
+		if isDomainError(err, entity2.ErrorCodeNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	message, err := uc.message(ctx, kind, "event:"+event.ID+":"+reviewerID, pr, reviewerID, previousID, nil)
+	if err != nil || message == nil {
+		return err
+	}
+	message.NextAttemptAt = event.OccurredAt
+	return uc.chatRepo.CreateChatMessages(ctx, []*entity2.ChatMessage{message})
+}
+
+func (uc *notificationUseCase) NotifyOverdue(ctx context.Context) error {
+	assignments, err := uc.prUseCase.GetOverdueReviews(ctx)
+	if err != nil {
+		return err
+	}
+
+	messages := make([]*entity2.ChatMessage, 0, len(assignments))
+	for _, assignment := range assignments {
+		pr, err := uc.prRepo.GetPullRequest(ctx, assignment.PullRequestID)
+		if err != nil {
+			return err
+		}
+
+		// Одно уведомление на назначение: после повторного назначения того же ревьювера срок считается заново
+		key := "overdue:" + assignment.PullRequestID + ":" + assignment.ReviewerID + ":" + strconv.FormatInt(assignment.AssignedAt.Unix(), 10)
+		message, err := uc.message(ctx, entity2.NotificationOverdue, key, pr, assignment.ReviewerID, "", assignment.DueAt)
+		if err != nil {
+			return err
+		}
+		if message != nil {
+			message.NextAttemptAt = time.Now()
+			messages = append(messages, message)
+		}
+	}
+
+	if len(messages) == 0 {
+		return nil
+	}
+	return uc.chatRepo.CreateChatMessages(ctx, messages)
+}
+
+func (uc *notificationUseCase) DeliverPending(ctx context.Context) (*entity2.ChatDeliveryResult, error) {
+	messages, err := uc.chatRepo.GetDueChatMessages(ctx, time.Now(), chatBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &entity2.ChatDeliveryResult{}
+	for _, message := range messages {
+		if ctx.Err() != nil {
+			return result, ctx.Err()
+		}
+
+		sendErr := uc.sender.Send(ctx, message.URL, message.Payload, map[string]string{"Content-Type": "application/json"})
+
+		message.Attempts++
+		switch {
+		case sendErr == nil:
+			message.Status = entity2.ChatMessageSent
+			message.LastError = ""
+			result.Sent++
+		case message.Attempts >= chatMaxAttempts:
+			message.Status = entity2.ChatMessageDead
+			message.LastError = truncate(sendErr.Error(), chatMaxErrorLength)
+			result.Dead++
+		default:
+			// Повторы идут по тому же расписанию, что и доставки вебхуков
+			message.NextAttemptAt = time.Now().Add(webhookBackoff(message.Attempts))
+			message.LastError = truncate(sendErr.Error(), chatMaxErrorLength)
+			result.Retried++
+		}
+
+		if err := uc.chatRepo.UpdateChatMessage(ctx, message); err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+// message формирует сообщение ревьюверу reviewerID в канал его команды; nil — у команды нет канала
+func (uc *notificationUseCase) message(ctx context.Context, kind entity2.NotificationKind, key string, pr *entity2.PullRequest, reviewerID, previousID string, dueAt *time.Time) (*entity2.ChatMessage, error) {
+	reviewer, err := uc.userRepo.GetUser(ctx, reviewerID)
+	if err != nil {
+		if isDomainError(err, entity2.ErrorCodeNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	channel, err := uc.chatRepo.GetChatChannel(ctx, reviewer.TeamName)
+	if err != nil {
+		if isDomainError(err, entity2.ErrorCodeNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	data := entity2.NotificationData{
+		PullRequestID:   slack.Escape(pr.PullRequestID),
+		PullRequestName: slack.Escape(pr.PullRequestName),
+		Author:          slack.Escape(displayName(ctx, uc.userRepo, pr.AuthorID)),
+		Reviewer:        slack.Escape(reviewer.Username),
+		DueAt:           dueAt,
+	}
+	if previousID != "" {
+		data.PreviousReviewer = slack.Escape(displayName(ctx, uc.userRepo, previousID))
+	}
+	for _, id := range pr.AssignedReviewers {
+		data.Reviewers = append(data.Reviewers, slack.Escape(displayName(ctx, uc.userRepo, id)))
+	}
+
+	text, err := renderNotification(withDefaultTemplates(channel).Templates[kind], data)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := slack.Message{Text: text}.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity2.ChatMessage{
+		DedupKey: truncate(key, maxVarcharLength),
+		TeamName: channel.TeamName,
+		Kind:     kind,
+		URL:      channel.WebhookURL,
+		Payload:  payload,
+		Status:   entity2.ChatMessagePending,
+	}, nil
+}
+
+// displayName возвращает имя пользователя или его ID, если пользователь не найден
+func displayName(ctx context.Context, userRepo port2.UserRepository, userID string) string {
+	user, err := userRepo.GetUser(ctx, userID)
+	if err != nil || user.Username == "" {
+		return userID
+	}
+	return user.Username
+}
+
+// withDefaultTemplates дополняет шаблоны канала шаблонами по умолчанию
+func withDefaultTemplates(channel *entity2.ChatChannel) *entity2.ChatChannel {
+	templates := make(map[entity2.NotificationKind]string, len(entity2.NotificationKinds))
+	for _, kind := range entity2.NotificationKinds {
+		templates[kind] = defaultNotificationTemplates[kind]
+		if text, ok := channel.Templates[kind]; ok {
+			templates[kind] = text
+		}
+	}
+	result := *channel
+	result.Templates = templates
+	return &result
+}
+
+// renderNotification заполняет шаблон сообщения
+func renderNotification(text string, data entity2.NotificationData) (string, error) {
+	tmpl, err := template.New("message").Funcs(templateFuncs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
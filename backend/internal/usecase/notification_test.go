@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	entity2 "test_task_avito/backend/internal/entity"
+	port2 "test_task_avito/backend/internal/port"
+	"testing"
+)
+
+// fakeChatRepo каналы команд в памяти
+type fakeChatRepo struct {
+	port2.ChatRepository
+
+	channels map[string]*entity2.ChatChannel
+}
+
+func (r *fakeChatRepo) SetChatChannel(_ context.Context, channel *entity2.ChatChannel) error {
+	if r.channels == nil {
+		r.channels = make(map[string]*entity2.ChatChannel)
+	}
+	r.channels[channel.TeamName] = channel
+	return nil
+}
+
+func newTestNotificationUseCase(chatRepo *fakeChatRepo, allowPrivateTargets bool) port2.NotificationUseCase {
+	repo := newFakeRepo(member("u1", "backend"))
+	return NewNotificationUseCase(chatRepo, repo, repo, repo, nil, nil, allowPrivateTargets)
+}
+
+func TestSetChatChannelChecksTargetAddress(t *testing.T) {
+	tests := []struct {
+		name         string
+		url          string
+		allowPrivate bool
+		wantErr      bool
+	}{
+		{name: "public host", url: "https://hooks.slack.com/services/T0/B0/x"},
+		{name: "loopback", url: "http://127.0.0.1:8065/hooks/x", wantErr: true},
+		{name: "private network", url: "http://192.168.1.10/hooks/x", wantErr: true},
+		{name: "loopback allowed by config", url: "http://127.0.0.1:8065/hooks/x", allowPrivate: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chatRepo := &fakeChatRepo{}
+
+			_, err := newTestNotificationUseCase(chatRepo, tt.allowPrivate).SetChatChannel(context.Background(), "backend", tt.url, nil)
+			if tt.wantErr {
+				if !isDomainError(err, entity2.ErrorCodeInvalidInput) || len(chatRepo.channels) != 0 {
+					t.Fatalf("error %v, channels %d, want INVALID_INPUT and nothing saved", err, len(chatRepo.channels))
+				}
+				return
+			}
+			if err != nil || len(chatRepo.channels) != 1 {
+				t.Fatalf("error %v, channels %d", err, len(chatRepo.channels))
+			}
+		})
+	}
+}
+
+func TestSetChatChannelValidatesTemplatesWithRealData(t *testing.T) {
+	const dueAt = `due {{.DueAt.UTC.Format "2006-01-02"}}`
+	tests := []struct {
+		name    string
+		kind    entity2.NotificationKind
+		text    string
+		wantErr bool
+	}{
+		{name: "overdue has a due date", kind: entity2.NotificationOverdue, text: dueAt},
+		{name: "assigned has no due date", kind: entity2.NotificationAssigned, text: dueAt, wantErr: true},
+		{name: "reassigned has no due date", kind: entity2.NotificationReassigned, text: dueAt, wantErr: true},
+		{name: "optional due date", kind: entity2.NotificationAssigned, text: `{{.Reviewer}}{{if .DueAt}} ` + dueAt + `{{end}}`},
+		{name: "unknown field", kind: entity2.NotificationReassigned, text: `{{.Missing}}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := newTestNotificationUseCase(&fakeChatRepo{}, false)
+
+			_, err := uc.SetChatChannel(context.Background(), "backend", "https://hooks.slack.com/services/T0/B0/x",
+				map[entity2.NotificationKind]string{tt.kind: tt.text})
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("error %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil && !isDomainError(err, entity2.ErrorCodeInvalidInput) {
+				t.Fatalf("error %v, want INVALID_INPUT", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	entity2 "test_task_avito/backend/internal/entity"
+	port2 "test_task_avito/backend/internal/port"
+	"time"
+)
+
+const (
+	// outboxBatchSize количество событий outbox, публикуемых за один проход
+	outboxBatchSize = 100
+	// outboxMaxAttempts количество попыток публикации, после которого событие пропускается
+	outboxMaxAttempts = 8
+)
+
+type eventRelayUseCase struct {
+	outboxRepo port2.OutboxRepository
+	sinks      []port2.EventPublisher
+}
+
+// NewEventRelayUseCase создает новый экземпляр EventRelayUseCase, публикующий события во все sinks
+func NewEventRelayUseCase(outboxRepo port2.OutboxRepository, sinks ...port2.EventPublisher) port2.EventRelayUseCase {
+	return &eventRelayUseCase{
+		outboxRepo: outboxRepo,
+		sinks:      sinks,
+	}
+}
+
+// RelayPending публикует события в порядке записи. Событие считается опубликованным, когда его приняли
+// все приемники; при ошибке проход останавливается, чтобы не нарушать порядок, и событие повторяется
+// целиком с задержкой по расписанию вебхуков — приемники, уже получившие его, отбрасывают повтор по ID.
+// Событие, которое не удалось опубликовать за outboxMaxAttempts попыток, отмечается недоставленным
+// и больше не задерживает следующие.
+func (uc *eventRelayUseCase) RelayPending(ctx context.Context) (*entity2.OutboxRelayResult, error) {
+	records, err := uc.outboxRepo.GetPendingOutboxEvents(ctx, outboxBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &entity2.OutboxRelayResult{}
+	for _, record := range records {
+		if ctx.Err() != nil {
+			return result, ctx.Err()
+		}
+
+		// Следующие события ждут, пока не наступит время повтора предыдущего
+		now := time.Now()
+		if record.NextAttemptAt != nil && now.Before(*record.NextAttemptAt) {
+			return result, nil
+		}
+
+		var publishErr error
+		for _, sink := range uc.sinks {
+			if publishErr = sink.Publish(ctx, record.Event); publishErr != nil {
+				break
+			}
+		}
+
+		if publishErr != nil {
+			record.Attempts++
+			record.LastError = truncate(publishErr.Error(), webhookMaxErrorLength)
+			if record.Attempts >= outboxMaxAttempts {
+				record.FailedAt = &now
+				if err := uc.outboxRepo.UpdateOutboxEvent(ctx, record); err != nil {
+					return result, err
+				}
+				result.Dead++
+				continue
+			}
+
+			nextAttemptAt := now.Add(webhookBackoff(record.Attempts))
+			record.NextAttemptAt = &nextAttemptAt
+			if err := uc.outboxRepo.UpdateOutboxEvent(ctx, record); err != nil {
+				return result, err
+			}
+			return result, publishErr
+		}
+
+		record.PublishedAt = &now
+		record.NextAttemptAt = nil
+		record.LastError = ""
+		if err := uc.outboxRepo.UpdateOutboxEvent(ctx, record); err != nil {
+			return result, err
+		}
+		result.Published++
+	}
+
+	return result, nil
+}
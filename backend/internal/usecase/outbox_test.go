@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	entity2 "test_task_avito/backend/internal/entity"
+	port2 "test_task_avito/backend/internal/port"
+	"testing"
+)
+
+// fakeOutboxRepo outbox в памяти
+type fakeOutboxRepo struct {
+	port2.OutboxRepository
+
+	records []*entity2.OutboxRecord
+}
+
+func newFakeOutboxRepo(eventIDs ...string) *fakeOutboxRepo {
+	repo := &fakeOutboxRepo{}
+	for i, id := range eventIDs {
+		repo.records = append(repo.records, &entity2.OutboxRecord{
+			ID:    int64(i + 1),
+			Event: &entity2.Event{ID: id, Type: entity2.EventPullRequestCreated},
+		})
+	}
+	return repo
+}
+
+func (r *fakeOutboxRepo) GetPendingOutboxEvents(_ context.Context, limit int) ([]*entity2.OutboxRecord, error) {
+	var pending []*entity2.OutboxRecord
+	for _, record := range r.records {
+		if record.PublishedAt == nil && record.FailedAt == nil && len(pending) < limit {
+			copied := *record
+			pending = append(pending, &copied)
+		}
+	}
+	return pending, nil
+}
+
+func (r *fakeOutboxRepo) UpdateOutboxEvent(_ context.Context, record *entity2.OutboxRecord) error {
+	copied := *record
+	r.records[record.ID-1] = &copied
+	return nil
+}
+
+func (r *fakeOutboxRepo) GetOutboxEventsAfter(_ context.Context, afterID int64, limit int) ([]*entity2.OutboxRecord, error) {
+	var records []*entity2.OutboxRecord
+	for _, record := range r.records {
+		if record.ID > afterID && len(records) < limit {
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+// skipBackoff переносит время повтора событий на текущий момент, как будто задержка уже прошла
+func (r *fakeOutboxRepo) skipBackoff() {
+	for _, record := range r.records {
+		record.NextAttemptAt = nil
+	}
+}
+
+// recordingSink приемник, запоминающий ID принятых событий; события из failing отклоняются
+type recordingSink struct {
+	failing   map[string]bool
+	published []string
+}
+
+func (s *recordingSink) Publish(_ context.Context, event *entity2.Event) error {
+	if s.failing[event.ID] {
+		return errors.New("sink rejected " + event.ID)
+	}
+	s.published = append(s.published, event.ID)
+	return nil
+}
+
+func TestRelayPendingSkipsPoisonEventAfterMaxAttempts(t *testing.T) {
+	repo := newFakeOutboxRepo("e1", "e2", "e3")
+	sink := &recordingSink{failing: map[string]bool{"e2": true}}
+	uc := NewEventRelayUseCase(repo, sink)
+
+	result, err := uc.RelayPending(context.Background())
+	if err == nil || result.Published != 1 {
+		t.Fatalf("first pass: result %+v, err %v, want e1 published and an error for e2", result, err)
+	}
+
+	// Пока попытки не исчерпаны, следующие события ждут
+	for attempt := 2; attempt < outboxMaxAttempts; attempt++ {
+		result, err = uc.RelayPending(context.Background())
+		if err != nil || result.Published != 0 {
+			t.Fatalf("attempt %d before backoff: result %+v, err %v, want nothing published", attempt, result, err)
+		}
+		repo.skipBackoff()
+		result, err = uc.RelayPending(context.Background())
+		if err == nil || result.Published != 0 || result.Dead != 0 {
+			t.Fatalf("attempt %d: result %+v, err %v, want e2 retried and e3 waiting", attempt, result, err)
+		}
+	}
+	if len(sink.published) != 1 {
+		t.Fatalf("published %v before the poison event was given up", sink.published)
+	}
+
+	repo.skipBackoff()
+	result, err = uc.RelayPending(context.Background())
+	if err != nil {
+		t.Fatalf("last attempt: %v", err)
+	}
+	if result.Dead != 1 || result.Published != 1 {
+		t.Fatalf("last attempt: result %+v, want e2 dead and e3 published", result)
+	}
+	if want := []string{"e1", "e3"}; !slices.Equal(sink.published, want) {
+		t.Fatalf("published %v, want %v", sink.published, want)
+	}
+
+	poison := repo.records[1]
+	if poison.FailedAt == nil || poison.PublishedAt != nil || poison.Attempts != outboxMaxAttempts || poison.LastError != "sink rejected e2" {
+		t.Fatalf("poison record %+v", poison)
+	}
+
+	// Недоставленное событие больше не публикуется
+	result, err = uc.RelayPending(context.Background())
+	if err != nil || result.Published != 0 || result.Dead != 0 {
+		t.Fatalf("after dead letter: result %+v, err %v", result, err)
+	}
+}
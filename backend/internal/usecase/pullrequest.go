@@ -2,67 +2,95 @@ package usecase
 
 import (
 	"context"
-	"math/rand"
+	"slices"
+	"strings"
 	entity2 "test_task_avito/backend/internal/entity"
 	port2 "test_task_avito/backend/internal/port"
+	"test_task_avito/backend/pkg/codeowners"
 	"time"
+	"unicode/utf8"
 )
 
 type pullRequestUseCase struct {
 	prRepo   port2.PullRequestRepository
 	userRepo port2.UserRepository
 	teamRepo port2.TeamRepository
+	selector *reviewerSelector
 }
 
 // NewPullRequestUseCase создает новый экземпляр PullRequestUseCase
-func NewPullRequestUseCase(prRepo port2.PullRequestRepository, userRepo port2.UserRepository, teamRepo port2.TeamRepository) port2.PullRequestUseCase {
+func NewPullRequestUseCase(prRepo port2.PullRequestRepository, userRepo port2.UserRepository, teamRepo port2.TeamRepository, rnd port2.RandomSource) port2.PullRequestUseCase {
 	return &pullRequestUseCase{
 		prRepo:   prRepo,
 		userRepo: userRepo,
 		teamRepo: teamRepo,
+		selector: newReviewerSelector(userRepo, teamRepo, prRepo, rnd),
 	}
 }
 
-func (uc *pullRequestUseCase) CreatePullRequest(ctx context.Context, prID, prName, authorID string) (*entity2.PullRequest, error) {
+func (uc *pullRequestUseCase) CreatePullRequest(ctx context.Context, input entity2.CreatePullRequestInput) (*entity2.PullRequest, []string, error) {
+	requiredTags, err := entity2.NormalizeTags(input.RequiredTags)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// Проверяем существование PR
-	exists, err := uc.prRepo.PRExists(ctx, prID)
+	exists, err := uc.prRepo.PRExists(ctx, input.PullRequestID)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if exists {
-		return nil, entity2.NewDomainError(entity2.ErrorCodePRExists, "PR id already exists")
+		return nil, nil, entity2.NewDomainError(entity2.ErrorCodePRExists, "PR id already exists")
 	}
 
 	// Получаем автора
-	author, err := uc.userRepo.GetUser(ctx, authorID)
+	author, err := uc.userRepo.GetUser(ctx, input.AuthorID)
 	if err != nil {
-		return nil, entity2.NewDomainError(entity2.ErrorCodeNotFound, "author not found")
+		return nil, nil, entity2.NewDomainError(entity2.ErrorCodeNotFound, "author not found")
 	}
 
-	// Получаем активных пользователей команды автора (исключая самого автора)
-	candidates, err := uc.userRepo.GetActiveUsersByTeam(ctx, author.TeamName, authorID)
-	if err != nil {
-		return nil, err
+	// Ревьюверы выбираются по правилам заданной команды так, как если бы автор был ее участником
+	ruledAuthor := author
+	if input.TeamName != "" && input.TeamName != author.TeamName {
+		exists, err := uc.teamRepo.TeamExists(ctx, input.TeamName)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !exists {
+			return nil, nil, entity2.NewDomainError(entity2.ErrorCodeNotFound, "team not found")
+		}
+		override := *author
+		override.TeamName = input.TeamName
+		ruledAuthor = &override
 	}
 
 	// Назначаем до 2 ревьюверов
-	reviewers := uc.selectReviewers(candidates, 2)
+	reviewers, uncoveredTags, err := uc.selectReviewers(ctx, ruledAuthor, input.ChangedFiles, requiredTags, 2)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	now := time.Now()
 	pr := &entity2.PullRequest{
-		PullRequestID:     prID,
-		PullRequestName:   prName,
-		AuthorID:          authorID,
+		PullRequestID:     input.PullRequestID,
+		PullRequestName:   input.PullRequestName,
+		AuthorID:          input.AuthorID,
+		TeamName:          ruledAuthor.TeamName,
 		Status:            entity2.PullRequestStatusOpen,
 		AssignedReviewers: reviewers,
+		RequiredTags:      requiredTags,
 		CreatedAt:         &now,
 	}
 
-	if err := uc.prRepo.CreatePullRequest(ctx, pr); err != nil {
-		return nil, err
+	events := []*entity2.Event{pullRequestCreatedEvent(pr)}
+	for _, reviewerID := range pr.AssignedReviewers {
+		events = append(events, reviewerAssignedEvent(pr.PullRequestID, reviewerID))
+	}
+	if err := uc.prRepo.CreatePullRequest(ctx, pr, events...); err != nil {
+		return nil, nil, err
 	}
 
-	return pr, nil
+	return pr, uncoveredTags, nil
 }
 
 func (uc *pullRequestUseCase) MergePullRequest(ctx context.Context, prID string) (*entity2.PullRequest, error) {
@@ -76,29 +104,110 @@ func (uc *pullRequestUseCase) MergePullRequest(ctx context.Context, prID string)
 	if pr.Status == entity2.PullRequestStatusMerged {
 		return pr, nil
 	}
+	if pr.Status == entity2.PullRequestStatusClosed {
+		return nil, entity2.NewDomainError(entity2.ErrorCodePRClosed, "cannot merge closed PR")
+	}
 
 	// Обновляем статус
 	now := time.Now()
-	if err := uc.prRepo.UpdatePullRequestStatus(ctx, prID, entity2.PullRequestStatusMerged, &now); err != nil {
+	pr.Status = entity2.PullRequestStatusMerged
+	pr.MergedAt = &now
+	if err := uc.prRepo.UpdatePullRequestStatus(ctx, prID, entity2.PullRequestStatusMerged, &now, pullRequestMergedEvent(pr)); err != nil {
 		return nil, err
 	}
 
-	pr.Status = entity2.PullRequestStatusMerged
-	pr.MergedAt = &now
+	return pr, nil
+}
+
+func (uc *pullRequestUseCase) ClosePullRequest(ctx context.Context, prID string) (*entity2.PullRequest, error) {
+	pr, err := uc.prRepo.GetPullRequest(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch pr.Status {
+	case entity2.PullRequestStatusClosed:
+		return pr, nil
+	case entity2.PullRequestStatusMerged:
+		return nil, entity2.NewDomainError(entity2.ErrorCodePRMerged, "cannot close merged PR")
+	}
+
+	// Ревьюверы остаются назначенными, но закрытый PR не входит в открытые ревью, SLA и нагрузку
+	pr.Status = entity2.PullRequestStatusClosed
+	if err := uc.prRepo.UpdatePullRequestStatus(ctx, prID, entity2.PullRequestStatusClosed, nil, pullRequestClosedEvent(pr)); err != nil {
+		return nil, err
+	}
 
 	return pr, nil
 }
 
-func (uc *pullRequestUseCase) ReassignReviewer(ctx context.Context, prID, oldUserID string) (*entity2.PullRequest, string, error) {
-	// Получаем PR
+func (uc *pullRequestUseCase) ReopenPullRequest(ctx context.Context, prID string) (*entity2.PullRequest, error) {
 	pr, err := uc.prRepo.GetPullRequest(ctx, prID)
 	if err != nil {
+		return nil, err
+	}
+
+	switch pr.Status {
+	case entity2.PullRequestStatusOpen:
+		return pr, nil
+	case entity2.PullRequestStatusMerged:
+		return nil, entity2.NewDomainError(entity2.ErrorCodePRMerged, "cannot reopen merged PR")
+	}
+
+	pr.Status = entity2.PullRequestStatusOpen
+	if err := uc.prRepo.UpdatePullRequestStatus(ctx, prID, entity2.PullRequestStatusOpen, nil, pullRequestReopenedEvent(pr)); err != nil {
+		return nil, err
+	}
+
+	return pr, nil
+}
+
+func (uc *pullRequestUseCase) ReassignReviewer(ctx context.Context, prID, oldUserID, newUserID string) (*entity2.PullRequest, string, error) {
+	pr, err := uc.reassignablePullRequest(ctx, prID, oldUserID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Замена на конкретного пользователя проверяется так же, как ручное назначение;
+	// отказавшийся от PR заменой быть не может
+	if newUserID != "" {
+		if err := uc.checkManualReviewer(ctx, pr, newUserID); err != nil {
+			return nil, "", err
+		}
+		if pr.DeclinedBy(newUserID) {
+			return nil, "", entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "user has declined this PR")
+		}
+	} else {
+		newUserID, err = uc.pickReplacement(ctx, pr, oldUserID)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	reviewers := replacedReviewers(pr.AssignedReviewers, oldUserID, newUserID)
+	event := reviewerReassignedEvent(pr.PullRequestID, oldUserID, newUserID, "")
+	if err := uc.prRepo.UpdatePullRequestReviewers(ctx, pr.PullRequestID, reviewers, event); err != nil {
 		return nil, "", err
 	}
+	pr.AssignedReviewers = reviewers
+
+	return pr, newUserID, nil
+}
+
+// reassignablePullRequest возвращает PR, в котором можно заменить ревьювера oldUserID: PR открыт и он назначен
+func (uc *pullRequestUseCase) reassignablePullRequest(ctx context.Context, prID, oldUserID string) (*entity2.PullRequest, error) {
+	// Получаем PR
+	pr, err := uc.prRepo.GetPullRequest(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
 
 	// Проверяем, что PR не MERGED
 	if pr.Status == entity2.PullRequestStatusMerged {
-		return nil, "", entity2.NewDomainError(entity2.ErrorCodePRMerged, "cannot reassign on merged PR")
+		return nil, entity2.NewDomainError(entity2.ErrorCodePRMerged, "cannot reassign on merged PR")
+	}
+	if pr.Status == entity2.PullRequestStatusClosed {
+		return nil, entity2.NewDomainError(entity2.ErrorCodePRClosed, "cannot reassign on closed PR")
 	}
 
 	// Проверяем, что oldUserID назначен ревьювером
@@ -110,97 +219,512 @@ func (uc *pullRequestUseCase) ReassignReviewer(ctx context.Context, prID, oldUse
 		}
 	}
 	if !found {
-		return nil, "", entity2.NewDomainError(entity2.ErrorCodeNotAssigned, "reviewer is not assigned to this PR")
+		return nil, entity2.NewDomainError(entity2.ErrorCodeNotAssigned, "reviewer is not assigned to this PR")
 	}
 
+	return pr, nil
+}
+
+// pickReplacement подбирает замену ревьюверу oldUserID по правилам команды PR
+func (uc *pullRequestUseCase) pickReplacement(ctx context.Context, pr *entity2.PullRequest, oldUserID string) (string, error) {
 	// Получаем пользователя, которого заменяем
 	oldUser, err := uc.userRepo.GetUser(ctx, oldUserID)
+	if err != nil {
+		return "", err
+	}
+
+	// Автор PR (nil, если удален) — для правил команды PR и истории пар автор–ревьювер
+	author, err := uc.userRepo.GetUser(ctx, pr.AuthorID)
+	if err != nil {
+		if domainErr, ok := err.(*entity2.DomainError); !ok || domainErr.Code != entity2.ErrorCodeNotFound {
+			return "", err
+		}
+	}
+	author = rulesAuthor(pr, author)
+
+	// Команда, из которой ищется замена, запасная команда и требуемый уровень замены с учетом правил команды PR
+	teamName, fallbackTeam, requiredLevel, err := uc.replacementRequirements(ctx, pr, author, oldUser)
+	if err != nil {
+		return "", err
+	}
+
+	availableCandidates, rejections, err := uc.replacementCandidates(ctx, pr, author, teamName, oldUserID)
+	if err != nil {
+		return "", err
+	}
+
+	// Если в команде-партнере заменить некем, место заполняется как при создании PR
+	if len(availableCandidates) == 0 && fallbackTeam != "" {
+		var fallbackRejections []entity2.CandidateRejection
+		availableCandidates, fallbackRejections, err = uc.replacementCandidates(ctx, pr, author, fallbackTeam, oldUserID)
+		if err != nil {
+			return "", err
+		}
+		teamName = fallbackTeam
+		rejections = append(rejections, fallbackRejections...)
+	}
+
+	if len(availableCandidates) == 0 {
+		return "", entity2.NewNoCandidateError("no active replacement candidate in team", rejections)
+	}
+
+	// Выбираем случайного кандидата, по возможности не ниже требуемого уровня
+	var qualified []*entity2.User
+	if requiredLevel != "" {
+		qualified = filterByLevel(availableCandidates, requiredLevel)
+	}
+	picked, err := uc.selector.pickPreferred(ctx, teamName, author, qualified, availableCandidates, 1)
+	if err != nil {
+		return "", err
+	}
+
+	return picked[0].UserID, nil
+}
+
+// rulesAuthor возвращает автора PR в команде, по правилам которой выбирались ревьюверы PR, — так же,
+// как при создании PR с заданной командой
+func rulesAuthor(pr *entity2.PullRequest, author *entity2.User) *entity2.User {
+	if author == nil || pr.TeamName == "" || pr.TeamName == author.TeamName {
+		return author
+	}
+	override := *author
+	override.TeamName = pr.TeamName
+	return &override
+}
+
+func (uc *pullRequestUseCase) DeclineReview(ctx context.Context, prID, userID, reason string) (*entity2.PullRequest, string, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, "", entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "reason is required")
+	}
+	if utf8.RuneCountInString(reason) > maxVarcharLength {
+		return nil, "", entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "reason is too long")
+	}
+
+	pr, err := uc.reassignablePullRequest(ctx, prID, userID)
 	if err != nil {
 		return nil, "", err
 	}
 
-	// Получаем активных пользователей команды заменяемого ревьювера (исключая его самого и автора PR)
-	candidates, err := uc.userRepo.GetActiveUsersByTeam(ctx, oldUser.TeamName, oldUserID)
+	// Замена подбирается так же, как при переназначении; если заменить некем, отказ не фиксируется
+	replacedBy, err := uc.pickReplacement(ctx, pr, userID)
 	if err != nil {
 		return nil, "", err
 	}
 
-	// Исключаем автора PR из кандидатов
-	var filteredCandidates []*entity2.User
-	for _, candidate := range candidates {
-		if candidate.UserID != pr.AuthorID {
-			filteredCandidates = append(filteredCandidates, candidate)
-		}
+	// Замена, отказ и событие сохраняются одной транзакцией
+	reviewers := replacedReviewers(pr.AssignedReviewers, userID, replacedBy)
+	decline := &entity2.ReviewDecline{
+		UserID: userID,
+		Reason: reason,
+	}
+	event := reviewerReassignedEvent(pr.PullRequestID, userID, replacedBy, reason)
+	if err := uc.prRepo.SaveReviewDecline(ctx, prID, reviewers, decline, event); err != nil {
+		return nil, "", err
 	}
+	pr.AssignedReviewers = reviewers
 
-	// Исключаем уже назначенных ревьюверов
-	var availableCandidates []*entity2.User
-	for _, candidate := range filteredCandidates {
-		isAssigned := false
-		for _, reviewerID := range pr.AssignedReviewers {
-			if candidate.UserID == reviewerID {
-				isAssigned = true
-				break
-			}
-		}
-		if !isAssigned {
-			availableCandidates = append(availableCandidates, candidate)
+	// Повторный отказ (после ручного назначения) заменяет предыдущий
+	declines := make([]entity2.ReviewDecline, 0, len(pr.Declines)+1)
+	for _, previous := range pr.Declines {
+		if previous.UserID != userID {
+			declines = append(declines, previous)
 		}
 	}
+	pr.Declines = append(declines, *decline)
 
-	if len(availableCandidates) == 0 {
-		return nil, "", entity2.NewDomainError(entity2.ErrorCodeNoCandidate, "no active replacement candidate in team")
+	return pr, replacedBy, nil
+}
+
+func (uc *pullRequestUseCase) AddReviewer(ctx context.Context, prID, userID string) (*entity2.PullRequest, error) {
+	pr, err := uc.prRepo.GetPullRequest(ctx, prID)
+	if err != nil {
+		return nil, err
 	}
 
-	// Выбираем случайного кандидата
-	newReviewer := availableCandidates[rand.Intn(len(availableCandidates))]
+	if pr.Status == entity2.PullRequestStatusMerged {
+		return nil, entity2.NewDomainError(entity2.ErrorCodePRMerged, "cannot add reviewer to merged PR")
+	}
+	if pr.Status == entity2.PullRequestStatusClosed {
+		return nil, entity2.NewDomainError(entity2.ErrorCodePRClosed, "cannot add reviewer to closed PR")
+	}
+
+	if err := uc.checkManualReviewer(ctx, pr, userID); err != nil {
+		return nil, err
+	}
+
+	newReviewers := append(append([]string{}, pr.AssignedReviewers...), userID)
+	if err := uc.prRepo.UpdatePullRequestReviewers(ctx, prID, newReviewers, reviewerAssignedEvent(prID, userID)); err != nil {
+		return nil, err
+	}
+
+	pr.AssignedReviewers = newReviewers
+
+	return pr, nil
+}
+
+func (uc *pullRequestUseCase) RemoveReviewer(ctx context.Context, prID, userID string) (*entity2.PullRequest, error) {
+	pr, err := uc.prRepo.GetPullRequest(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+
+	if pr.Status == entity2.PullRequestStatusMerged {
+		return nil, entity2.NewDomainError(entity2.ErrorCodePRMerged, "cannot remove reviewer from merged PR")
+	}
+	if pr.Status == entity2.PullRequestStatusClosed {
+		return nil, entity2.NewDomainError(entity2.ErrorCodePRClosed, "cannot remove reviewer from closed PR")
+	}
 
-	// Обновляем список ревьюверов
 	newReviewers := make([]string, 0, len(pr.AssignedReviewers))
 	for _, reviewerID := range pr.AssignedReviewers {
-		if reviewerID != oldUserID {
+		if reviewerID != userID {
 			newReviewers = append(newReviewers, reviewerID)
 		}
 	}
-	newReviewers = append(newReviewers, newReviewer.UserID)
+	if len(newReviewers) == len(pr.AssignedReviewers) {
+		return nil, entity2.NewDomainError(entity2.ErrorCodeNotAssigned, "reviewer is not assigned to this PR")
+	}
 
-	if err := uc.prRepo.UpdatePullRequestReviewers(ctx, prID, newReviewers); err != nil {
-		return nil, "", err
+	if err := uc.prRepo.UpdatePullRequestReviewers(ctx, prID, newReviewers, reviewerRemovedEvent(prID, userID)); err != nil {
+		return nil, err
 	}
 
 	pr.AssignedReviewers = newReviewers
 
-	return pr, newReviewer.UserID, nil
+	return pr, nil
+}
+
+// checkManualReviewer проверяет, что пользователя можно вручную назначить ревьювером PR: он существует, активен,
+// не является автором, еще не назначен и не исключен для автора. Лимиты и режим выбора не учитываются.
+func (uc *pullRequestUseCase) checkManualReviewer(ctx context.Context, pr *entity2.PullRequest, userID string) error {
+	user, err := uc.userRepo.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if !user.IsActive {
+		return entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "user is not active")
+	}
+	if user.UserID == pr.AuthorID {
+		return entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "author cannot review own PR")
+	}
+	for _, reviewerID := range pr.AssignedReviewers {
+		if reviewerID == userID {
+			return entity2.NewDomainError(entity2.ErrorCodeAlreadyAssigned, "user is already assigned to this PR")
+		}
+	}
+
+	exclusions, err := uc.userRepo.GetReviewerExclusions(ctx, pr.AuthorID)
+	if err != nil {
+		return err
+	}
+	for _, exclusion := range exclusions {
+		if exclusion.ExcludedUserID == userID {
+			return entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "user is excluded from reviewing PRs of "+pr.AuthorID)
+		}
+	}
+
+	return nil
+}
+
+// replacedReviewers возвращает список ревьюверов, в котором oldUserID заменен на newUserID
+func replacedReviewers(reviewers []string, oldUserID, newUserID string) []string {
+	replaced := make([]string, 0, len(reviewers))
+	for _, reviewerID := range reviewers {
+		if reviewerID != oldUserID {
+			replaced = append(replaced, reviewerID)
+		}
+	}
+	return append(replaced, newUserID)
+}
+
+// selectReviewers выбирает до maxReviewers ревьюверов для нового PR в порядке приоритета:
+//  0. один ревьювер из команды-партнера, если этого требуют настройки команды автора;
+//     один ревьювер не ниже требуемого уровня, если среди выбранных такого еще нет;
+//  1. участники команды автора, покрывающие требуемые навыки;
+//  2. участники других команд — только для навыков, не покрытых командой автора;
+//  3. владельцы измененных путей по CODEOWNERS;
+//  4. остальные участники команды автора.
+//
+// Возвращает ID ревьюверов и навыки, которые не удалось покрыть.
+func (uc *pullRequestUseCase) selectReviewers(ctx context.Context, author *entity2.User, changedFiles, requiredTags []string, maxReviewers int) ([]string, []string, error) {
+	settings, err := uc.teamRepo.GetTeamSettings(ctx, author.TeamName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Получаем активных пользователей команды автора (исключая самого автора)
+	candidates, err := uc.userRepo.GetActiveUsersByTeam(ctx, author.TeamName, author.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Пропускаем перегруженных ревьюверов
+	candidates, _, err = uc.selector.eligible(ctx, author, candidates)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var picked []*entity2.User
+	uncoveredTags := requiredTags
+
+	// Одно место отводится ревьюверу из команды-партнера; если там никого нет, место заполняется как обычно
+	if settings.RequiredReviewerTeam != "" {
+		partner, left, err := uc.partnerReviewer(ctx, author, settings.RequiredReviewerTeam, uncoveredTags)
+		if err != nil {
+			return nil, nil, err
+		}
+		picked = append(picked, partner...)
+		uncoveredTags = left
+	}
+
+	// Одно место отводится ревьюверу не ниже требуемого уровня, если среди выбранных такого еще нет
+	requiredLevel := entity2.RequiredReviewerLevel(author.Level, settings.MinReviewerLevel)
+	if requiredLevel != "" && !hasLevel(picked, requiredLevel) && len(picked) < maxReviewers {
+		reviewer, left, err := uc.levelReviewer(ctx, author, candidates, picked, requiredLevel, uncoveredTags)
+		if err != nil {
+			return nil, nil, err
+		}
+		picked = append(picked, reviewer...)
+		uncoveredTags = left
+	}
+
+	more, uncoveredTags, err := uc.selector.coverTags(ctx, author.TeamName, author, excludeUsers(candidates, picked), uncoveredTags, maxReviewers-len(picked))
+	if err != nil {
+		return nil, nil, err
+	}
+	picked = append(picked, more...)
+
+	// Для навыков, которых нет в команде автора, ищем ревьюверов в других командах
+	if len(uncoveredTags) > 0 && len(picked) < maxReviewers {
+		others, err := uc.otherTeamsCandidates(ctx, author)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		more, uncoveredTags, err = uc.selector.coverTags(ctx, author.TeamName, author, excludeUsers(others, picked), uncoveredTags, maxReviewers-len(picked))
+		if err != nil {
+			return nil, nil, err
+		}
+		picked = append(picked, more...)
+	}
+
+	// Владельцы измененных путей имеют приоритет перед остальными участниками команды
+	owners, err := uc.codeOwnerCandidates(ctx, author, changedFiles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rest, err := uc.selector.pickPreferred(ctx, author.TeamName, author, excludeUsers(owners, picked), excludeUsers(candidates, picked), maxReviewers-len(picked))
+	if err != nil {
+		return nil, nil, err
+	}
+	picked = append(picked, rest...)
+
+	reviewers := make([]string, 0, len(picked))
+	for _, candidate := range picked {
+		reviewers = append(reviewers, candidate.UserID)
+	}
+
+	if uncoveredTags == nil {
+		uncoveredTags = []string{}
+	}
+
+	return reviewers, uncoveredTags, nil
 }
 
-// selectReviewers выбирает до maxReviewers случайных ревьюверов из кандидатов
-func (uc *pullRequestUseCase) selectReviewers(candidates []*entity2.User, maxReviewers int) []string {
-	if len(candidates) == 0 {
-		return []string{}
+// partnerReviewer выбирает ревьювера из команды-партнера, предпочитая покрывающего требуемые навыки.
+// Возвращает выбранного (или пустой список) и непокрытые навыки.
+func (uc *pullRequestUseCase) partnerReviewer(ctx context.Context, author *entity2.User, partnerTeam string, tags []string) ([]*entity2.User, []string, error) {
+	candidates, err := uc.userRepo.GetActiveUsersByTeam(ctx, partnerTeam, author.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+	candidates, _, err = uc.selector.eligible(ctx, author, candidates)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	count := maxReviewers
-	if len(candidates) < count {
-		count = len(candidates)
+	partner, left, err := uc.selector.coverTags(ctx, partnerTeam, author, candidates, tags, 1)
+	if err != nil || len(partner) > 0 {
+		return partner, left, err
 	}
 
-	// Перемешиваем кандидатов
-	shuffled := make([]*entity2.User, len(candidates))
-	copy(shuffled, candidates)
-	rand.Seed(time.Now().UnixNano())
-	rand.Shuffle(len(shuffled), func(i, j int) {
-		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
-	})
+	partner, err = uc.selector.pick(ctx, partnerTeam, author, candidates, 1)
+	return partner, tags, err
+}
+
+// levelReviewer выбирает ревьювера не ниже level: сначала из кандидатов команды автора, затем из других
+// команд, предпочитая покрывающего требуемые навыки. Возвращает выбранного (или пустой список) и непокрытые навыки.
+func (uc *pullRequestUseCase) levelReviewer(ctx context.Context, author *entity2.User, candidates, exclude []*entity2.User, level entity2.UserLevel, tags []string) ([]*entity2.User, []string, error) {
+	qualified := filterByLevel(excludeUsers(candidates, exclude), level)
+	if len(qualified) == 0 {
+		others, err := uc.otherTeamsCandidates(ctx, author)
+		if err != nil {
+			return nil, nil, err
+		}
+		qualified = filterByLevel(excludeUsers(others, exclude), level)
+	}
 
-	// Выбираем первых count
-	reviewers := make([]string, 0, count)
-	for i := 0; i < count; i++ {
-		reviewers = append(reviewers, shuffled[i].UserID)
+	reviewer, left, err := uc.selector.coverTags(ctx, author.TeamName, author, qualified, tags, 1)
+	if err != nil || len(reviewer) > 0 {
+		return reviewer, left, err
 	}
 
-	return reviewers
+	reviewer, err = uc.selector.pick(ctx, author.TeamName, author, qualified, 1)
+	return reviewer, tags, err
+}
+
+// replacementCandidates возвращает активных участников команды teamName, которыми можно заменить ревьювера
+// oldUserID: кроме автора, уже назначенных, отказавшихся от PR и недоступных по правилам отбора, — и причины отказа
+func (uc *pullRequestUseCase) replacementCandidates(ctx context.Context, pr *entity2.PullRequest, author *entity2.User, teamName, oldUserID string) ([]*entity2.User, []entity2.CandidateRejection, error) {
+	candidates, err := uc.userRepo.GetActiveUsersByTeam(ctx, teamName, oldUserID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var available []*entity2.User
+	var declineRejections []entity2.CandidateRejection
+	for _, candidate := range candidates {
+		if candidate.UserID == pr.AuthorID {
+			continue
+		}
+		if pr.DeclinedBy(candidate.UserID) {
+			declineRejections = append(declineRejections, entity2.CandidateRejection{
+				UserID: candidate.UserID,
+				Reason: "declined this PR",
+			})
+			continue
+		}
+		if !slices.Contains(pr.AssignedReviewers, candidate.UserID) {
+			available = append(available, candidate)
+		}
+	}
+
+	// Пропускаем перегруженных и исключенных для автора ревьюверов
+	available, rejections, err := uc.selector.eligible(ctx, author, available)
+	if err != nil {
+		return nil, nil, err
+	}
+	return available, append(declineRejections, rejections...), nil
+}
+
+// replacementRequirements определяет, из какой команды ищется замена ревьюверу и какого уровня она должна
+// быть, чтобы PR продолжал соответствовать правилам своей команды (author — автор в команде PR, см. rulesAuthor):
+//   - замена ищется в команде заменяемого ревьювера. Если он — единственный ревьювер PR из команды-партнера,
+//     которую требует команда PR, и в команде-партнере заменить некем, замена, как при создании PR,
+//     ищется в команде PR (запасная команда; пусто — запасной команды нет);
+//   - если без заменяемого в PR не останется ревьювера требуемого уровня, возвращается этот уровень
+//     (пусто — ограничений нет).
+func (uc *pullRequestUseCase) replacementRequirements(ctx context.Context, pr *entity2.PullRequest, author, oldUser *entity2.User) (string, string, entity2.UserLevel, error) {
+	if author == nil {
+		return oldUser.TeamName, "", "", nil
+	}
+
+	settings, err := uc.teamRepo.GetTeamSettings(ctx, author.TeamName)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	fallbackTeam := ""
+	partnerTeam := settings.RequiredReviewerTeam
+	if partnerTeam != "" && oldUser.TeamName == partnerTeam && partnerTeam != author.TeamName {
+		fallbackTeam = author.TeamName
+	}
+	requiredLevel := entity2.RequiredReviewerLevel(author.Level, settings.MinReviewerLevel)
+
+	for _, reviewerID := range pr.AssignedReviewers {
+		if reviewerID == oldUser.UserID {
+			continue
+		}
+		reviewer, err := uc.userRepo.GetUser(ctx, reviewerID)
+		if err != nil {
+			return "", "", "", err
+		}
+		// Правило команды-партнера выполняется другим ревьювером, запасная команда не нужна
+		if reviewer.TeamName == partnerTeam {
+			fallbackTeam = ""
+		}
+		if requiredLevel != "" && reviewer.Level.AtLeast(requiredLevel) {
+			requiredLevel = ""
+		}
+	}
+
+	return oldUser.TeamName, fallbackTeam, requiredLevel, nil
+}
+
+// otherTeamsCandidates возвращает доступных активных пользователей вне команды автора
+func (uc *pullRequestUseCase) otherTeamsCandidates(ctx context.Context, author *entity2.User) ([]*entity2.User, error) {
+	activeUsers, err := uc.userRepo.GetAllActiveUsers(ctx, []string{author.UserID})
+	if err != nil {
+		return nil, err
+	}
+
+	others := make([]*entity2.User, 0, len(activeUsers))
+	for _, user := range activeUsers {
+		if user.TeamName != author.TeamName {
+			others = append(others, user)
+		}
+	}
+
+	eligible, _, err := uc.selector.eligible(ctx, author, others)
+	return eligible, err
+}
+
+// codeOwnerCandidates возвращает доступных владельцев измененных путей по правилам CODEOWNERS команды автора.
+// Владельцами могут быть и участники других команд.
+func (uc *pullRequestUseCase) codeOwnerCandidates(ctx context.Context, author *entity2.User, changedFiles []string) ([]*entity2.User, error) {
+	if len(changedFiles) == 0 {
+		return nil, nil
+	}
+
+	content, err := uc.teamRepo.GetTeamCodeowners(ctx, author.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	if content == "" {
+		return nil, nil
+	}
+
+	ruleset, err := codeowners.Parse(strings.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+
+	activeUsers, err := uc.userRepo.GetAllActiveUsers(ctx, []string{author.UserID})
+	if err != nil {
+		return nil, err
+	}
+
+	owners, _, err := uc.selector.eligible(ctx, author, resolveCodeOwners(ruleset, changedFiles, activeUsers))
+	return owners, err
 }
 
 func (uc *pullRequestUseCase) GetReviewerStats(ctx context.Context) ([]entity2.ReviewerStat, int64, error) {
 	return uc.prRepo.GetReviewerStats(ctx)
 }
+
+func (uc *pullRequestUseCase) GetOverdueReviews(ctx context.Context) ([]*entity2.ReviewAssignment, error) {
+	return uc.overdueReviews(ctx, time.Now())
+}
+
+// overdueReviews возвращает назначения открытых PR, просроченные на момент now
+func (uc *pullRequestUseCase) overdueReviews(ctx context.Context, now time.Time) ([]*entity2.ReviewAssignment, error) {
+	assignments, err := uc.prRepo.GetOpenReviewAssignments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := setReviewDeadlines(ctx, uc.userRepo, uc.teamRepo, assignments, now); err != nil {
+		return nil, err
+	}
+
+	overdue := make([]*entity2.ReviewAssignment, 0)
+	for _, a := range assignments {
+		if a.Overdue {
+			overdue = append(overdue, a)
+		}
+	}
+	return overdue, nil
+}
@@ -0,0 +1,468 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	entity2 "test_task_avito/backend/internal/entity"
+	"test_task_avito/backend/pkg/random"
+	"testing"
+)
+
+// newTestPullRequestUseCase создает use case PR над fakeRepo с фиксированным seed
+func newTestPullRequestUseCase(repo *fakeRepo, seed int64) *pullRequestUseCase {
+	return NewPullRequestUseCase(repo, repo, repo, random.New(seed)).(*pullRequestUseCase)
+}
+
+func TestCreatePullRequestPrefersCodeOwners(t *testing.T) {
+	for seed := int64(1); seed <= 20; seed++ {
+		repo := newFakeRepo(member("author", "backend"), member("b1", "backend"), member("b2", "backend"), member("owner", "backend"))
+		repo.codeowners["backend"] = "/api/ @owner\n"
+
+		pr, _, err := newTestPullRequestUseCase(repo, seed).CreatePullRequest(context.Background(), entity2.CreatePullRequestInput{
+			PullRequestID: "pr-1", PullRequestName: "API", AuthorID: "author", ChangedFiles: []string{"api/handler.go"},
+		})
+		if err != nil {
+			t.Fatalf("seed %d: create: %v", seed, err)
+		}
+		if len(pr.AssignedReviewers) != 2 || !slices.Contains(pr.AssignedReviewers, "owner") {
+			t.Fatalf("seed %d: reviewers %v must include the code owner", seed, pr.AssignedReviewers)
+		}
+	}
+}
+
+func TestCreatePullRequestCoversTagsFromOtherTeams(t *testing.T) {
+	repo := newFakeRepo(member("author", "backend"), member("b1", "backend"), member("b2", "backend"), member("dba", "data"))
+	repo.skills = map[string][]string{"b1": {"go"}, "dba": {"postgres"}}
+
+	pr, uncovered, err := newTestPullRequestUseCase(repo, 1).CreatePullRequest(context.Background(), entity2.CreatePullRequestInput{
+		PullRequestID: "pr-1", PullRequestName: "Migration", AuthorID: "author", RequiredTags: []string{"go", "postgres", "rust"},
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	slices.Sort(pr.AssignedReviewers)
+	if !slices.Equal(pr.AssignedReviewers, []string{"b1", "dba"}) {
+		t.Fatalf("reviewers %v, want b1 and dba", pr.AssignedReviewers)
+	}
+	if !slices.Equal(uncovered, []string{"rust"}) {
+		t.Fatalf("uncovered %v, want [rust]", uncovered)
+	}
+}
+
+func TestCreatePullRequestReservesPartnerSlot(t *testing.T) {
+	tests := []struct {
+		name        string
+		partnerBusy bool
+		want        []string
+	}{
+		{name: "partner team has a candidate", want: []string{"b1", "p1"}},
+		{name: "partner team is at capacity", partnerBusy: true, want: []string{"b1", "b2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeRepo(member("author", "backend"), member("b1", "backend"), member("b2", "backend"), member("p1", "platform"))
+			repo.teamSettings("backend").RequiredReviewerTeam = "platform"
+			repo.teamSettings("platform").DefaultMaxOpenReviews = 1
+			if tt.partnerBusy {
+				repo.openReviews["p1"] = 1
+			}
+			// Второе место в команде автора однозначно: b2 занят, если партнер свободен
+			if !tt.partnerBusy {
+				repo.users[2].IsActive = false
+			}
+
+			pr, _, err := newTestPullRequestUseCase(repo, 1).CreatePullRequest(context.Background(), entity2.CreatePullRequestInput{
+				PullRequestID: "pr-1", PullRequestName: "Feature", AuthorID: "author",
+			})
+			if err != nil {
+				t.Fatalf("create: %v", err)
+			}
+			got := append([]string(nil), pr.AssignedReviewers...)
+			slices.Sort(got)
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("reviewers %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReassignReviewerPartnerTeam(t *testing.T) {
+	tests := []struct {
+		name      string
+		reviewers []string
+		oldUserID string
+		partners  []string
+		want      string
+	}{
+		{name: "partner reviewer is replaced from partner team", reviewers: []string{"b1", "p1"}, oldUserID: "p1", partners: []string{"p1", "p2"}, want: "p2"},
+		{name: "empty partner team falls back to author team", reviewers: []string{"b1", "p1"}, oldUserID: "p1", partners: []string{"p1"}, want: "b2"},
+		{name: "author team reviewer is replaced from own team", reviewers: []string{"b1", "b2"}, oldUserID: "b1", partners: []string{"p1"}, want: "b3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeRepo(member("author", "backend"), member("b1", "backend"), member("b2", "backend"), member("b3", "backend"))
+			for _, id := range tt.partners {
+				repo.users = append(repo.users, member(id, "platform"))
+			}
+			repo.teamSettings("backend").RequiredReviewerTeam = "platform"
+			repo.addPullRequest(&entity2.PullRequest{
+				PullRequestID: "pr-1", AuthorID: "author", Status: entity2.PullRequestStatusOpen, AssignedReviewers: tt.reviewers,
+			})
+			// В сценариях с партнером b3 неактивен, чтобы замена из команды автора была однозначной
+			if tt.oldUserID == "p1" {
+				repo.users[3].IsActive = false
+			}
+
+			_, replacedBy, err := newTestPullRequestUseCase(repo, 1).ReassignReviewer(context.Background(), "pr-1", tt.oldUserID, "")
+			if err != nil {
+				t.Fatalf("reassign: %v", err)
+			}
+			if replacedBy != tt.want {
+				t.Fatalf("replaced by %s, want %s", replacedBy, tt.want)
+			}
+		})
+	}
+}
+
+func TestPullRequestTeamRulesOutliveCreation(t *testing.T) {
+	repo := newFakeRepo(
+		member("author", "backend"), member("b1", "backend"),
+		member("pay1", "payments"), member("pay2", "payments"), member("p1", "platform"),
+	)
+	repo.teamSettings("payments").RequiredReviewerTeam = "platform"
+	uc := newTestPullRequestUseCase(repo, 1)
+
+	pr, _, err := uc.CreatePullRequest(context.Background(), entity2.CreatePullRequestInput{
+		PullRequestID: "pr-1", PullRequestName: "Add search", AuthorID: "author", TeamName: "payments",
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if repo.prs["pr-1"].TeamName != "payments" {
+		t.Fatalf("saved team %q, want payments", repo.prs["pr-1"].TeamName)
+	}
+	if !slices.Contains(pr.AssignedReviewers, "p1") {
+		t.Fatalf("reviewers %v, want partner p1", pr.AssignedReviewers)
+	}
+
+	// Заменить партнера в его команде некем: запасная команда — команда PR, а не команда автора
+	_, replacedBy, err := uc.ReassignReviewer(context.Background(), "pr-1", "p1", "")
+	if err != nil {
+		t.Fatalf("reassign: %v", err)
+	}
+	want := "pay1"
+	if slices.Contains(pr.AssignedReviewers, want) {
+		want = "pay2"
+	}
+	if replacedBy != want {
+		t.Fatalf("replaced by %s, want %s from the PR team", replacedBy, want)
+	}
+}
+
+// withLevel меняет уровень пользователя
+func withLevel(user *entity2.User, level entity2.UserLevel) *entity2.User {
+	user.Level = level
+	return user
+}
+
+func TestLevelReviewer(t *testing.T) {
+	tests := []struct {
+		name    string
+		users   []*entity2.User
+		exclude []string
+		tags    []string
+		want    []string
+		left    []string
+	}{
+		{
+			name:  "senior from author team",
+			users: []*entity2.User{member("b1", "backend"), withLevel(member("b2", "backend"), entity2.UserLevelSenior), withLevel(member("o1", "ops"), entity2.UserLevelSenior)},
+			want:  []string{"b2"},
+		},
+		{
+			name:  "lead satisfies senior requirement",
+			users: []*entity2.User{member("b1", "backend"), withLevel(member("b2", "backend"), entity2.UserLevelLead)},
+			want:  []string{"b2"},
+		},
+		{
+			name:    "already picked senior is not picked again",
+			users:   []*entity2.User{withLevel(member("b1", "backend"), entity2.UserLevelSenior), withLevel(member("o1", "ops"), entity2.UserLevelSenior)},
+			exclude: []string{"b1"},
+			want:    []string{"o1"},
+		},
+		{
+			name:  "other teams when author team has no senior",
+			users: []*entity2.User{member("b1", "backend"), withLevel(member("o1", "ops"), entity2.UserLevelSenior)},
+			want:  []string{"o1"},
+		},
+		{
+			name: "prefers senior covering tags",
+			users: []*entity2.User{
+				withLevel(member("b1", "backend"), entity2.UserLevelSenior),
+				withLevel(member("b2", "backend"), entity2.UserLevelSenior),
+			},
+			tags: []string{"postgres", "go"},
+			want: []string{"b2"},
+			left: []string{"go"},
+		},
+		{
+			name:  "nobody qualified anywhere",
+			users: []*entity2.User{member("b1", "backend"), member("o1", "ops")},
+			want:  []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			author := member("author", "backend")
+			repo := newFakeRepo(append([]*entity2.User{author}, tt.users...)...)
+			repo.skills["b2"] = []string{"postgres"}
+			uc := newTestPullRequestUseCase(repo, 1)
+
+			candidates, err := repo.GetActiveUsersByTeam(context.Background(), "backend", "author")
+			if err != nil {
+				t.Fatalf("candidates: %v", err)
+			}
+			var exclude []*entity2.User
+			for _, user := range tt.users {
+				if slices.Contains(tt.exclude, user.UserID) {
+					exclude = append(exclude, user)
+				}
+			}
+
+			reviewer, left, err := uc.levelReviewer(context.Background(), author, candidates, exclude, entity2.UserLevelSenior, tt.tags)
+			if err != nil {
+				t.Fatalf("levelReviewer: %v", err)
+			}
+			if got := userIDs(reviewer); !slices.Equal(got, tt.want) {
+				t.Fatalf("reviewer %v, want %v", got, tt.want)
+			}
+			wantLeft := tt.left
+			if wantLeft == nil {
+				wantLeft = tt.tags
+			}
+			if !slices.Equal(left, wantLeft) {
+				t.Fatalf("uncovered %v, want %v", left, wantLeft)
+			}
+		})
+	}
+}
+
+func TestReassignReviewerKeepsRequiredLevel(t *testing.T) {
+	for seed := int64(1); seed <= 10; seed++ {
+		repo := newFakeRepo(
+			withLevel(member("author", "backend"), entity2.UserLevelJunior),
+			withLevel(member("b1", "backend"), entity2.UserLevelSenior),
+			withLevel(member("b2", "backend"), entity2.UserLevelJunior),
+			withLevel(member("b3", "backend"), entity2.UserLevelJunior),
+			withLevel(member("b4", "backend"), entity2.UserLevelMiddle),
+		)
+		repo.addPullRequest(&entity2.PullRequest{
+			PullRequestID: "pr-1", AuthorID: "author", Status: entity2.PullRequestStatusOpen, AssignedReviewers: []string{"b1", "b2"},
+		})
+
+		_, replacedBy, err := newTestPullRequestUseCase(repo, seed).ReassignReviewer(context.Background(), "pr-1", "b1", "")
+		if err != nil {
+			t.Fatalf("seed %d: reassign: %v", seed, err)
+		}
+		if replacedBy != "b4" {
+			t.Fatalf("seed %d: replaced by %s, want the only middle b4", seed, replacedBy)
+		}
+	}
+}
+
+func TestCheckManualReviewer(t *testing.T) {
+	tests := []struct {
+		name      string
+		userID    string
+		exclusion *entity2.ReviewerExclusion
+		wantCode  entity2.ErrorCode
+	}{
+		{name: "available user", userID: "b2"},
+		{name: "user from another team", userID: "o1"},
+		{name: "unknown user", userID: "ghost", wantCode: entity2.ErrorCodeNotFound},
+		{name: "inactive user", userID: "inactive", wantCode: entity2.ErrorCodeInvalidInput},
+		{name: "author", userID: "author", wantCode: entity2.ErrorCodeInvalidInput},
+		{name: "already assigned", userID: "b1", wantCode: entity2.ErrorCodeAlreadyAssigned},
+		{name: "excluded by author", userID: "b2", exclusion: &entity2.ReviewerExclusion{UserID: "author", ExcludedUserID: "b2"}, wantCode: entity2.ErrorCodeInvalidInput},
+		{name: "author excluded by user", userID: "b2", exclusion: &entity2.ReviewerExclusion{UserID: "b2", ExcludedUserID: "author"}, wantCode: entity2.ErrorCodeInvalidInput},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inactive := member("inactive", "backend")
+			inactive.IsActive = false
+			repo := newFakeRepo(member("author", "backend"), member("b1", "backend"), member("b2", "backend"), member("o1", "ops"), inactive)
+			if tt.exclusion != nil {
+				repo.exclusions = []*entity2.ReviewerExclusion{tt.exclusion}
+			}
+			pr := &entity2.PullRequest{PullRequestID: "pr-1", AuthorID: "author", Status: entity2.PullRequestStatusOpen, AssignedReviewers: []string{"b1"}}
+
+			err := newTestPullRequestUseCase(repo, 1).checkManualReviewer(context.Background(), pr, tt.userID)
+			if tt.wantCode == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if !isDomainError(err, tt.wantCode) {
+				t.Fatalf("error %v, want %s", err, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestReassignReviewerToExplicitUser(t *testing.T) {
+	tests := []struct {
+		name      string
+		newUserID string
+		wantCode  entity2.ErrorCode
+	}{
+		{name: "available user", newUserID: "b3"},
+		{name: "user who declined this PR", newUserID: "b2", wantCode: entity2.ErrorCodeInvalidInput},
+		{name: "already assigned", newUserID: "b4", wantCode: entity2.ErrorCodeAlreadyAssigned},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeRepo(member("author", "backend"), member("b1", "backend"), member("b2", "backend"), member("b3", "backend"), member("b4", "backend"))
+			repo.addPullRequest(&entity2.PullRequest{
+				PullRequestID: "pr-1", AuthorID: "author", Status: entity2.PullRequestStatusOpen, AssignedReviewers: []string{"b1", "b4"},
+				Declines: []entity2.ReviewDecline{{UserID: "b2", Reason: "no context"}},
+			})
+
+			_, replacedBy, err := newTestPullRequestUseCase(repo, 1).ReassignReviewer(context.Background(), "pr-1", "b1", tt.newUserID)
+			if tt.wantCode != "" {
+				if !isDomainError(err, tt.wantCode) {
+					t.Fatalf("error %v, want %s", err, tt.wantCode)
+				}
+				if reviewers := repo.prs["pr-1"].AssignedReviewers; !slices.Equal(reviewers, []string{"b1", "b4"}) {
+					t.Fatalf("reviewers changed to %v", reviewers)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("reassign: %v", err)
+			}
+			if replacedBy != tt.newUserID {
+				t.Fatalf("replaced by %s, want %s", replacedBy, tt.newUserID)
+			}
+		})
+	}
+}
+
+func TestDeclineReviewSavesReplacementDeclineAndEventTogether(t *testing.T) {
+	repo := newFakeRepo(member("author", "backend"), member("b1", "backend"), member("b2", "backend"), member("b3", "backend"))
+	repo.addPullRequest(&entity2.PullRequest{
+		PullRequestID: "pr-1", AuthorID: "author", Status: entity2.PullRequestStatusOpen, AssignedReviewers: []string{"b1", "b2"},
+	})
+
+	pr, replacedBy, err := newTestPullRequestUseCase(repo, 1).DeclineReview(context.Background(), "pr-1", "b1", " no context ")
+	if err != nil {
+		t.Fatalf("decline: %v", err)
+	}
+	if replacedBy != "b3" || !slices.Equal(pr.AssignedReviewers, []string{"b2", "b3"}) {
+		t.Fatalf("replaced by %s, reviewers %v", replacedBy, pr.AssignedReviewers)
+	}
+
+	saved := repo.prs["pr-1"]
+	if !slices.Equal(saved.AssignedReviewers, []string{"b2", "b3"}) {
+		t.Fatalf("saved reviewers %v", saved.AssignedReviewers)
+	}
+	if len(saved.Declines) != 1 || saved.Declines[0].UserID != "b1" || saved.Declines[0].Reason != "no context" {
+		t.Fatalf("saved declines %+v", saved.Declines)
+	}
+	if len(repo.events) != 1 || repo.events[0].Type != entity2.EventReviewerReassigned {
+		t.Fatalf("events %+v, want one reviewer.reassigned", repo.events)
+	}
+	data := repo.events[0].Data
+	if data["old_user_id"] != "b1" || data["new_user_id"] != "b3" || data["reason"] != "no context" {
+		t.Fatalf("event data %v", data)
+	}
+}
+
+func TestDeclineReviewWithoutCandidateKeepsReviewer(t *testing.T) {
+	repo := newFakeRepo(member("author", "backend"), member("b1", "backend"), member("b2", "backend"))
+	repo.addPullRequest(&entity2.PullRequest{
+		PullRequestID: "pr-1", AuthorID: "author", Status: entity2.PullRequestStatusOpen, AssignedReviewers: []string{"b1", "b2"},
+	})
+
+	_, _, err := newTestPullRequestUseCase(repo, 1).DeclineReview(context.Background(), "pr-1", "b1", "overloaded")
+	if !isDomainError(err, entity2.ErrorCodeNoCandidate) {
+		t.Fatalf("error %v, want NO_CANDIDATE", err)
+	}
+	saved := repo.prs["pr-1"]
+	if !slices.Equal(saved.AssignedReviewers, []string{"b1", "b2"}) || len(saved.Declines) != 0 || len(repo.events) != 0 {
+		t.Fatalf("nothing must be saved: reviewers %v, declines %+v, events %d", saved.AssignedReviewers, saved.Declines, len(repo.events))
+	}
+}
+
+func TestRemoveReviewerSavesEvent(t *testing.T) {
+	repo := newFakeRepo(member("author", "backend"), member("b1", "backend"), member("b2", "backend"))
+	repo.addPullRequest(&entity2.PullRequest{
+		PullRequestID: "pr-1", AuthorID: "author", Status: entity2.PullRequestStatusOpen, AssignedReviewers: []string{"b1", "b2"},
+	})
+
+	pr, err := newTestPullRequestUseCase(repo, 1).RemoveReviewer(context.Background(), "pr-1", "b1")
+	if err != nil {
+		t.Fatalf("remove: %v", err)
+	}
+	if !slices.Equal(pr.AssignedReviewers, []string{"b2"}) || !slices.Equal(repo.prs["pr-1"].AssignedReviewers, []string{"b2"}) {
+		t.Fatalf("reviewers %v, saved %v", pr.AssignedReviewers, repo.prs["pr-1"].AssignedReviewers)
+	}
+	if len(repo.events) != 1 || repo.events[0].Type != entity2.EventReviewerRemoved {
+		t.Fatalf("events %+v, want one reviewer.removed", repo.events)
+	}
+	if data := repo.events[0].Data; data["pull_request_id"] != "pr-1" || data["user_id"] != "b1" {
+		t.Fatalf("event data %v", data)
+	}
+}
+
+// assignmentsWithSeed создает три PR и переназначает первого ревьювера первого PR с источником seed;
+// возвращает ревьюверов каждого PR и выбранную замену
+func assignmentsWithSeed(t *testing.T, seed int64) ([][]string, string) {
+	t.Helper()
+	repo := newFakeRepo(member("author", "backend"), member("b1", "backend"), member("b2", "backend"), member("b3", "backend"), member("b4", "backend"), member("b5", "backend"))
+	uc := newTestPullRequestUseCase(repo, seed)
+
+	var reviewers [][]string
+	for i := 1; i <= 3; i++ {
+		pr, _, err := uc.CreatePullRequest(context.Background(), entity2.CreatePullRequestInput{
+			PullRequestID: fmt.Sprintf("pr-%d", i), PullRequestName: "Feature", AuthorID: "author",
+		})
+		if err != nil {
+			t.Fatalf("seed %d: create: %v", seed, err)
+		}
+		reviewers = append(reviewers, pr.AssignedReviewers)
+	}
+
+	_, replacedBy, err := uc.ReassignReviewer(context.Background(), "pr-1", reviewers[0][0], "")
+	if err != nil {
+		t.Fatalf("seed %d: reassign: %v", seed, err)
+	}
+	return reviewers, replacedBy
+}
+
+func TestAssignmentsWithFixedSeed(t *testing.T) {
+	tests := []struct {
+		seed           int64
+		wantReviewers  [][]string
+		wantReplacedBy string
+	}{
+		{seed: 1, wantReviewers: [][]string{{"b3", "b1"}, {"b2", "b4"}, {"b5", "b3"}}, wantReplacedBy: "b4"},
+		{seed: 7, wantReviewers: [][]string{{"b3", "b2"}, {"b3", "b5"}, {"b4", "b5"}}, wantReplacedBy: "b5"},
+		{seed: 42, wantReviewers: [][]string{{"b3", "b4"}, {"b4", "b5"}, {"b5", "b1"}}, wantReplacedBy: "b5"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("seed %d", tt.seed), func(t *testing.T) {
+			reviewers, replacedBy := assignmentsWithSeed(t, tt.seed)
+			if !slices.EqualFunc(reviewers, tt.wantReviewers, slices.Equal) || replacedBy != tt.wantReplacedBy {
+				t.Fatalf("got %v and replacement %s, want %v and %s", reviewers, replacedBy, tt.wantReviewers, tt.wantReplacedBy)
+			}
+
+			// Тот же seed повторяет назначения
+			again, againReplacedBy := assignmentsWithSeed(t, tt.seed)
+			if !slices.EqualFunc(again, reviewers, slices.Equal) || againReplacedBy != replacedBy {
+				t.Fatalf("second run gave %v and %s", again, againReplacedBy)
+			}
+		})
+	}
+}
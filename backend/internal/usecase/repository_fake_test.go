@@ -0,0 +1,376 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	entity2 "test_task_avito/backend/internal/entity"
+	port2 "test_task_avito/backend/internal/port"
+	"time"
+)
+
+// fakeRepo хранилище в памяти для тестов use case'ов. Методы, которые тесты не используют,
+// достаются встроенным nil-интерфейсам и паникуют при вызове.
+type fakeRepo struct {
+	port2.UserRepository
+	port2.TeamRepository
+	port2.PullRequestRepository
+
+	users      []*entity2.User
+	settings   map[string]*entity2.TeamSettings
+	holidays   map[string][]entity2.TeamHoliday
+	skills     map[string][]string
+	codeowners map[string]string
+	exclusions []*entity2.ReviewerExclusion
+	// openReviews количество открытых ревью сверх PR из prs
+	openReviews map[string]int
+	// recentPairs ревьюверы последних PR автора: автор → ревьювер → количество
+	recentPairs map[string]map[string]int
+	prs         map[string]*entity2.PullRequest
+	escalations map[string]*entity2.ReviewEscalation
+	events      []*entity2.Event
+	// participantQueries количество вызовов GetPullRequestParticipants
+	participantQueries int
+	availabilities     []*entity2.UserAvailability
+	// processedAvailabilities окна, открытые ревью которых уже переназначены
+	processedAvailabilities map[int64]bool
+}
+
+func newFakeRepo(users ...*entity2.User) *fakeRepo {
+	return &fakeRepo{
+		users:       users,
+		settings:    make(map[string]*entity2.TeamSettings),
+		holidays:    make(map[string][]entity2.TeamHoliday),
+		skills:      make(map[string][]string),
+		codeowners:  make(map[string]string),
+		openReviews: make(map[string]int),
+		recentPairs: make(map[string]map[string]int),
+		prs:         make(map[string]*entity2.PullRequest),
+		escalations: make(map[string]*entity2.ReviewEscalation),
+
+		processedAvailabilities: make(map[int64]bool),
+	}
+}
+
+// member создает активного участника команды уровня middle
+func member(userID, teamName string) *entity2.User {
+	return &entity2.User{UserID: userID, Username: userID, TeamName: teamName, IsActive: true, ReviewWeight: 1, Level: entity2.UserLevelMiddle}
+}
+
+// teamSettings возвращает настройки команды для изменения в тесте
+func (r *fakeRepo) teamSettings(teamName string) *entity2.TeamSettings {
+	settings, ok := r.settings[teamName]
+	if !ok {
+		settings = &entity2.TeamSettings{TeamName: teamName, SelectionMode: entity2.SelectionModeRandom}
+		r.settings[teamName] = settings
+	}
+	return settings
+}
+
+func (r *fakeRepo) addPullRequest(pr *entity2.PullRequest) {
+	r.prs[pr.PullRequestID] = pr
+}
+
+func notFound(message string) error {
+	return entity2.NewDomainError(entity2.ErrorCodeNotFound, message)
+}
+
+func (r *fakeRepo) GetUser(_ context.Context, userID string) (*entity2.User, error) {
+	for _, user := range r.users {
+		if user.UserID == userID {
+			copied := *user
+			return &copied, nil
+		}
+	}
+	return nil, notFound("user not found")
+}
+
+func (r *fakeRepo) GetActiveUsersByTeam(_ context.Context, teamName string, excludeUserID string) ([]*entity2.User, error) {
+	var users []*entity2.User
+	for _, user := range r.users {
+		if user.IsActive && user.TeamName == teamName && user.UserID != excludeUserID {
+			users = append(users, user)
+		}
+	}
+	return users, nil
+}
+
+func (r *fakeRepo) GetAllActiveUsers(_ context.Context, excludeIDs []string) ([]*entity2.User, error) {
+	excluded := make(map[string]bool, len(excludeIDs))
+	for _, id := range excludeIDs {
+		excluded[id] = true
+	}
+	var users []*entity2.User
+	for _, user := range r.users {
+		if user.IsActive && !excluded[user.UserID] {
+			users = append(users, user)
+		}
+	}
+	return users, nil
+}
+
+func (r *fakeRepo) GetAllUsers(_ context.Context) ([]*entity2.User, error) {
+	return r.users, nil
+}
+
+func (r *fakeRepo) GetUserSkills(_ context.Context, userIDs []string) (map[string][]string, error) {
+	skills := make(map[string][]string, len(userIDs))
+	for _, id := range userIDs {
+		if tags, ok := r.skills[id]; ok {
+			skills[id] = tags
+		}
+	}
+	return skills, nil
+}
+
+func (r *fakeRepo) GetReviewerExclusions(_ context.Context, userID string) ([]*entity2.ReviewerExclusion, error) {
+	var exclusions []*entity2.ReviewerExclusion
+	for _, exclusion := range r.exclusions {
+		switch userID {
+		case exclusion.UserID:
+			exclusions = append(exclusions, exclusion)
+		case exclusion.ExcludedUserID:
+			exclusions = append(exclusions, &entity2.ReviewerExclusion{
+				UserID:         exclusion.ExcludedUserID,
+				ExcludedUserID: exclusion.UserID,
+				Reason:         exclusion.Reason,
+				CreatedAt:      exclusion.CreatedAt,
+			})
+		}
+	}
+	return exclusions, nil
+}
+
+func (r *fakeRepo) CreateUserAvailability(_ context.Context, availability *entity2.UserAvailability) error {
+	availability.ID = int64(len(r.availabilities) + 1)
+	copied := *availability
+	r.availabilities = append(r.availabilities, &copied)
+	return nil
+}
+
+// UpsertUserAvailability обновляет окно с тем же external_id и сбрасывает отметку при изменении периода
+func (r *fakeRepo) UpsertUserAvailability(ctx context.Context, availability *entity2.UserAvailability) error {
+	for _, existing := range r.availabilities {
+		if existing.UserID != availability.UserID || existing.ExternalID != availability.ExternalID {
+			continue
+		}
+		if !existing.StartsAt.Equal(availability.StartsAt) || !existing.EndsAt.Equal(availability.EndsAt) {
+			delete(r.processedAvailabilities, existing.ID)
+		}
+		availability.ID = existing.ID
+		*existing = *availability
+		return nil
+	}
+	return r.CreateUserAvailability(ctx, availability)
+}
+
+func (r *fakeRepo) GetStartedAvailabilities(_ context.Context, at time.Time) ([]*entity2.UserAvailability, error) {
+	var availabilities []*entity2.UserAvailability
+	for _, availability := range r.availabilities {
+		if !r.processedAvailabilities[availability.ID] && availability.ActiveAt(at) {
+			copied := *availability
+			availabilities = append(availabilities, &copied)
+		}
+	}
+	return availabilities, nil
+}
+
+func (r *fakeRepo) MarkAvailabilityProcessed(_ context.Context, availabilityID int64, _ time.Time) error {
+	r.processedAvailabilities[availabilityID] = true
+	return nil
+}
+
+func (r *fakeRepo) TeamExists(_ context.Context, teamName string) (bool, error) {
+	for _, user := range r.users {
+		if user.TeamName == teamName {
+			return true, nil
+		}
+	}
+	_, ok := r.settings[teamName]
+	return ok, nil
+}
+
+func (r *fakeRepo) GetTeamSettings(ctx context.Context, teamName string) (*entity2.TeamSettings, error) {
+	exists, _ := r.TeamExists(ctx, teamName)
+	if !exists {
+		return nil, notFound("team not found")
+	}
+	copied := *r.teamSettings(teamName)
+	return &copied, nil
+}
+
+func (r *fakeRepo) GetTeamHolidays(_ context.Context, teamName string) ([]entity2.TeamHoliday, error) {
+	return r.holidays[teamName], nil
+}
+
+func (r *fakeRepo) GetTeamCodeowners(_ context.Context, teamName string) (string, error) {
+	return r.codeowners[teamName], nil
+}
+
+func (r *fakeRepo) PRExists(_ context.Context, prID string) (bool, error) {
+	_, ok := r.prs[prID]
+	return ok, nil
+}
+
+func (r *fakeRepo) GetPullRequest(_ context.Context, prID string) (*entity2.PullRequest, error) {
+	pr, ok := r.prs[prID]
+	if !ok {
+		return nil, notFound("PR not found")
+	}
+	copied := *pr
+	copied.AssignedReviewers = append([]string(nil), pr.AssignedReviewers...)
+	copied.Declines = append([]entity2.ReviewDecline(nil), pr.Declines...)
+	return &copied, nil
+}
+
+func (r *fakeRepo) GetPullRequestParticipants(ctx context.Context, prIDs []string) (map[string]*entity2.PullRequest, error) {
+	r.participantQueries++
+	prs := make(map[string]*entity2.PullRequest, len(prIDs))
+	for _, id := range prIDs {
+		if pr, err := r.GetPullRequest(ctx, id); err == nil {
+			prs[id] = pr
+		}
+	}
+	return prs, nil
+}
+
+func (r *fakeRepo) GetPullRequestsByReviewer(ctx context.Context, userID string) ([]*entity2.PullRequest, error) {
+	var prs []*entity2.PullRequest
+	for id, pr := range r.prs {
+		if slices.Contains(pr.AssignedReviewers, userID) {
+			copied, _ := r.GetPullRequest(ctx, id)
+			prs = append(prs, copied)
+		}
+	}
+	slices.SortFunc(prs, func(a, b *entity2.PullRequest) int {
+		return strings.Compare(a.PullRequestID, b.PullRequestID)
+	})
+	return prs, nil
+}
+
+func (r *fakeRepo) CreatePullRequest(_ context.Context, pr *entity2.PullRequest, events ...*entity2.Event) error {
+	copied := *pr
+	copied.AssignedReviewers = append([]string(nil), pr.AssignedReviewers...)
+	r.prs[pr.PullRequestID] = &copied
+	r.events = append(r.events, events...)
+	return nil
+}
+
+func (r *fakeRepo) UpdatePullRequestReviewers(_ context.Context, prID string, reviewers []string, events ...*entity2.Event) error {
+	pr, ok := r.prs[prID]
+	if !ok {
+		return notFound("PR not found")
+	}
+	pr.AssignedReviewers = append([]string(nil), reviewers...)
+	r.events = append(r.events, events...)
+	return nil
+}
+
+func (r *fakeRepo) SaveReviewDecline(ctx context.Context, prID string, reviewers []string, decline *entity2.ReviewDecline, events ...*entity2.Event) error {
+	if err := r.UpdatePullRequestReviewers(ctx, prID, reviewers, events...); err != nil {
+		return err
+	}
+	pr := r.prs[prID]
+	pr.Declines = slices.DeleteFunc(pr.Declines, func(previous entity2.ReviewDecline) bool {
+		return previous.UserID == decline.UserID
+	})
+	decline.DeclinedAt = time.Now()
+	pr.Declines = append(pr.Declines, *decline)
+	return nil
+}
+
+func (r *fakeRepo) GetOpenReviewCounts(_ context.Context, userIDs []string) (map[string]int, error) {
+	counts := make(map[string]int, len(userIDs))
+	for _, id := range userIDs {
+		counts[id] = r.openReviews[id]
+	}
+	for _, pr := range r.prs {
+		if pr.Status != entity2.PullRequestStatusOpen {
+			continue
+		}
+		for _, reviewerID := range pr.AssignedReviewers {
+			if _, ok := counts[reviewerID]; ok {
+				counts[reviewerID]++
+			}
+		}
+	}
+	return counts, nil
+}
+
+func (r *fakeRepo) GetRecentReviewerCounts(_ context.Context, authorID string, _ int) (map[string]int, error) {
+	return r.recentPairs[authorID], nil
+}
+
+// GetOpenReviewAssignments возвращает назначения открытых PR; моментом назначения считается создание PR
+func (r *fakeRepo) GetOpenReviewAssignments(ctx context.Context) ([]*entity2.ReviewAssignment, error) {
+	return r.reviewAssignments(ctx, func(pr *entity2.PullRequest, _ string) bool {
+		return pr.Status == entity2.PullRequestStatusOpen
+	}), nil
+}
+
+func (r *fakeRepo) GetReviewAssignmentsByReviewer(ctx context.Context, userID string) ([]*entity2.ReviewAssignment, error) {
+	return r.reviewAssignments(ctx, func(_ *entity2.PullRequest, reviewerID string) bool {
+		return reviewerID == userID
+	}), nil
+}
+
+// reviewAssignments возвращает назначения, отобранные keep, в порядке PR
+func (r *fakeRepo) reviewAssignments(ctx context.Context, keep func(pr *entity2.PullRequest, reviewerID string) bool) []*entity2.ReviewAssignment {
+	ids := make([]string, 0, len(r.prs))
+	for id := range r.prs {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	var assignments []*entity2.ReviewAssignment
+	for _, id := range ids {
+		pr := r.prs[id]
+		teamName := pr.TeamName
+		if author, err := r.GetUser(ctx, pr.AuthorID); err == nil && teamName == "" {
+			teamName = author.TeamName
+		}
+		for _, reviewerID := range pr.AssignedReviewers {
+			if !keep(pr, reviewerID) {
+				continue
+			}
+			assignment := &entity2.ReviewAssignment{
+				PullRequestID:   pr.PullRequestID,
+				PullRequestName: pr.PullRequestName,
+				AuthorID:        pr.AuthorID,
+				TeamName:        teamName,
+				Status:          pr.Status,
+				ReviewerID:      reviewerID,
+				Escalated:       r.escalations[pr.PullRequestID] != nil && r.escalations[pr.PullRequestID].UserID == reviewerID,
+			}
+			if pr.CreatedAt != nil {
+				assignment.AssignedAt = *pr.CreatedAt
+			}
+			assignments = append(assignments, assignment)
+		}
+	}
+	return assignments
+}
+
+func (r *fakeRepo) GetOpenPullRequestsWithoutEscalation(ctx context.Context) ([]*entity2.PullRequest, error) {
+	var prs []*entity2.PullRequest
+	for id, pr := range r.prs {
+		if pr.Status == entity2.PullRequestStatusOpen && r.escalations[id] == nil {
+			copied, _ := r.GetPullRequest(ctx, id)
+			prs = append(prs, copied)
+		}
+	}
+	return prs, nil
+}
+
+func (r *fakeRepo) SaveReviewEscalation(ctx context.Context, prID string, reviewers []string, escalation *entity2.ReviewEscalation, events ...*entity2.Event) error {
+	if r.escalations[prID] != nil {
+		return errors.New("duplicate escalation")
+	}
+	if err := r.UpdatePullRequestReviewers(ctx, prID, reviewers, events...); err != nil {
+		return err
+	}
+	escalation.EscalatedAt = time.Now()
+	r.escalations[prID] = escalation
+	return nil
+}
@@ -0,0 +1,378 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	entity2 "test_task_avito/backend/internal/entity"
+	port2 "test_task_avito/backend/internal/port"
+	"time"
+)
+
+// reviewerSelector общие правила отбора ревьюверов для создания PR, переназначения и деактивации команды
+type reviewerSelector struct {
+	userRepo port2.UserRepository
+	teamRepo port2.TeamRepository
+	prRepo   port2.PullRequestRepository
+	rnd      port2.RandomSource
+}
+
+func newReviewerSelector(userRepo port2.UserRepository, teamRepo port2.TeamRepository, prRepo port2.PullRequestRepository, rnd port2.RandomSource) *reviewerSelector {
+	return &reviewerSelector{
+		userRepo: userRepo,
+		teamRepo: teamRepo,
+		prRepo:   prRepo,
+		rnd:      rnd,
+	}
+}
+
+// eligible оставляет кандидатов, которым можно назначить еще одно ревью PR автора author (nil — автор
+// неизвестен), и возвращает причины отказа остальным
+func (s *reviewerSelector) eligible(ctx context.Context, author *entity2.User, candidates []*entity2.User) ([]*entity2.User, []entity2.CandidateRejection, error) {
+	if len(candidates) == 0 {
+		return nil, nil, nil
+	}
+
+	excluded, err := s.excludedReviewers(ctx, author)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	userIDs := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		userIDs = append(userIDs, candidate.UserID)
+	}
+
+	openReviews, err := s.prRepo.GetOpenReviewCounts(ctx, userIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settingsByTeam := make(map[string]*entity2.TeamSettings)
+	eligible := make([]*entity2.User, 0, len(candidates))
+	var rejections []entity2.CandidateRejection
+
+	for _, candidate := range candidates {
+		if exclusion, ok := excluded[candidate.UserID]; ok {
+			reason := fmt.Sprintf("excluded for author %s", author.UserID)
+			if exclusion.Reason != "" {
+				reason += ": " + exclusion.Reason
+			}
+			rejections = append(rejections, entity2.CandidateRejection{
+				UserID: candidate.UserID,
+				Reason: reason,
+			})
+			continue
+		}
+
+		settings, err := s.teamSettings(ctx, candidate.TeamName, settingsByTeam)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		limit := maxOpenReviews(candidate, settings)
+		if limit > 0 && openReviews[candidate.UserID] >= limit {
+			rejections = append(rejections, entity2.CandidateRejection{
+				UserID: candidate.UserID,
+				Reason: fmt.Sprintf("at capacity (%d/%d open reviews)", openReviews[candidate.UserID], limit),
+			})
+			continue
+		}
+
+		eligible = append(eligible, candidate)
+	}
+
+	return eligible, rejections, nil
+}
+
+// pick выбирает до count ревьюверов из подходящих кандидатов в режиме выбора команды teamName.
+// Если известен автор PR (author не nil), шанс кандидата, назначенного на k из его последних PR,
+// делится на 1+k, чтобы PR автора со временем распределялись по всей команде.
+func (s *reviewerSelector) pick(ctx context.Context, teamName string, author *entity2.User, candidates []*entity2.User, count int) ([]*entity2.User, error) {
+	if len(candidates) == 0 || count <= 0 {
+		return []*entity2.User{}, nil
+	}
+
+	settings, err := s.teamRepo.GetTeamSettings(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	recentPairs, err := s.recentPairs(ctx, author)
+	if err != nil {
+		return nil, err
+	}
+
+	if count > len(candidates) {
+		count = len(candidates)
+	}
+
+	// Ревьюверы, у которых сейчас рабочее время, выбираются первыми; остальные добирают недостающие места
+	if settings.PreferWorkingHours {
+		working, offHours, err := s.splitByWorkingTime(ctx, candidates, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		if len(working) > 0 && len(offHours) > 0 {
+			picked := s.pickFrom(settings, recentPairs, working, min(count, len(working)))
+			if len(picked) < count {
+				picked = append(picked, s.pickFrom(settings, recentPairs, offHours, count-len(picked))...)
+			}
+			return picked, nil
+		}
+	}
+
+	return s.pickFrom(settings, recentPairs, candidates, count), nil
+}
+
+// pickFrom выбирает count кандидатов в режиме выбора команды со штрафом за повторные пары
+func (s *reviewerSelector) pickFrom(settings *entity2.TeamSettings, recentPairs map[string]int, candidates []*entity2.User, count int) []*entity2.User {
+	if settings.SelectionMode != entity2.SelectionModeWeighted && len(recentPairs) == 0 {
+		return pickRandom(s.rnd, candidates, count)
+	}
+
+	return pickWeighted(s.rnd, candidates, count, func(candidate *entity2.User) float64 {
+		weight := 1.0
+		if settings.SelectionMode == entity2.SelectionModeWeighted && candidate.ReviewWeight > 0 {
+			weight = candidate.ReviewWeight
+		}
+		return weight / float64(1+recentPairs[candidate.UserID])
+	})
+}
+
+// splitByWorkingTime делит кандидатов на тех, у кого в момент now рабочее время, и остальных
+func (s *reviewerSelector) splitByWorkingTime(ctx context.Context, candidates []*entity2.User, now time.Time) ([]*entity2.User, []*entity2.User, error) {
+	calendar := newWorkCalendar(s.userRepo, s.teamRepo)
+	var working, offHours []*entity2.User
+	for _, candidate := range candidates {
+		ok, err := calendar.isWorking(ctx, candidate, now)
+		if err != nil {
+			return nil, nil, err
+		}
+		if ok {
+			working = append(working, candidate)
+		} else {
+			offHours = append(offHours, candidate)
+		}
+	}
+	return working, offHours, nil
+}
+
+// recentPairs возвращает, сколько раз каждый ревьювер назначался на последние PR автора
+// в пределах окна pair_history_window команды автора
+func (s *reviewerSelector) recentPairs(ctx context.Context, author *entity2.User) (map[string]int, error) {
+	if author == nil {
+		return nil, nil
+	}
+
+	settings, err := s.teamRepo.GetTeamSettings(ctx, author.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	if settings.PairHistoryWindow == 0 {
+		return nil, nil
+	}
+
+	return s.prRepo.GetRecentReviewerCounts(ctx, author.UserID, settings.PairHistoryWindow)
+}
+
+// pickPreferred выбирает до count ревьюверов сначала из preferred, недостающих — из fallback
+func (s *reviewerSelector) pickPreferred(ctx context.Context, teamName string, author *entity2.User, preferred, fallback []*entity2.User, count int) ([]*entity2.User, error) {
+	picked, err := s.pick(ctx, teamName, author, preferred, count)
+	if err != nil {
+		return nil, err
+	}
+	if len(picked) >= count {
+		return picked, nil
+	}
+
+	rest := excludeUsers(fallback, picked)
+	more, err := s.pick(ctx, teamName, author, rest, count-len(picked))
+	if err != nil {
+		return nil, err
+	}
+
+	return append(picked, more...), nil
+}
+
+// coverTags жадно выбирает до count ревьюверов, покрывающих требуемые навыки: на каждом шаге берется
+// кандидат, закрывающий больше всего еще не покрытых навыков (равных по покрытию выбирает pick).
+// Возвращает выбранных кандидатов и навыки, оставшиеся непокрытыми, в исходном порядке.
+func (s *reviewerSelector) coverTags(ctx context.Context, teamName string, author *entity2.User, candidates []*entity2.User, tags []string, count int) ([]*entity2.User, []string, error) {
+	if len(tags) == 0 || len(candidates) == 0 || count <= 0 {
+		return nil, tags, nil
+	}
+
+	userIDs := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		userIDs = append(userIDs, candidate.UserID)
+	}
+	skills, err := s.userRepo.GetUserSkills(ctx, userIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	uncovered := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		uncovered[tag] = struct{}{}
+	}
+
+	var picked []*entity2.User
+	remaining := candidates
+	for len(picked) < count && len(uncovered) > 0 {
+		best := 0
+		var tied []*entity2.User
+		for _, candidate := range remaining {
+			covered := 0
+			for _, tag := range skills[candidate.UserID] {
+				if _, ok := uncovered[tag]; ok {
+					covered++
+				}
+			}
+			switch {
+			case covered == 0 || covered < best:
+				continue
+			case covered > best:
+				best = covered
+				tied = tied[:0]
+			}
+			tied = append(tied, candidate)
+		}
+		if best == 0 {
+			break
+		}
+
+		chosen, err := s.pick(ctx, teamName, author, tied, 1)
+		if err != nil {
+			return nil, nil, err
+		}
+		picked = append(picked, chosen[0])
+		remaining = excludeUsers(remaining, chosen)
+		for _, tag := range skills[chosen[0].UserID] {
+			delete(uncovered, tag)
+		}
+	}
+
+	left := make([]string, 0, len(uncovered))
+	for _, tag := range tags {
+		if _, ok := uncovered[tag]; ok {
+			left = append(left, tag)
+		}
+	}
+
+	return picked, left, nil
+}
+
+// excludedReviewers возвращает запреты взаимного ревью автора по ID второго пользователя
+func (s *reviewerSelector) excludedReviewers(ctx context.Context, author *entity2.User) (map[string]*entity2.ReviewerExclusion, error) {
+	if author == nil {
+		return nil, nil
+	}
+
+	exclusions, err := s.userRepo.GetReviewerExclusions(ctx, author.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	excluded := make(map[string]*entity2.ReviewerExclusion, len(exclusions))
+	for _, exclusion := range exclusions {
+		excluded[exclusion.ExcludedUserID] = exclusion
+	}
+	return excluded, nil
+}
+
+func (s *reviewerSelector) teamSettings(ctx context.Context, teamName string, cache map[string]*entity2.TeamSettings) (*entity2.TeamSettings, error) {
+	if settings, ok := cache[teamName]; ok {
+		return settings, nil
+	}
+	settings, err := s.teamRepo.GetTeamSettings(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	cache[teamName] = settings
+	return settings, nil
+}
+
+// maxOpenReviews возвращает действующий лимит открытых ревью пользователя (0 — без ограничения)
+func maxOpenReviews(user *entity2.User, settings *entity2.TeamSettings) int {
+	if user.MaxOpenReviews != nil {
+		return *user.MaxOpenReviews
+	}
+	return settings.DefaultMaxOpenReviews
+}
+
+// pickRandom равновероятно выбирает count кандидатов
+func pickRandom(rnd port2.RandomSource, candidates []*entity2.User, count int) []*entity2.User {
+	shuffled := make([]*entity2.User, len(candidates))
+	copy(shuffled, candidates)
+	rnd.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+	return shuffled[:count]
+}
+
+// pickWeighted выбирает до count кандидатов без повторов с вероятностью, пропорциональной весу
+// (алгоритм Efraimidis–Spirakis: ключ u^(1/w), берутся кандидаты с наибольшими ключами).
+// Кандидаты с неположительным весом не выбираются.
+func pickWeighted(rnd port2.RandomSource, candidates []*entity2.User, count int, weightOf func(*entity2.User) float64) []*entity2.User {
+	type keyedCandidate struct {
+		user *entity2.User
+		key  float64
+	}
+
+	keyed := make([]keyedCandidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		weight := weightOf(candidate)
+		if !(weight > 0) {
+			continue
+		}
+		keyed = append(keyed, keyedCandidate{
+			user: candidate,
+			key:  math.Pow(rnd.Float64(), 1/weight),
+		})
+	}
+
+	sort.Slice(keyed, func(i, j int) bool {
+		return keyed[i].key > keyed[j].key
+	})
+
+	count = min(count, len(keyed))
+	picked := make([]*entity2.User, 0, count)
+	for _, candidate := range keyed[:count] {
+		picked = append(picked, candidate.user)
+	}
+	return picked
+}
+
+// excludeUsers возвращает пользователей из users, отсутствующих в exclude
+func excludeUsers(users, exclude []*entity2.User) []*entity2.User {
+	excluded := make(map[string]struct{}, len(exclude))
+	for _, user := range exclude {
+		excluded[user.UserID] = struct{}{}
+	}
+
+	rest := make([]*entity2.User, 0, len(users))
+	for _, user := range users {
+		if _, ok := excluded[user.UserID]; !ok {
+			rest = append(rest, user)
+		}
+	}
+	return rest
+}
+
+// filterByLevel возвращает пользователей не ниже уровня level
+func filterByLevel(users []*entity2.User, level entity2.UserLevel) []*entity2.User {
+	qualified := make([]*entity2.User, 0, len(users))
+	for _, user := range users {
+		if user.Level.AtLeast(level) {
+			qualified = append(qualified, user)
+		}
+	}
+	return qualified
+}
+
+// hasLevel проверяет, есть ли среди пользователей хотя бы один не ниже уровня level
+func hasLevel(users []*entity2.User, level entity2.UserLevel) bool {
+	return len(filterByLevel(users, level)) > 0
+}
@@ -0,0 +1,385 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"strings"
+	entity2 "test_task_avito/backend/internal/entity"
+	"test_task_avito/backend/pkg/random"
+	"testing"
+	"time"
+)
+
+// newTestSelector создает отбор ревьюверов над fakeRepo с фиксированным seed
+func newTestSelector(repo *fakeRepo) *reviewerSelector {
+	return newReviewerSelector(repo, repo, repo, random.New(1))
+}
+
+// userIDs возвращает ID пользователей в исходном порядке
+func userIDs(users []*entity2.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, user := range users {
+		ids = append(ids, user.UserID)
+	}
+	return ids
+}
+
+func intPtr(value int) *int {
+	return &value
+}
+
+func TestEligibleCapacity(t *testing.T) {
+	tests := []struct {
+		name         string
+		teamDefault  int
+		personal     *int
+		openReviews  int
+		wantEligible bool
+		wantReason   string
+	}{
+		{name: "no limit", teamDefault: 0, openReviews: 10, wantEligible: true},
+		{name: "below team default", teamDefault: 2, openReviews: 1, wantEligible: true},
+		{name: "at team default", teamDefault: 2, openReviews: 2, wantReason: "at capacity (2/2 open reviews)"},
+		{name: "personal limit above team default", teamDefault: 2, personal: intPtr(3), openReviews: 2, wantEligible: true},
+		{name: "personal limit below team default", teamDefault: 5, personal: intPtr(1), openReviews: 1, wantReason: "at capacity (1/1 open reviews)"},
+		{name: "personal zero disables team default", teamDefault: 1, personal: intPtr(0), openReviews: 4, wantEligible: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			author := member("author", "backend")
+			candidate := member("u1", "backend")
+			candidate.MaxOpenReviews = tt.personal
+			repo := newFakeRepo(author, candidate)
+			repo.teamSettings("backend").DefaultMaxOpenReviews = tt.teamDefault
+			repo.openReviews["u1"] = tt.openReviews
+
+			eligible, rejections, err := newTestSelector(repo).eligible(context.Background(), author, []*entity2.User{candidate})
+			if err != nil {
+				t.Fatalf("eligible: %v", err)
+			}
+			if got := len(eligible) == 1; got != tt.wantEligible {
+				t.Fatalf("eligible = %v, want %v (rejections %+v)", userIDs(eligible), tt.wantEligible, rejections)
+			}
+			if tt.wantReason != "" && (len(rejections) != 1 || rejections[0].Reason != tt.wantReason) {
+				t.Fatalf("rejections = %+v, want reason %q", rejections, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestEligibleUsesCandidateTeamLimit(t *testing.T) {
+	author := member("author", "backend")
+	own := member("b1", "backend")
+	other := member("p1", "platform")
+	repo := newFakeRepo(author, own, other)
+	repo.teamSettings("backend").DefaultMaxOpenReviews = 1
+	repo.teamSettings("platform").DefaultMaxOpenReviews = 3
+	repo.openReviews["b1"] = 1
+	repo.openReviews["p1"] = 1
+
+	eligible, rejections, err := newTestSelector(repo).eligible(context.Background(), author, []*entity2.User{own, other})
+	if err != nil {
+		t.Fatalf("eligible: %v", err)
+	}
+	if got := strings.Join(userIDs(eligible), ","); got != "p1" {
+		t.Fatalf("eligible = %s, want p1", got)
+	}
+	if len(rejections) != 1 || rejections[0].UserID != "b1" {
+		t.Fatalf("rejections = %+v, want b1", rejections)
+	}
+}
+
+func TestEligibleCountsOpenPullRequests(t *testing.T) {
+	author := member("author", "backend")
+	candidate := member("u1", "backend")
+	repo := newFakeRepo(author, candidate)
+	repo.teamSettings("backend").DefaultMaxOpenReviews = 1
+	repo.addPullRequest(&entity2.PullRequest{PullRequestID: "merged", AuthorID: "author", Status: entity2.PullRequestStatusMerged, AssignedReviewers: []string{"u1"}})
+
+	eligible, _, err := newTestSelector(repo).eligible(context.Background(), author, []*entity2.User{candidate})
+	if err != nil || len(eligible) != 1 {
+		t.Fatalf("merged PR must not count toward capacity: eligible %v, err %v", userIDs(eligible), err)
+	}
+
+	repo.addPullRequest(&entity2.PullRequest{PullRequestID: "open", AuthorID: "author", Status: entity2.PullRequestStatusOpen, AssignedReviewers: []string{"u1"}})
+	eligible, _, err = newTestSelector(repo).eligible(context.Background(), author, []*entity2.User{candidate})
+	if err != nil || len(eligible) != 0 {
+		t.Fatalf("open PR must count toward capacity: eligible %v, err %v", userIDs(eligible), err)
+	}
+}
+
+func TestEligibleExclusions(t *testing.T) {
+	tests := []struct {
+		name       string
+		exclusion  entity2.ReviewerExclusion
+		nilAuthor  bool
+		wantReason string
+	}{
+		{name: "excluded by author", exclusion: entity2.ReviewerExclusion{UserID: "author", ExcludedUserID: "u1"}, wantReason: "excluded for author author"},
+		{name: "author excluded by reviewer", exclusion: entity2.ReviewerExclusion{UserID: "u1", ExcludedUserID: "author"}, wantReason: "excluded for author author"},
+		{name: "reason is reported", exclusion: entity2.ReviewerExclusion{UserID: "author", ExcludedUserID: "u1", Reason: "conflict"}, wantReason: "excluded for author author: conflict"},
+		{name: "unrelated pair", exclusion: entity2.ReviewerExclusion{UserID: "u1", ExcludedUserID: "u2"}},
+		{name: "no author", exclusion: entity2.ReviewerExclusion{UserID: "author", ExcludedUserID: "u1"}, nilAuthor: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			author := member("author", "backend")
+			candidate := member("u1", "backend")
+			repo := newFakeRepo(author, candidate, member("u2", "backend"))
+			repo.exclusions = []*entity2.ReviewerExclusion{&tt.exclusion}
+			if tt.nilAuthor {
+				author = nil
+			}
+
+			eligible, rejections, err := newTestSelector(repo).eligible(context.Background(), author, []*entity2.User{candidate})
+			if err != nil {
+				t.Fatalf("eligible: %v", err)
+			}
+			if tt.wantReason == "" {
+				if len(eligible) != 1 || len(rejections) != 0 {
+					t.Fatalf("eligible = %v, rejections = %+v, want u1 eligible", userIDs(eligible), rejections)
+				}
+				return
+			}
+			if len(eligible) != 0 || len(rejections) != 1 || rejections[0].UserID != "u1" || rejections[0].Reason != tt.wantReason {
+				t.Fatalf("eligible = %v, rejections = %+v, want u1 rejected with %q", userIDs(eligible), rejections, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestPickWeightedFrequencyProportionalToWeight(t *testing.T) {
+	candidates := []*entity2.User{member("w1", "backend"), member("w2", "backend"), member("w3", "backend")}
+	weights := map[string]float64{"w1": 1, "w2": 2, "w3": 3}
+	weightOf := func(user *entity2.User) float64 { return weights[user.UserID] }
+
+	const draws = 30000
+	rnd := random.New(42)
+	counts := make(map[string]int)
+	for i := 0; i < draws; i++ {
+		picked := pickWeighted(rnd, candidates, 1, weightOf)
+		counts[picked[0].UserID]++
+	}
+
+	for id, weight := range weights {
+		want := weight / 6
+		got := float64(counts[id]) / draws
+		if got < want-0.01 || got > want+0.01 {
+			t.Errorf("%s picked with frequency %.3f, want %.3f±0.01", id, got, want)
+		}
+	}
+}
+
+func TestPickWeightedSkipsNonPositiveWeights(t *testing.T) {
+	candidates := []*entity2.User{member("zero", "backend"), member("negative", "backend"), member("positive", "backend")}
+	weights := map[string]float64{"zero": 0, "negative": -1, "positive": 0.5}
+	weightOf := func(user *entity2.User) float64 { return weights[user.UserID] }
+
+	rnd := random.New(7)
+	for i := 0; i < 1000; i++ {
+		picked := pickWeighted(rnd, candidates, 2, weightOf)
+		if got := strings.Join(userIDs(picked), ","); got != "positive" {
+			t.Fatalf("draw %d picked %s, want only positive", i, got)
+		}
+	}
+}
+
+func TestPickFromWeightedModeUsesReviewWeight(t *testing.T) {
+	light := member("light", "backend")
+	light.ReviewWeight = 0.5
+	heavy := member("heavy", "backend")
+	heavy.ReviewWeight = 2
+	settings := &entity2.TeamSettings{TeamName: "backend", SelectionMode: entity2.SelectionModeWeighted}
+	selector := newTestSelector(newFakeRepo(light, heavy))
+
+	const draws = 20000
+	heavyPicks := 0
+	for i := 0; i < draws; i++ {
+		if selector.pickFrom(settings, nil, []*entity2.User{light, heavy}, 1)[0].UserID == "heavy" {
+			heavyPicks++
+		}
+	}
+	if got := float64(heavyPicks) / draws; got < 0.79 || got > 0.81 {
+		t.Fatalf("heavy picked with frequency %.3f, want 0.800±0.01", got)
+	}
+}
+
+func TestPickPreferred(t *testing.T) {
+	users := map[string]*entity2.User{}
+	for _, id := range []string{"p1", "p2", "p3", "f1", "f2"} {
+		users[id] = member(id, "backend")
+	}
+	list := func(ids ...string) []*entity2.User {
+		result := make([]*entity2.User, 0, len(ids))
+		for _, id := range ids {
+			result = append(result, users[id])
+		}
+		return result
+	}
+
+	tests := []struct {
+		name      string
+		preferred []string
+		fallback  []string
+		count     int
+		// wantFrom каждому выбранному — множество, из которого он допустим; выбранные идут в порядке мест
+		wantFrom [][]string
+	}{
+		{name: "enough preferred", preferred: []string{"p1", "p2", "p3"}, fallback: []string{"f1", "f2"}, count: 2, wantFrom: [][]string{{"p1", "p2", "p3"}, {"p1", "p2", "p3"}}},
+		{name: "preferred first then fallback", preferred: []string{"p1"}, fallback: []string{"p1", "f1", "f2"}, count: 2, wantFrom: [][]string{{"p1"}, {"f1", "f2"}}},
+		{name: "no preferred", fallback: []string{"f1", "f2"}, count: 1, wantFrom: [][]string{{"f1", "f2"}}},
+		{name: "not enough candidates", preferred: []string{"p1"}, fallback: []string{"p1"}, count: 2, wantFrom: [][]string{{"p1"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeRepo(member("author", "backend"))
+			for seed := int64(1); seed <= 10; seed++ {
+				selector := newReviewerSelector(repo, repo, repo, random.New(seed))
+				picked, err := selector.pickPreferred(context.Background(), "backend", nil, list(tt.preferred...), list(tt.fallback...), tt.count)
+				if err != nil {
+					t.Fatalf("pickPreferred: %v", err)
+				}
+				got := userIDs(picked)
+				if len(got) != len(tt.wantFrom) {
+					t.Fatalf("seed %d: picked %v, want %d reviewers", seed, got, len(tt.wantFrom))
+				}
+				for i, id := range got {
+					if !slices.Contains(tt.wantFrom[i], id) {
+						t.Fatalf("seed %d: picked %v, position %d must be one of %v", seed, got, i, tt.wantFrom[i])
+					}
+				}
+				if len(got) == 2 && got[0] == got[1] {
+					t.Fatalf("seed %d: picked %v twice", seed, got)
+				}
+			}
+		})
+	}
+}
+
+func TestCoverTags(t *testing.T) {
+	skills := map[string][]string{
+		"a": {"go", "sql"},
+		"b": {"go"},
+		"c": {"k8s"},
+		"d": nil,
+	}
+
+	tests := []struct {
+		name     string
+		tags     []string
+		count    int
+		want     []string
+		wantLeft []string
+	}{
+		{name: "greedy covers all tags", tags: []string{"go", "sql", "k8s"}, count: 2, want: []string{"a", "c"}, wantLeft: []string{}},
+		{name: "count limit leaves tags uncovered", tags: []string{"go", "sql", "k8s"}, count: 1, want: []string{"a"}, wantLeft: []string{"k8s"}},
+		{name: "stops when all tags covered", tags: []string{"k8s"}, count: 2, want: []string{"c"}, wantLeft: []string{}},
+		{name: "nobody has the tag", tags: []string{"rust", "go"}, count: 2, want: []string{"a"}, wantLeft: []string{"rust"}},
+		{name: "no tags", tags: nil, count: 2, want: []string{}, wantLeft: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			candidates := []*entity2.User{member("a", "backend"), member("b", "backend"), member("c", "backend"), member("d", "backend")}
+			repo := newFakeRepo(candidates...)
+			repo.skills = skills
+
+			picked, left, err := newTestSelector(repo).coverTags(context.Background(), "backend", nil, candidates, tt.tags, tt.count)
+			if err != nil {
+				t.Fatalf("coverTags: %v", err)
+			}
+			if got := userIDs(picked); !slices.Equal(got, tt.want) {
+				t.Fatalf("picked %v, want %v", got, tt.want)
+			}
+			if !slices.Equal(left, tt.wantLeft) || (left == nil) != (tt.wantLeft == nil) {
+				t.Fatalf("left %#v, want %#v", left, tt.wantLeft)
+			}
+		})
+	}
+}
+
+func TestCoverTagsBreaksTiesAmongEquallyCoveringCandidates(t *testing.T) {
+	candidates := []*entity2.User{member("a", "backend"), member("b", "backend"), member("c", "backend")}
+	repo := newFakeRepo(candidates...)
+	repo.skills = map[string][]string{"a": {"go"}, "b": {"go"}, "c": {"sql"}}
+
+	seen := make(map[string]bool)
+	for seed := int64(1); seed <= 30; seed++ {
+		selector := newReviewerSelector(repo, repo, repo, random.New(seed))
+		picked, _, err := selector.coverTags(context.Background(), "backend", nil, candidates, []string{"go"}, 1)
+		if err != nil || len(picked) != 1 || picked[0].UserID == "c" {
+			t.Fatalf("seed %d: picked %v, err %v; want a or b", seed, userIDs(picked), err)
+		}
+		seen[picked[0].UserID] = true
+	}
+	if !seen["a"] || !seen["b"] {
+		t.Fatalf("ties must be broken randomly, picked only %v", seen)
+	}
+}
+
+// withSchedule задает пользователю рабочий график
+func withSchedule(user *entity2.User, timezone, workStart, workEnd string) *entity2.User {
+	schedule, err := entity2.NewWorkSchedule(timezone, workStart, workEnd)
+	if err != nil {
+		panic(err)
+	}
+	user.Schedule = schedule
+	return user
+}
+
+func TestSplitByWorkingTime(t *testing.T) {
+	// Среда, 14 октября 2026 года
+	wednesdayMorning := time.Date(2026, time.October, 14, 10, 0, 0, 0, time.UTC)
+	wednesdayNight := time.Date(2026, time.October, 14, 23, 30, 0, 0, time.UTC)
+	saturday := time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		user        *entity2.User
+		holidays    []entity2.TeamHoliday
+		now         time.Time
+		wantWorking bool
+	}{
+		{name: "no schedule on a weekday", user: member("u1", "backend"), now: wednesdayMorning, wantWorking: true},
+		{name: "no schedule on a weekend", user: member("u1", "backend"), now: saturday},
+		{name: "inside local working hours", user: withSchedule(member("u1", "backend"), "Europe/Moscow", "09:00", "18:00"), now: wednesdayMorning, wantWorking: true},
+		{name: "before local working hours", user: withSchedule(member("u1", "backend"), "America/New_York", "09:00", "18:00"), now: wednesdayMorning},
+		{name: "end of window is exclusive", user: withSchedule(member("u1", "backend"), "UTC", "08:00", "10:00"), now: wednesdayMorning},
+		{name: "next local day has started", user: withSchedule(member("u1", "backend"), "Asia/Tokyo", "08:00", "17:00"), now: wednesdayNight, wantWorking: true},
+		{
+			name:     "team holiday",
+			user:     withSchedule(member("u1", "backend"), "Europe/Moscow", "09:00", "18:00"),
+			holidays: []entity2.TeamHoliday{{Date: "2026-10-14", Name: "Team day"}},
+			now:      wednesdayMorning,
+		},
+		{
+			name:     "holiday is matched by local date",
+			user:     withSchedule(member("u1", "backend"), "Asia/Tokyo", "08:00", "17:00"),
+			holidays: []entity2.TeamHoliday{{Date: "2026-10-15", Name: "Local holiday"}},
+			now:      wednesdayNight,
+		},
+		{
+			name:        "holiday of another team",
+			user:        withSchedule(member("u1", "platform"), "Europe/Moscow", "09:00", "18:00"),
+			holidays:    []entity2.TeamHoliday{{Date: "2026-10-14", Name: "Team day"}},
+			now:         wednesdayMorning,
+			wantWorking: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeRepo(tt.user)
+			repo.holidays["backend"] = tt.holidays
+
+			working, offHours, err := newTestSelector(repo).splitByWorkingTime(context.Background(), []*entity2.User{tt.user}, tt.now)
+			if err != nil {
+				t.Fatalf("split: %v", err)
+			}
+			if got := len(working) == 1; got != tt.wantWorking || len(working)+len(offHours) != 1 {
+				t.Fatalf("working %v, off hours %v, want working = %v", userIDs(working), userIDs(offHours), tt.wantWorking)
+			}
+		})
+	}
+}
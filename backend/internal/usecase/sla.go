@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	entity2 "test_task_avito/backend/internal/entity"
+	port2 "test_task_avito/backend/internal/port"
+	"time"
+)
+
+// setReviewDeadlines рассчитывает сроки ревью по SLA команды каждого PR и отмечает просроченные на момент now.
+// Рабочие часы отсчитываются по графику ревьювера с учетом праздников его команды.
+func setReviewDeadlines(ctx context.Context, userRepo port2.UserRepository, teamRepo port2.TeamRepository, assignments []*entity2.ReviewAssignment, now time.Time) error {
+	calendar := newWorkCalendar(userRepo, teamRepo)
+	slaHours := make(map[string]int)
+	for _, a := range assignments {
+		hours, ok := slaHours[a.TeamName]
+		if !ok {
+			settings, err := teamRepo.GetTeamSettings(ctx, a.TeamName)
+			if err != nil {
+				return err
+			}
+			hours = settings.ReviewSLAHours
+			slaHours[a.TeamName] = hours
+		}
+		if hours == 0 {
+			continue
+		}
+
+		schedule, err := calendar.scheduleOf(ctx, a.ReviewerID)
+		if err != nil {
+			return err
+		}
+		a.SetDueAt(schedule.AddWorkingHours(a.AssignedAt, hours), now)
+	}
+	return nil
+}
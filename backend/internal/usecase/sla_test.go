@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	entity2 "test_task_avito/backend/internal/entity"
+	"testing"
+	"time"
+)
+
+// slaMonday понедельник 10:00 UTC — момент назначения ревью в тестах SLA
+var slaMonday = time.Date(2025, 11, 10, 10, 0, 0, 0, time.UTC)
+
+func TestSetReviewDeadlines(t *testing.T) {
+	moscow, err := entity2.NewWorkSchedule("Europe/Moscow", "09:00", "18:00")
+	if err != nil {
+		t.Fatalf("schedule: %v", err)
+	}
+	friday := time.Date(2025, 11, 14, 14, 0, 0, 0, time.UTC) // 17:00 MSK
+
+	tests := []struct {
+		name        string
+		team        string
+		reviewer    string
+		assignedAt  time.Time
+		status      entity2.PullRequestStatus
+		now         time.Time
+		wantDueAt   *time.Time
+		wantOverdue bool
+	}{
+		{name: "team without SLA", team: "frontend", reviewer: "b1", assignedAt: slaMonday, now: slaMonday.AddDate(0, 0, 7)},
+		{name: "before the deadline", team: "backend", reviewer: "b1", assignedAt: slaMonday, now: slaMonday.Add(time.Hour), wantDueAt: ptrTime(slaMonday.Add(4 * time.Hour))},
+		{name: "exactly at the deadline", team: "backend", reviewer: "b1", assignedAt: slaMonday, now: slaMonday.Add(4 * time.Hour), wantDueAt: ptrTime(slaMonday.Add(4 * time.Hour))},
+		{name: "just past the deadline", team: "backend", reviewer: "b1", assignedAt: slaMonday, now: slaMonday.Add(4*time.Hour + time.Second), wantDueAt: ptrTime(slaMonday.Add(4 * time.Hour)), wantOverdue: true},
+		{name: "merged PR is never overdue", team: "backend", reviewer: "b1", assignedAt: slaMonday, status: entity2.PullRequestStatusMerged, now: slaMonday.AddDate(0, 0, 7), wantDueAt: ptrTime(slaMonday.Add(4 * time.Hour))},
+		// 1 ч в пятницу до 18:00 MSK, остальные 3 ч — с 09:00 MSK понедельника
+		{name: "reviewer schedule skips the weekend", team: "backend", reviewer: "m1", assignedAt: friday, now: friday.Add(time.Hour), wantDueAt: ptrTime(time.Date(2025, 11, 17, 9, 0, 0, 0, time.UTC))},
+		{name: "removed reviewer counts round the clock", team: "backend", reviewer: "gone", assignedAt: slaMonday, now: slaMonday, wantDueAt: ptrTime(slaMonday.Add(4 * time.Hour))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeRepo(member("b1", "backend"), member("m1", "backend"), member("f1", "frontend"))
+			repo.users[1].Schedule = moscow
+			repo.teamSettings("backend").ReviewSLAHours = 4
+			status := tt.status
+			if status == "" {
+				status = entity2.PullRequestStatusOpen
+			}
+			assignment := &entity2.ReviewAssignment{PullRequestID: "pr-1", TeamName: tt.team, ReviewerID: tt.reviewer, Status: status, AssignedAt: tt.assignedAt}
+
+			if err := setReviewDeadlines(context.Background(), repo, repo, []*entity2.ReviewAssignment{assignment}, tt.now); err != nil {
+				t.Fatalf("set deadlines: %v", err)
+			}
+			switch {
+			case tt.wantDueAt == nil && assignment.DueAt != nil:
+				t.Fatalf("due at %s, want no deadline", assignment.DueAt)
+			case tt.wantDueAt != nil && (assignment.DueAt == nil || !assignment.DueAt.Equal(*tt.wantDueAt)):
+				t.Fatalf("due at %v, want %s", assignment.DueAt, tt.wantDueAt)
+			}
+			if assignment.Overdue != tt.wantOverdue {
+				t.Fatalf("overdue = %v, want %v", assignment.Overdue, tt.wantOverdue)
+			}
+		})
+	}
+}
+
+func TestGetOverdueReviews(t *testing.T) {
+	repo := newFakeRepo(member("author", "backend"), member("b1", "backend"), member("b2", "backend"), member("fa", "frontend"), member("f1", "frontend"))
+	repo.teamSettings("backend").ReviewSLAHours = 4
+	longAgo := slaMonday.Add(-time.Hour)
+	repo.addPullRequest(&entity2.PullRequest{PullRequestID: "pr-late", AuthorID: "author", Status: entity2.PullRequestStatusOpen, AssignedReviewers: []string{"b1", "b2"}, CreatedAt: &longAgo})
+	repo.addPullRequest(&entity2.PullRequest{PullRequestID: "pr-on-time", AuthorID: "author", Status: entity2.PullRequestStatusOpen, AssignedReviewers: []string{"b1"}, CreatedAt: &slaMonday})
+	repo.addPullRequest(&entity2.PullRequest{PullRequestID: "pr-no-sla", AuthorID: "fa", Status: entity2.PullRequestStatusOpen, AssignedReviewers: []string{"f1"}, CreatedAt: &longAgo})
+
+	// pr-on-time ровно на сроке, pr-late просрочен на час
+	overdue, err := newTestPullRequestUseCase(repo, 1).overdueReviews(context.Background(), slaMonday.Add(4*time.Hour))
+	if err != nil {
+		t.Fatalf("overdue reviews: %v", err)
+	}
+
+	var got []string
+	for _, a := range overdue {
+		got = append(got, a.PullRequestID+"/"+a.ReviewerID)
+		if !a.Overdue || a.DueAt == nil || !a.DueAt.Equal(longAgo.Add(4*time.Hour)) {
+			t.Errorf("unexpected assignment %+v", a)
+		}
+	}
+	if len(got) != 2 || got[0] != "pr-late/b1" || got[1] != "pr-late/b2" {
+		t.Fatalf("overdue %v, want both reviewers of pr-late", got)
+	}
+}
+
+func ptrTime(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,183 @@
+package usecase
+
+import (
+	"context"
+	entity2 "test_task_avito/backend/internal/entity"
+	"time"
+)
+
+func (uc *pullRequestUseCase) ProcessStaleReviews(ctx context.Context) (*entity2.StaleReviewResult, error) {
+	now := time.Now()
+	settingsCache := make(map[string]*entity2.TeamSettings)
+	calendar := newWorkCalendar(uc.userRepo, uc.teamRepo)
+	result := &entity2.StaleReviewResult{}
+
+	reassigned, err := uc.reassignIdleReviewers(ctx, now, settingsCache, calendar)
+	result.Reassigned = reassigned
+	if err != nil {
+		return result, err
+	}
+
+	escalated, err := uc.escalateStalePullRequests(ctx, now, settingsCache, calendar)
+	result.Escalated = escalated
+	return result, err
+}
+
+// reassignIdleReviewers заменяет ревьюверов открытых PR, простаивающих дольше stale_reassign_hours команды PR
+// (в рабочих часах графика ревьювера). Ревьюверы, для которых нет замены, остаются назначенными;
+// тимлид, добавленный эскалацией, не заменяется.
+func (uc *pullRequestUseCase) reassignIdleReviewers(ctx context.Context, now time.Time, settingsCache map[string]*entity2.TeamSettings, calendar *workCalendar) (int64, error) {
+	assignments, err := uc.prRepo.GetOpenReviewAssignments(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var reassigned int64
+	for _, a := range assignments {
+		if a.Escalated {
+			continue
+		}
+
+		settings, err := uc.selector.teamSettings(ctx, a.TeamName, settingsCache)
+		if err != nil {
+			return reassigned, err
+		}
+		if settings.StaleReassignHours == 0 {
+			continue
+		}
+		schedule, err := calendar.scheduleOf(ctx, a.ReviewerID)
+		if err != nil {
+			return reassigned, err
+		}
+		if now.Before(schedule.AddWorkingHours(a.AssignedAt, settings.StaleReassignHours)) {
+			continue
+		}
+
+		if _, _, err := uc.ReassignReviewer(ctx, a.PullRequestID, a.ReviewerID, ""); err != nil {
+			if isSkippableStaleError(err) {
+				continue
+			}
+			return reassigned, err
+		}
+		reassigned++
+	}
+
+	return reassigned, nil
+}
+
+// escalateStalePullRequests добавляет тимлида команды PR в PR, открытые дольше stale_escalation_hours
+// (в рабочих часах графика автора). Если свободного тимлида нет, эскалация повторяется при следующем запуске.
+func (uc *pullRequestUseCase) escalateStalePullRequests(ctx context.Context, now time.Time, settingsCache map[string]*entity2.TeamSettings, calendar *workCalendar) (int64, error) {
+	prs, err := uc.prRepo.GetOpenPullRequestsWithoutEscalation(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var escalated int64
+	for _, pr := range prs {
+		if pr.CreatedAt == nil {
+			continue
+		}
+
+		author, err := uc.userRepo.GetUser(ctx, pr.AuthorID)
+		if err != nil {
+			if isSkippableStaleError(err) {
+				continue
+			}
+			return escalated, err
+		}
+
+		ruled := rulesAuthor(pr, author)
+		settings, err := uc.selector.teamSettings(ctx, ruled.TeamName, settingsCache)
+		if err != nil {
+			return escalated, err
+		}
+		if settings.StaleEscalationHours == 0 {
+			continue
+		}
+		schedule, err := calendar.schedule(ctx, author)
+		if err != nil {
+			return escalated, err
+		}
+		if now.Before(schedule.AddWorkingHours(*pr.CreatedAt, settings.StaleEscalationHours)) {
+			continue
+		}
+
+		ok, err := uc.escalate(ctx, pr.PullRequestID, ruled)
+		if err != nil {
+			return escalated, err
+		}
+		if ok {
+			escalated++
+		}
+	}
+
+	return escalated, nil
+}
+
+// escalate назначает ревьювером PR активного тимлида команды PR (author — автор в этой команде) и фиксирует эскалацию.
+// Лимит открытых ревью не учитывается; исключенные для автора и отказавшиеся от PR тимлиды не выбираются.
+func (uc *pullRequestUseCase) escalate(ctx context.Context, prID string, author *entity2.User) (bool, error) {
+	pr, err := uc.prRepo.GetPullRequest(ctx, prID)
+	if err != nil {
+		return false, err
+	}
+
+	candidates, err := uc.userRepo.GetActiveUsersByTeam(ctx, author.TeamName, author.UserID)
+	if err != nil {
+		return false, err
+	}
+
+	excluded, err := uc.selector.excludedReviewers(ctx, author)
+	if err != nil {
+		return false, err
+	}
+
+	assigned := make(map[string]bool, len(pr.AssignedReviewers))
+	for _, reviewerID := range pr.AssignedReviewers {
+		assigned[reviewerID] = true
+	}
+
+	var leads []*entity2.User
+	for _, candidate := range candidates {
+		if candidate.Level != entity2.UserLevelLead || assigned[candidate.UserID] || pr.DeclinedBy(candidate.UserID) {
+			continue
+		}
+		if _, ok := excluded[candidate.UserID]; ok {
+			continue
+		}
+		leads = append(leads, candidate)
+	}
+	if len(leads) == 0 {
+		return false, nil
+	}
+
+	picked, err := uc.selector.pick(ctx, author.TeamName, author, leads, 1)
+	if err != nil {
+		return false, err
+	}
+	lead := picked[0]
+
+	// Тимлид, эскалация и событие сохраняются одной транзакцией
+	newReviewers := append(append([]string{}, pr.AssignedReviewers...), lead.UserID)
+	escalation := &entity2.ReviewEscalation{UserID: lead.UserID}
+	if err := uc.prRepo.SaveReviewEscalation(ctx, prID, newReviewers, escalation, reviewerAssignedEvent(prID, lead.UserID)); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// isSkippableStaleError ошибки, из-за которых фоновая обработка пропускает ревью, а не прерывается:
+// замены нет, либо PR или ревьювер изменились с момента выборки
+func isSkippableStaleError(err error) bool {
+	domainErr, ok := err.(*entity2.DomainError)
+	if !ok {
+		return false
+	}
+	switch domainErr.Code {
+	case entity2.ErrorCodeNoCandidate, entity2.ErrorCodeNotAssigned, entity2.ErrorCodePRMerged, entity2.ErrorCodePRClosed, entity2.ErrorCodeNotFound:
+		return true
+	}
+	return false
+}
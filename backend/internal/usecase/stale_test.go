@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	entity2 "test_task_avito/backend/internal/entity"
+	"testing"
+	"time"
+)
+
+func TestProcessStaleReviewsEscalatesOnce(t *testing.T) {
+	lead := withLevel(member("lead", "backend"), entity2.UserLevelLead)
+	repo := newFakeRepo(member("author", "backend"), member("b1", "backend"), lead)
+	repo.teamSettings("backend").StaleEscalationHours = 24
+	createdAt := time.Now().Add(-72 * time.Hour)
+	repo.addPullRequest(&entity2.PullRequest{
+		PullRequestID: "pr-1", AuthorID: "author", Status: entity2.PullRequestStatusOpen, AssignedReviewers: []string{"b1"}, CreatedAt: &createdAt,
+	})
+	uc := newTestPullRequestUseCase(repo, 1)
+
+	result, err := uc.ProcessStaleReviews(context.Background())
+	if err != nil {
+		t.Fatalf("first run: %v", err)
+	}
+	if result.Escalated != 1 {
+		t.Fatalf("first run escalated %d, want 1", result.Escalated)
+	}
+	if reviewers := repo.prs["pr-1"].AssignedReviewers; !slices.Equal(reviewers, []string{"b1", "lead"}) {
+		t.Fatalf("reviewers %v, want lead added", reviewers)
+	}
+	if escalation := repo.escalations["pr-1"]; escalation == nil || escalation.UserID != "lead" {
+		t.Fatalf("escalation %+v", escalation)
+	}
+
+	result, err = uc.ProcessStaleReviews(context.Background())
+	if err != nil {
+		t.Fatalf("second run: %v", err)
+	}
+	if result.Escalated != 0 || result.Reassigned != 0 {
+		t.Fatalf("second run %+v, want no changes", result)
+	}
+	if reviewers := repo.prs["pr-1"].AssignedReviewers; !slices.Equal(reviewers, []string{"b1", "lead"}) {
+		t.Fatalf("reviewers after second run %v", reviewers)
+	}
+	if len(repo.events) != 1 || repo.events[0].Type != entity2.EventReviewerAssigned || repo.events[0].Data["user_id"] != "lead" {
+		t.Fatalf("events %+v, want a single reviewer.assigned for lead", repo.events)
+	}
+}
+
+func TestProcessStaleReviewsSkipsPullRequestWithoutFreeLead(t *testing.T) {
+	repo := newFakeRepo(member("author", "backend"), member("b1", "backend"), withLevel(member("lead", "backend"), entity2.UserLevelLead))
+	repo.teamSettings("backend").StaleEscalationHours = 24
+	createdAt := time.Now().Add(-72 * time.Hour)
+	repo.addPullRequest(&entity2.PullRequest{
+		PullRequestID: "pr-1", AuthorID: "author", Status: entity2.PullRequestStatusOpen, AssignedReviewers: []string{"b1", "lead"}, CreatedAt: &createdAt,
+	})
+
+	result, err := newTestPullRequestUseCase(repo, 1).ProcessStaleReviews(context.Background())
+	if err != nil {
+		t.Fatalf("process: %v", err)
+	}
+	if result.Escalated != 0 || repo.escalations["pr-1"] != nil || len(repo.events) != 0 {
+		t.Fatalf("PR with the lead already assigned must not be escalated: %+v", result)
+	}
+}
+
+func TestProcessStaleReviewsEscalatesToPullRequestTeamLead(t *testing.T) {
+	repo := newFakeRepo(
+		member("author", "backend"), withLevel(member("backend-lead", "backend"), entity2.UserLevelLead),
+		member("pay1", "payments"), withLevel(member("payments-lead", "payments"), entity2.UserLevelLead),
+	)
+	repo.teamSettings("payments").StaleEscalationHours = 24
+	createdAt := time.Now().Add(-72 * time.Hour)
+	repo.addPullRequest(&entity2.PullRequest{
+		PullRequestID: "pr-1", AuthorID: "author", TeamName: "payments", Status: entity2.PullRequestStatusOpen, AssignedReviewers: []string{"pay1"}, CreatedAt: &createdAt,
+	})
+
+	result, err := newTestPullRequestUseCase(repo, 1).ProcessStaleReviews(context.Background())
+	if err != nil {
+		t.Fatalf("process: %v", err)
+	}
+	if result.Escalated != 1 {
+		t.Fatalf("escalated %d, want 1 by payments settings", result.Escalated)
+	}
+	if escalation := repo.escalations["pr-1"]; escalation == nil || escalation.UserID != "payments-lead" {
+		t.Fatalf("escalation %+v, want payments-lead", escalation)
+	}
+}
@@ -3,26 +3,27 @@ package usecase
 import (
 	"context"
 	"errors"
-	"math/rand"
+	"strings"
 	entity2 "test_task_avito/backend/internal/entity"
 	port2 "test_task_avito/backend/internal/port"
-	"time"
+	"test_task_avito/backend/pkg/codeowners"
+	"unicode/utf8"
 )
 
 type teamUseCase struct {
 	teamRepo port2.TeamRepository
 	userRepo port2.UserRepository
 	prRepo   port2.PullRequestRepository
-	rng      *rand.Rand
+	selector *reviewerSelector
 }
 
 // NewTeamUseCase создает новый экземпляр TeamUseCase
-func NewTeamUseCase(teamRepo port2.TeamRepository, userRepo port2.UserRepository, prRepo port2.PullRequestRepository) port2.TeamUseCase {
+func NewTeamUseCase(teamRepo port2.TeamRepository, userRepo port2.UserRepository, prRepo port2.PullRequestRepository, rnd port2.RandomSource) port2.TeamUseCase {
 	return &teamUseCase{
 		teamRepo: teamRepo,
 		userRepo: userRepo,
 		prRepo:   prRepo,
-		rng:      rand.New(rand.NewSource(time.Now().UnixNano())),
+		selector: newReviewerSelector(userRepo, teamRepo, prRepo, rnd),
 	}
 }
 
@@ -52,6 +53,109 @@ func (uc *teamUseCase) GetTeam(ctx context.Context, teamName string) (*entity2.T
 	return uc.teamRepo.GetTeam(ctx, teamName)
 }
 
+func (uc *teamUseCase) GetTeamSettings(ctx context.Context, teamName string) (*entity2.TeamSettings, error) {
+	return uc.teamRepo.GetTeamSettings(ctx, teamName)
+}
+
+func (uc *teamUseCase) UpdateTeamSettings(ctx context.Context, teamName string, update entity2.TeamSettingsUpdate) (*entity2.TeamSettings, error) {
+	settings, err := uc.teamRepo.GetTeamSettings(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	settings.Apply(update)
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+
+	if settings.RequiredReviewerTeam != "" {
+		exists, err := uc.teamRepo.TeamExists(ctx, settings.RequiredReviewerTeam)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "required_reviewer_team not found")
+		}
+	}
+
+	if err := uc.teamRepo.SaveTeamSettings(ctx, settings); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+func (uc *teamUseCase) SetTeamCodeowners(ctx context.Context, teamName, content string) (int, error) {
+	exists, err := uc.teamRepo.TeamExists(ctx, teamName)
+	if err != nil {
+		return 0, err
+	}
+	if !exists {
+		return 0, entity2.NewDomainError(entity2.ErrorCodeNotFound, "team not found")
+	}
+
+	ruleset, err := codeowners.Parse(strings.NewReader(content))
+	if err != nil {
+		return 0, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "invalid CODEOWNERS: "+err.Error())
+	}
+
+	if err := uc.teamRepo.SaveTeamCodeowners(ctx, teamName, content); err != nil {
+		return 0, err
+	}
+
+	return len(ruleset.Rules), nil
+}
+
+func (uc *teamUseCase) GetTeamCodeowners(ctx context.Context, teamName string) (string, error) {
+	exists, err := uc.teamRepo.TeamExists(ctx, teamName)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return "", entity2.NewDomainError(entity2.ErrorCodeNotFound, "team not found")
+	}
+
+	return uc.teamRepo.GetTeamCodeowners(ctx, teamName)
+}
+
+func (uc *teamUseCase) SetTeamHolidays(ctx context.Context, teamName string, holidays []entity2.TeamHoliday) ([]entity2.TeamHoliday, error) {
+	exists, err := uc.teamRepo.TeamExists(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, entity2.NewDomainError(entity2.ErrorCodeNotFound, "team not found")
+	}
+
+	holidays, err = entity2.NormalizeHolidays(holidays)
+	if err != nil {
+		return nil, err
+	}
+	for _, holiday := range holidays {
+		if utf8.RuneCountInString(holiday.Name) > maxVarcharLength {
+			return nil, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "holiday name is too long")
+		}
+	}
+
+	if err := uc.teamRepo.SetTeamHolidays(ctx, teamName, holidays); err != nil {
+		return nil, err
+	}
+
+	return holidays, nil
+}
+
+func (uc *teamUseCase) GetTeamHolidays(ctx context.Context, teamName string) ([]entity2.TeamHoliday, error) {
+	exists, err := uc.teamRepo.TeamExists(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, entity2.NewDomainError(entity2.ErrorCodeNotFound, "team not found")
+	}
+
+	return uc.teamRepo.GetTeamHolidays(ctx, teamName)
+}
+
 func (uc *teamUseCase) DeactivateTeam(ctx context.Context, teamName string, strategy entity2.ReplacementStrategy) (*entity2.TeamDeactivateResult, error) {
 	strategy = strategy.Normalize()
 	if !strategy.Valid() {
@@ -102,6 +206,9 @@ func (uc *teamUseCase) DeactivateTeam(ctx context.Context, teamName string, stra
 
 	authorCache := make(map[string]*entity2.User)
 	skippedPRs := make(map[string]struct{})
+	// Причины отказа кандидатам для PR, оставшихся без замены
+	var candidateRejections []entity2.CandidateRejection
+	rejectedCandidates := make(map[string]struct{})
 
 	for _, prID := range prIDs {
 		pr, err := uc.prRepo.GetPullRequest(ctx, prID)
@@ -119,6 +226,7 @@ func (uc *teamUseCase) DeactivateTeam(ctx context.Context, teamName string, stra
 
 		newReviewers := make([]string, 0, len(pr.AssignedReviewers))
 		prSkipped := false
+		var events []*entity2.Event
 
 		for _, reviewer := range pr.AssignedReviewers {
 			if _, toDeactivate := targetSet[reviewer]; !toDeactivate {
@@ -128,10 +236,16 @@ func (uc *teamUseCase) DeactivateTeam(ctx context.Context, teamName string, stra
 
 			delete(currentReviewers, reviewer)
 
-			replacement, repErr := uc.pickReplacement(ctx, strategy, reviewer, pr, currentReviewers, targetSet, userByID, authorCache)
+			replacement, rejections, repErr := uc.pickReplacement(ctx, strategy, reviewer, pr, currentReviewers, targetSet, userByID, authorCache)
 			if repErr != nil {
 				if errors.Is(repErr, errNoReplacement) {
 					prSkipped = true
+					for _, rejection := range rejections {
+						if _, seen := rejectedCandidates[rejection.UserID]; !seen {
+							rejectedCandidates[rejection.UserID] = struct{}{}
+							candidateRejections = append(candidateRejections, rejection)
+						}
+					}
 					continue
 				}
 				return nil, repErr
@@ -140,26 +254,27 @@ func (uc *teamUseCase) DeactivateTeam(ctx context.Context, teamName string, stra
 			newReviewers = append(newReviewers, replacement)
 			currentReviewers[replacement] = struct{}{}
 			result.ReassignedPRs++
+			events = append(events, reviewerReassignedEvent(prID, reviewer, replacement, ""))
 		}
 
 		if prSkipped {
 			skippedPRs[prID] = struct{}{}
 		}
 
-		if err := uc.prRepo.UpdatePullRequestReviewers(ctx, prID, newReviewers); err != nil {
+		if err := uc.prRepo.UpdatePullRequestReviewers(ctx, prID, newReviewers, events...); err != nil {
 			return nil, err
 		}
 	}
 
-	deactivatedCount, err := uc.teamRepo.BulkDeactivateUsersByTeam(ctx, teamName)
+	result.SkippedPRs = int64(len(skippedPRs))
+	deactivatedCount, err := uc.teamRepo.BulkDeactivateUsersByTeam(ctx, teamName, teamDeactivatedEvent(result, reviewerIDs))
 	if err != nil {
 		return nil, err
 	}
 	result.DeactivatedUsers = deactivatedCount
-	result.SkippedPRs = int64(len(skippedPRs))
 
 	if result.SkippedPRs > 0 {
-		return result, entity2.NewDomainError(entity2.ErrorCodeNoCandidate, "unable to reassign all reviewers")
+		return result, entity2.NewNoCandidateError("unable to reassign all reviewers", candidateRejections)
 	}
 
 	return result, nil
@@ -176,34 +291,53 @@ func (uc *teamUseCase) pickReplacement(
 	toDeactivate map[string]struct{},
 	userByID map[string]*entity2.User,
 	authorCache map[string]*entity2.User,
-) (string, error) {
+) (string, []entity2.CandidateRejection, error) {
 
 	oldUser, ok := userByID[reviewerID]
 	if !ok {
-		return "", entity2.NewDomainError(entity2.ErrorCodeNotFound, "reviewer not found for reassignment")
+		return "", nil, entity2.NewDomainError(entity2.ErrorCodeNotFound, "reviewer not found for reassignment")
 	}
 
+	author, err := uc.getAuthor(ctx, pr.AuthorID, authorCache)
+	if err != nil {
+		return "", nil, err
+	}
+	// Стратегия author_team ищет замену в команде, по правилам которой выбирались ревьюверы PR
+	author = rulesAuthor(pr, author)
+
 	candidates := make([]*entity2.User, 0)
+	var rejections []entity2.CandidateRejection
+
+	// addCandidates добавляет подходящих кандидатов очередного этапа поиска, перегруженные и исключенные для автора отклоняются
+	addCandidates := func(users []*entity2.User) error {
+		eligible, stageRejections, err := uc.selector.eligible(ctx, author, uc.filterCandidates(users, pr, currentReviewers, toDeactivate))
+		if err != nil {
+			return err
+		}
+		candidates = append(candidates, eligible...)
+		rejections = append(rejections, stageRejections...)
+		return nil
+	}
 
 	if strategy == entity2.ReplacementStrategySameTeam {
 		sameTeamCandidates, err := uc.userRepo.GetActiveUsersByTeam(ctx, oldUser.TeamName, reviewerID)
 		if err != nil {
-			return "", err
+			return "", nil, err
+		}
+		if err := addCandidates(sameTeamCandidates); err != nil {
+			return "", nil, err
 		}
-		candidates = append(candidates, uc.filterCandidates(sameTeamCandidates, currentReviewers, toDeactivate)...)
 	}
 
 	if strategy == entity2.ReplacementStrategyAuthorTeam || len(candidates) == 0 {
-		author, err := uc.getAuthor(ctx, pr.AuthorID, authorCache)
-		if err != nil {
-			return "", err
-		}
 		if author != nil {
 			authorCandidates, err := uc.userRepo.GetActiveUsersByTeam(ctx, author.TeamName, author.UserID)
 			if err != nil {
-				return "", err
+				return "", nil, err
+			}
+			if err := addCandidates(authorCandidates); err != nil {
+				return "", nil, err
 			}
-			candidates = append(candidates, uc.filterCandidates(authorCandidates, currentReviewers, toDeactivate)...)
 		}
 	}
 
@@ -219,23 +353,26 @@ func (uc *teamUseCase) pickReplacement(
 
 		globalCandidates, err := uc.userRepo.GetAllActiveUsers(ctx, exclude)
 		if err != nil {
-			return "", err
+			return "", nil, err
+		}
+		if err := addCandidates(globalCandidates); err != nil {
+			return "", nil, err
 		}
-		candidates = append(candidates, uc.filterCandidates(globalCandidates, currentReviewers, toDeactivate)...)
 	}
 
 	if len(candidates) == 0 {
-		return "", errNoReplacement
+		return "", rejections, errNoReplacement
 	}
 
-	uc.rng.Shuffle(len(candidates), func(i, j int) {
-		candidates[i], candidates[j] = candidates[j], candidates[i]
-	})
+	picked, err := uc.selector.pick(ctx, oldUser.TeamName, author, candidates, 1)
+	if err != nil {
+		return "", nil, err
+	}
 
-	return candidates[0].UserID, nil
+	return picked[0].UserID, nil, nil
 }
 
-func (uc *teamUseCase) filterCandidates(candidates []*entity2.User, currentReviewers map[string]struct{}, toDeactivate map[string]struct{}) []*entity2.User {
+func (uc *teamUseCase) filterCandidates(candidates []*entity2.User, pr *entity2.PullRequest, currentReviewers map[string]struct{}, toDeactivate map[string]struct{}) []*entity2.User {
 	filtered := make([]*entity2.User, 0, len(candidates))
 	seen := make(map[string]struct{}, len(candidates))
 
@@ -246,6 +383,9 @@ func (uc *teamUseCase) filterCandidates(candidates []*entity2.User, currentRevie
 		if _, deactivated := toDeactivate[candidate.UserID]; deactivated {
 			continue
 		}
+		if pr.DeclinedBy(candidate.UserID) {
+			continue
+		}
 		if _, exists := seen[candidate.UserID]; exists {
 			continue
 		}
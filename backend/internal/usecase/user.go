@@ -2,20 +2,38 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
 	entity2 "test_task_avito/backend/internal/entity"
 	port2 "test_task_avito/backend/internal/port"
+	"test_task_avito/backend/pkg/ical"
+	"time"
+	"unicode/utf8"
 )
 
+// maxVarcharLength ограничение длины строковых колонок VARCHAR(255)
+const maxVarcharLength = 255
+
 type userUseCase struct {
-	userRepo port2.UserRepository
-	prRepo   port2.PullRequestRepository
+	userRepo  port2.UserRepository
+	teamRepo  port2.TeamRepository
+	prRepo    port2.PullRequestRepository
+	prUseCase port2.PullRequestUseCase
+	// calendarPath путь к файлу календаря отсутствий на сервере (может быть пустым)
+	calendarPath string
 }
 
 // NewUserUseCase создает новый экземпляр UserUseCase
-func NewUserUseCase(userRepo port2.UserRepository, prRepo port2.PullRequestRepository) port2.UserUseCase {
+func NewUserUseCase(userRepo port2.UserRepository, teamRepo port2.TeamRepository, prRepo port2.PullRequestRepository, prUseCase port2.PullRequestUseCase, calendarPath string) port2.UserUseCase {
 	return &userUseCase{
-		userRepo: userRepo,
-		prRepo:   prRepo,
+		userRepo:     userRepo,
+		teamRepo:     teamRepo,
+		prRepo:       prRepo,
+		prUseCase:    prUseCase,
+		calendarPath: calendarPath,
 	}
 }
 
@@ -29,7 +47,112 @@ func (uc *userUseCase) SetUserIsActive(ctx context.Context, userID string, isAct
 	return uc.userRepo.GetUser(ctx, userID)
 }
 
-func (uc *userUseCase) GetUserReviews(ctx context.Context, userID string) ([]*entity2.PullRequest, error) {
+func (uc *userUseCase) SetUserMaxOpenReviews(ctx context.Context, userID string, maxOpenReviews *int) (*entity2.User, error) {
+	if maxOpenReviews != nil && *maxOpenReviews <= 0 {
+		return nil, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "max_open_reviews must be positive")
+	}
+
+	if err := uc.userRepo.UpdateUserMaxOpenReviews(ctx, userID, maxOpenReviews); err != nil {
+		return nil, err
+	}
+
+	return uc.userRepo.GetUser(ctx, userID)
+}
+
+func (uc *userUseCase) SetUserReviewWeight(ctx context.Context, userID string, weight float64) (*entity2.User, error) {
+	if weight <= 0 || math.IsInf(weight, 0) || math.IsNaN(weight) {
+		return nil, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "review_weight must be a positive number")
+	}
+
+	if err := uc.userRepo.UpdateUserReviewWeight(ctx, userID, weight); err != nil {
+		return nil, err
+	}
+
+	return uc.userRepo.GetUser(ctx, userID)
+}
+
+func (uc *userUseCase) SetUserLevel(ctx context.Context, userID string, level entity2.UserLevel) (*entity2.User, error) {
+	if !level.Valid() {
+		return nil, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "unknown level")
+	}
+
+	if err := uc.userRepo.UpdateUserLevel(ctx, userID, level); err != nil {
+		return nil, err
+	}
+
+	return uc.userRepo.GetUser(ctx, userID)
+}
+
+func (uc *userUseCase) SetUserSchedule(ctx context.Context, userID, timezone, workStart, workEnd string) (*entity2.User, error) {
+	var schedule *entity2.WorkSchedule
+	if timezone != "" || workStart != "" || workEnd != "" {
+		var err error
+		schedule, err = entity2.NewWorkSchedule(timezone, workStart, workEnd)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.userRepo.UpdateUserSchedule(ctx, userID, schedule); err != nil {
+		return nil, err
+	}
+
+	return uc.userRepo.GetUser(ctx, userID)
+}
+
+func (uc *userUseCase) SetUserSkills(ctx context.Context, userID string, tags []string) ([]string, error) {
+	tags, err := entity2.NormalizeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.userRepo.SetUserSkills(ctx, userID, tags); err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+func (uc *userUseCase) AddReviewerExclusion(ctx context.Context, userID, excludedUserID, reason string) (*entity2.ReviewerExclusion, error) {
+	reason = strings.TrimSpace(reason)
+	if userID == excludedUserID {
+		return nil, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "user cannot be excluded from themselves")
+	}
+	if utf8.RuneCountInString(reason) > maxVarcharLength {
+		return nil, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "reason is too long")
+	}
+
+	for _, id := range []string{userID, excludedUserID} {
+		if _, err := uc.userRepo.GetUser(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+
+	exclusion := &entity2.ReviewerExclusion{
+		UserID:         userID,
+		ExcludedUserID: excludedUserID,
+		Reason:         reason,
+	}
+	if err := uc.userRepo.SaveReviewerExclusion(ctx, exclusion); err != nil {
+		return nil, err
+	}
+
+	return exclusion, nil
+}
+
+func (uc *userUseCase) RemoveReviewerExclusion(ctx context.Context, userID, excludedUserID string) (*entity2.ReviewerExclusion, error) {
+	return uc.userRepo.DeleteReviewerExclusion(ctx, userID, excludedUserID)
+}
+
+func (uc *userUseCase) GetReviewerExclusions(ctx context.Context, userID string) ([]*entity2.ReviewerExclusion, error) {
+	if _, err := uc.userRepo.GetUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return uc.userRepo.GetReviewerExclusions(ctx, userID)
+}
+
+func (uc *userUseCase) GetUserReviews(ctx context.Context, userID string) ([]*entity2.ReviewAssignment, error) {
 	// Проверяем существование пользователя
 	_, err := uc.userRepo.GetUser(ctx, userID)
 	if err != nil {
@@ -37,5 +160,241 @@ func (uc *userUseCase) GetUserReviews(ctx context.Context, userID string) ([]*en
 	}
 
 	// Получаем PR'ы, где пользователь назначен ревьювером
-	return uc.prRepo.GetPullRequestsByReviewer(ctx, userID)
+	assignments, err := uc.prRepo.GetReviewAssignmentsByReviewer(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := setReviewDeadlines(ctx, uc.userRepo, uc.teamRepo, assignments, time.Now()); err != nil {
+		return nil, err
+	}
+	return assignments, nil
+}
+
+func (uc *userUseCase) SetUserAvailability(ctx context.Context, userID string, startsAt, endsAt time.Time, reason string) (*entity2.UserAvailability, int64, error) {
+	if !endsAt.After(startsAt) {
+		return nil, 0, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "availability end must be after start")
+	}
+	if utf8.RuneCountInString(reason) > maxVarcharLength {
+		return nil, 0, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "reason is too long")
+	}
+
+	// Проверяем существование пользователя
+	if _, err := uc.userRepo.GetUser(ctx, userID); err != nil {
+		return nil, 0, err
+	}
+
+	availability := &entity2.UserAvailability{
+		UserID:   userID,
+		StartsAt: startsAt.UTC(),
+		EndsAt:   endsAt.UTC(),
+		Reason:   reason,
+	}
+	if err := uc.userRepo.CreateUserAvailability(ctx, availability); err != nil {
+		return nil, 0, err
+	}
+
+	// Будущие окна обработает фоновая задача в момент их начала
+	now := time.Now()
+	if !availability.ActiveAt(now) {
+		return availability, 0, nil
+	}
+
+	reassigned, err := uc.reassignOpenReviews(ctx, availability, now)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return availability, reassigned, nil
+}
+
+func (uc *userUseCase) ImportAvailabilityCalendar(ctx context.Context, calendar io.Reader) (*entity2.AvailabilityImportResult, error) {
+	if calendar == nil {
+		if uc.calendarPath == "" {
+			return nil, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "calendar is required: no server calendar path configured")
+		}
+		file, err := os.Open(uc.calendarPath)
+		if err != nil {
+			return nil, fmt.Errorf("open calendar: %w", err)
+		}
+		defer file.Close()
+		calendar = file
+	}
+
+	events, err := ical.ParseEvents(calendar)
+	if err != nil {
+		return nil, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "invalid calendar: "+err.Error())
+	}
+
+	users, err := uc.userRepo.GetAllUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	identities := userIdentities(users)
+
+	result := &entity2.AvailabilityImportResult{UnmatchedEvents: []string{}}
+	now := time.Now()
+	for _, event := range events {
+		if !event.IsOutOfOffice() {
+			result.Skipped++
+			continue
+		}
+		// Время в неизвестной зоне не угадываем: окно сдвинулось бы на несколько часов
+		if event.TimeError != nil {
+			result.UnmatchedEvents = append(result.UnmatchedEvents, describeEvent(&event)+": "+event.TimeError.Error())
+			continue
+		}
+		// Завершившиеся события не влияют на назначения
+		if !event.End.After(event.Start) || !event.End.After(now) {
+			result.Skipped++
+			continue
+		}
+
+		userIDs := matchEventUsers(&event, identities)
+		if len(userIDs) == 0 {
+			result.UnmatchedEvents = append(result.UnmatchedEvents, describeEvent(&event))
+			continue
+		}
+
+		for _, userID := range userIDs {
+			availability := &entity2.UserAvailability{
+				UserID:     userID,
+				StartsAt:   event.Start.UTC(),
+				EndsAt:     event.End.UTC(),
+				Reason:     truncate(event.Summary, maxVarcharLength),
+				ExternalID: eventExternalID(&event),
+			}
+			if err := uc.userRepo.UpsertUserAvailability(ctx, availability); err != nil {
+				return nil, err
+			}
+			result.Imported++
+		}
+	}
+
+	// Окна, которые уже начались, обрабатываем сразу, не дожидаясь фоновой задачи
+	reassigned, err := uc.ReassignStartedAbsences(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result.ReassignedPRs = reassigned
+
+	return result, nil
+}
+
+func (uc *userUseCase) ReassignStartedAbsences(ctx context.Context) (int64, error) {
+	now := time.Now()
+	availabilities, err := uc.userRepo.GetStartedAvailabilities(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	var total int64
+	for _, availability := range availabilities {
+		reassigned, err := uc.reassignOpenReviews(ctx, availability, now)
+		if err != nil {
+			return total, err
+		}
+		total += reassigned
+	}
+
+	return total, nil
+}
+
+// reassignOpenReviews переназначает открытые ревью отсутствующего пользователя и отмечает окно обработанным.
+// PR, для которых не нашлось замены, остаются за пользователем.
+func (uc *userUseCase) reassignOpenReviews(ctx context.Context, availability *entity2.UserAvailability, now time.Time) (int64, error) {
+	prs, err := uc.prRepo.GetPullRequestsByReviewer(ctx, availability.UserID)
+	if err != nil {
+		return 0, err
+	}
+
+	var reassigned int64
+	for _, pr := range prs {
+		if pr.Status != entity2.PullRequestStatusOpen {
+			continue
+		}
+
+		_, _, err := uc.prUseCase.ReassignReviewer(ctx, pr.PullRequestID, availability.UserID, "")
+		if err != nil {
+			if domainErr, ok := err.(*entity2.DomainError); ok && domainErr.Code == entity2.ErrorCodeNoCandidate {
+				continue
+			}
+			return reassigned, err
+		}
+		reassigned++
+	}
+
+	if err := uc.userRepo.MarkAvailabilityProcessed(ctx, availability.ID, now); err != nil {
+		return reassigned, err
+	}
+
+	return reassigned, nil
+}
+
+// userIdentities строит индекс user_id и username (без учета регистра) -> user_id.
+// Неоднозначные username в индекс не попадают.
+func userIdentities(users []*entity2.User) map[string]string {
+	identities := make(map[string]string, len(users)*2)
+	ambiguous := make(map[string]bool)
+	for _, user := range users {
+		username := strings.ToLower(user.Username)
+		if existing, ok := identities[username]; ok && existing != user.UserID {
+			ambiguous[username] = true
+		}
+		identities[username] = user.UserID
+	}
+	for username := range ambiguous {
+		delete(identities, username)
+	}
+	// user_id уникален и имеет приоритет над username
+	for _, user := range users {
+		identities[strings.ToLower(user.UserID)] = user.UserID
+	}
+	return identities
+}
+
+// matchEventUsers сопоставляет организатора и участников события с пользователями
+// по email, локальной части email или отображаемому имени (CN)
+func matchEventUsers(event *ical.Event, identities map[string]string) []string {
+	var userIDs []string
+	seen := make(map[string]bool)
+	for _, person := range event.People() {
+		email := strings.ToLower(person.Email)
+		localPart, _, _ := strings.Cut(email, "@")
+		for _, key := range []string{email, localPart, strings.ToLower(person.CommonName)} {
+			userID, ok := identities[key]
+			if key == "" || !ok {
+				continue
+			}
+			if !seen[userID] {
+				seen[userID] = true
+				userIDs = append(userIDs, userID)
+			}
+			break
+		}
+	}
+	return userIDs
+}
+
+// eventExternalID возвращает UID события; для событий без UID идентификатором служит период
+func eventExternalID(event *ical.Event) string {
+	if event.UID != "" {
+		return truncate(event.UID, maxVarcharLength)
+	}
+	return event.Start.UTC().Format(time.RFC3339) + "/" + event.End.UTC().Format(time.RFC3339)
+}
+
+func describeEvent(event *ical.Event) string {
+	if event.UID != "" {
+		return fmt.Sprintf("%s (%s)", event.Summary, event.UID)
+	}
+	return event.Summary
+}
+
+func truncate(value string, maxRunes int) string {
+	runes := []rune(value)
+	if len(runes) <= maxRunes {
+		return value
+	}
+	return string(runes[:maxRunes])
 }
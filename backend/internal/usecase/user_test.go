@@ -0,0 +1,215 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"strings"
+	entity2 "test_task_avito/backend/internal/entity"
+	"testing"
+	"time"
+)
+
+// newTestUserUseCase создает use case пользователей над fakeRepo с фиксированным seed
+func newTestUserUseCase(repo *fakeRepo) *userUseCase {
+	return NewUserUseCase(repo, repo, repo, newTestPullRequestUseCase(repo, 1), "").(*userUseCase)
+}
+
+// absenceRepo команда, в которой b1 ревьюит открытый pr-1 и закрытый pr-2, а заменить его может только b2
+func absenceRepo() *fakeRepo {
+	repo := newFakeRepo(member("author", "backend"), member("b1", "backend"), member("b2", "backend"))
+	repo.addPullRequest(&entity2.PullRequest{PullRequestID: "pr-1", AuthorID: "author", Status: entity2.PullRequestStatusOpen, AssignedReviewers: []string{"b1"}})
+	repo.addPullRequest(&entity2.PullRequest{PullRequestID: "pr-2", AuthorID: "author", Status: entity2.PullRequestStatusMerged, AssignedReviewers: []string{"b1"}})
+	return repo
+}
+
+func TestSetUserAvailability(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name           string
+		startsAt       time.Time
+		endsAt         time.Time
+		wantReassigned int64
+		wantReviewers  []string
+	}{
+		{name: "active window reassigns open reviews", startsAt: now.Add(-time.Hour), endsAt: now.Add(24 * time.Hour), wantReassigned: 1, wantReviewers: []string{"b2"}},
+		{name: "future window waits for its start", startsAt: now.Add(time.Hour), endsAt: now.Add(24 * time.Hour), wantReviewers: []string{"b1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := absenceRepo()
+
+			availability, reassigned, err := newTestUserUseCase(repo).SetUserAvailability(context.Background(), "b1", tt.startsAt, tt.endsAt, "vacation")
+			if err != nil {
+				t.Fatalf("set availability: %v", err)
+			}
+			if reassigned != tt.wantReassigned {
+				t.Fatalf("reassigned %d, want %d", reassigned, tt.wantReassigned)
+			}
+			if reviewers := repo.prs["pr-1"].AssignedReviewers; !slices.Equal(reviewers, tt.wantReviewers) {
+				t.Fatalf("open PR reviewers %v, want %v", reviewers, tt.wantReviewers)
+			}
+			if reviewers := repo.prs["pr-2"].AssignedReviewers; !slices.Equal(reviewers, []string{"b1"}) {
+				t.Fatalf("merged PR reviewers %v must stay unchanged", reviewers)
+			}
+			if processed := repo.processedAvailabilities[availability.ID]; processed != (tt.wantReassigned > 0) {
+				t.Fatalf("window processed = %v", processed)
+			}
+		})
+	}
+}
+
+func TestSetUserAvailabilityStoresUTC(t *testing.T) {
+	repo := absenceRepo()
+	moscow := time.FixedZone("MSK", 3*60*60)
+	startsAt := time.Date(2030, 1, 10, 10, 0, 0, 0, moscow)
+
+	availability, _, err := newTestUserUseCase(repo).SetUserAvailability(context.Background(), "b1", startsAt, startsAt.Add(8*time.Hour), "")
+	if err != nil {
+		t.Fatalf("set availability: %v", err)
+	}
+	stored := repo.availabilities[0]
+	if stored.StartsAt.Location() != time.UTC || stored.StartsAt.Hour() != 7 || stored.EndsAt.Hour() != 15 {
+		t.Fatalf("stored window %s – %s, want 07:00–15:00 UTC", stored.StartsAt, stored.EndsAt)
+	}
+	if !availability.StartsAt.Equal(startsAt) {
+		t.Fatalf("returned start %s, want %s", availability.StartsAt, startsAt)
+	}
+}
+
+func TestSetUserAvailabilityRejectsInvalidInput(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name     string
+		startsAt time.Time
+		endsAt   time.Time
+		reason   string
+	}{
+		{name: "end before start", startsAt: now, endsAt: now.Add(-time.Hour)},
+		{name: "end equals start", startsAt: now, endsAt: now},
+		{name: "reason too long", startsAt: now, endsAt: now.Add(time.Hour), reason: strings.Repeat("я", maxVarcharLength+1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := absenceRepo()
+
+			_, _, err := newTestUserUseCase(repo).SetUserAvailability(context.Background(), "b1", tt.startsAt, tt.endsAt, tt.reason)
+			if domainErr, ok := err.(*entity2.DomainError); !ok || domainErr.Code != entity2.ErrorCodeInvalidInput {
+				t.Fatalf("expected INVALID_INPUT, got %v", err)
+			}
+			if len(repo.availabilities) != 0 {
+				t.Fatalf("invalid window must not be stored, got %d", len(repo.availabilities))
+			}
+		})
+	}
+}
+
+func TestReassignStartedAbsences(t *testing.T) {
+	repo := absenceRepo()
+	now := time.Now()
+	repo.availabilities = []*entity2.UserAvailability{
+		{ID: 1, UserID: "b1", StartsAt: now.Add(-time.Minute), EndsAt: now.Add(time.Hour)},
+		{ID: 2, UserID: "b2", StartsAt: now.Add(time.Hour), EndsAt: now.Add(2 * time.Hour)},
+	}
+	uc := newTestUserUseCase(repo)
+
+	reassigned, err := uc.ReassignStartedAbsences(context.Background())
+	if err != nil {
+		t.Fatalf("first run: %v", err)
+	}
+	if reassigned != 1 || !slices.Equal(repo.prs["pr-1"].AssignedReviewers, []string{"b2"}) {
+		t.Fatalf("reassigned %d, reviewers %v, want pr-1 moved to b2", reassigned, repo.prs["pr-1"].AssignedReviewers)
+	}
+	if !repo.processedAvailabilities[1] || repo.processedAvailabilities[2] {
+		t.Fatalf("processed windows %v, want only the started one", repo.processedAvailabilities)
+	}
+
+	reassigned, err = uc.ReassignStartedAbsences(context.Background())
+	if err != nil {
+		t.Fatalf("second run: %v", err)
+	}
+	if reassigned != 0 {
+		t.Fatalf("second run reassigned %d, want 0", reassigned)
+	}
+}
+
+// vevent собирает событие отсутствия с указанными UID, периодом и участниками
+func vevent(uid, start, end string, people ...string) string {
+	event := "BEGIN:VEVENT\r\nUID:" + uid + "\r\nSUMMARY:Vacation " + uid + "\r\n" +
+		"DTSTART;VALUE=DATE:" + start + "\r\nDTEND;VALUE=DATE:" + end + "\r\nX-MICROSOFT-CDO-INTENDEDSTATUS:OOF\r\n"
+	for _, person := range people {
+		event += person + "\r\n"
+	}
+	return event + "END:VEVENT\r\n"
+}
+
+func importCalendar(t *testing.T, uc *userUseCase, events ...string) *entity2.AvailabilityImportResult {
+	t.Helper()
+	calendar := "BEGIN:VCALENDAR\r\n" + strings.Join(events, "") + "END:VCALENDAR\r\n"
+	result, err := uc.ImportAvailabilityCalendar(context.Background(), strings.NewReader(calendar))
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	return result
+}
+
+func TestImportAvailabilityCalendarMatchesPeople(t *testing.T) {
+	alice := &entity2.User{UserID: "u1", Username: "alice", TeamName: "backend", IsActive: true}
+	bob := &entity2.User{UserID: "u2", Username: "Bob", TeamName: "backend", IsActive: true}
+	carol := &entity2.User{UserID: "u3", Username: "carol", TeamName: "backend", IsActive: true}
+	repo := newFakeRepo(alice, bob, carol)
+
+	result := importCalendar(t, newTestUserUseCase(repo),
+		vevent("by-email-local-part", "20990101", "20990110", "ORGANIZER:mailto:alice@example.com"),
+		vevent("by-common-name", "20990201", "20990210", "ATTENDEE;CN=bob:mailto:robert@example.com", "ATTENDEE:mailto:U3@example.com"),
+		vevent("stranger", "20990301", "20990310", "ORGANIZER;CN=Dave:mailto:dave@example.com"),
+		vevent("finished", "20200101", "20200110", "ORGANIZER:mailto:alice@example.com"),
+		"BEGIN:VEVENT\r\nUID:meeting\r\nDTSTART:20990101T100000Z\r\nDTEND:20990101T110000Z\r\nORGANIZER:mailto:alice@example.com\r\nEND:VEVENT\r\n",
+	)
+
+	if result.Imported != 3 || result.Skipped != 2 {
+		t.Fatalf("result %+v, want 3 imported and the finished event and the meeting skipped", result)
+	}
+	if !slices.Equal(result.UnmatchedEvents, []string{"Vacation stranger (stranger)"}) {
+		t.Fatalf("unmatched %v", result.UnmatchedEvents)
+	}
+	var windows []string
+	for _, availability := range repo.availabilities {
+		windows = append(windows, availability.UserID+"/"+availability.ExternalID)
+	}
+	if !slices.Equal(windows, []string{"u1/by-email-local-part", "u2/by-common-name", "u3/by-common-name"}) {
+		t.Fatalf("windows %v", windows)
+	}
+}
+
+func TestImportAvailabilityCalendarUpdatesByExternalID(t *testing.T) {
+	repo := newFakeRepo(member("alice", "backend"))
+	uc := newTestUserUseCase(repo)
+
+	importCalendar(t, uc, vevent("vacation-1", "20990101", "20990110", "ORGANIZER:mailto:alice@example.com"))
+	result := importCalendar(t, uc, vevent("vacation-1", "20990105", "20990115", "ORGANIZER:mailto:alice@example.com"))
+
+	if result.Imported != 1 {
+		t.Fatalf("imported %d, want 1", result.Imported)
+	}
+	if len(repo.availabilities) != 1 {
+		t.Fatalf("re-import must update the window, got %d windows", len(repo.availabilities))
+	}
+	if availability := repo.availabilities[0]; availability.StartsAt.Day() != 5 || availability.EndsAt.Day() != 15 {
+		t.Fatalf("window %s – %s, want the updated period", availability.StartsAt, availability.EndsAt)
+	}
+}
+
+func TestImportAvailabilityCalendarReportsUnknownTimezone(t *testing.T) {
+	repo := newFakeRepo(member("alice", "backend"))
+
+	result := importCalendar(t, newTestUserUseCase(repo),
+		"BEGIN:VEVENT\r\nUID:outlook-1\r\nSUMMARY:Vacation\r\nDTSTART;TZID=Russian Standard Time:20990101T090000\r\n"+
+			"DTEND;TZID=Russian Standard Time:20990102T090000\r\nORGANIZER:mailto:alice@example.com\r\nX-MICROSOFT-CDO-INTENDEDSTATUS:OOF\r\nEND:VEVENT\r\n")
+
+	if result.Imported != 0 || len(repo.availabilities) != 0 {
+		t.Fatalf("event in an unknown zone must not be imported, got %+v", result)
+	}
+	if len(result.UnmatchedEvents) != 1 || !strings.Contains(result.UnmatchedEvents[0], "unknown time zone") {
+		t.Fatalf("unmatched %v, want the event with its time zone error", result.UnmatchedEvents)
+	}
+}
@@ -0,0 +1,270 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	entity2 "test_task_avito/backend/internal/entity"
+	port2 "test_task_avito/backend/internal/port"
+	"test_task_avito/backend/pkg/hmacsig"
+	"test_task_avito/backend/pkg/netguard"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// webhookMaxAttempts количество попыток доставки, после которого доставка попадает в очередь недоставленных
+	webhookMaxAttempts = 8
+	// webhookBaseBackoff задержка перед второй попыткой; каждая следующая задержка удваивается
+	webhookBaseBackoff = 30 * time.Second
+	// webhookMaxBackoff ограничение задержки между попытками
+	webhookMaxBackoff = time.Hour
+	// webhookBatchSize количество доставок, обрабатываемых за один проход
+	webhookBatchSize = 100
+	// webhookDeadLettersLimit количество недоставленных событий в выдаче
+	webhookDeadLettersLimit = 100
+	// webhookMaxErrorLength ограничение длины сохраняемой ошибки доставки
+	webhookMaxErrorLength = 1000
+)
+
+// Заголовки запроса вебхука
+const (
+	WebhookEventHeader     = "X-Webhook-Event"
+	WebhookEventIDHeader   = "X-Webhook-Event-Id"
+	WebhookDeliveryHeader  = "X-Webhook-Delivery"
+	WebhookSignatureHeader = "X-Webhook-Signature-256"
+)
+
+type webhookUseCase struct {
+	webhookRepo port2.WebhookRepository
+	sender      port2.WebhookSender
+	// allowPrivateTargets разрешает подписки на внутренние адреса (loopback, частные сети)
+	allowPrivateTargets bool
+}
+
+// NewWebhookUseCase создает новый экземпляр WebhookUseCase
+func NewWebhookUseCase(webhookRepo port2.WebhookRepository, sender port2.WebhookSender, allowPrivateTargets bool) port2.WebhookUseCase {
+	return &webhookUseCase{
+		webhookRepo:         webhookRepo,
+		sender:              sender,
+		allowPrivateTargets: allowPrivateTargets,
+	}
+}
+
+// webhookEnvelope тело запроса вебхука
+type webhookEnvelope struct {
+	ID         string                 `json:"id"`
+	Type       entity2.EventType      `json:"type"`
+	OccurredAt time.Time              `json:"occurred_at"`
+	Data       map[string]interface{} `json:"data"`
+}
+
+func (uc *webhookUseCase) Subscribe(ctx context.Context, rawURL, secret string, eventTypes []entity2.EventType) (*entity2.WebhookSubscription, error) {
+	if !isHTTPURL(rawURL) {
+		return nil, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "url must be an absolute http(s) URL")
+	}
+	if err := checkTargetURL(rawURL, uc.allowPrivateTargets); err != nil {
+		return nil, err
+	}
+	if len(eventTypes) == 0 {
+		return nil, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "at least one event type is required")
+	}
+
+	seen := make(map[entity2.EventType]bool, len(eventTypes))
+	types := make([]entity2.EventType, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		if !eventType.Valid() {
+			return nil, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "unknown event type "+strconv.Quote(string(eventType)))
+		}
+		if !seen[eventType] {
+			seen[eventType] = true
+			types = append(types, eventType)
+		}
+	}
+
+	if secret == "" {
+		var err error
+		secret, err = randomHex(32)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if utf8.RuneCountInString(secret) > maxVarcharLength {
+		return nil, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "secret is too long")
+	}
+
+	subscription := &entity2.WebhookSubscription{
+		URL:        rawURL,
+		Secret:     secret,
+		EventTypes: types,
+	}
+	if err := uc.webhookRepo.CreateWebhookSubscription(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func (uc *webhookUseCase) Unsubscribe(ctx context.Context, id int64) error {
+	return uc.webhookRepo.DeleteWebhookSubscription(ctx, id)
+}
+
+func (uc *webhookUseCase) GetSubscriptions(ctx context.Context) ([]*entity2.WebhookSubscription, error) {
+	subscriptions, err := uc.webhookRepo.GetWebhookSubscriptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, subscription := range subscriptions {
+		subscription.Secret = ""
+	}
+	return subscriptions, nil
+}
+
+func (uc *webhookUseCase) Enqueue(ctx context.Context, event *entity2.Event) error {
+	subscriptions, err := uc.webhookRepo.GetWebhookSubscriptions(ctx)
+	if err != nil {
+		return err
+	}
+
+	var payload []byte
+	deliveries := make([]*entity2.WebhookDelivery, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		if !subscription.Subscribed(event.Type) {
+			continue
+		}
+		if payload == nil {
+			payload, err = json.Marshal(webhookEnvelope{
+				ID:         event.ID,
+				Type:       event.Type,
+				OccurredAt: event.OccurredAt.UTC(),
+				Data:       event.Data,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		deliveries = append(deliveries, &entity2.WebhookDelivery{
+			SubscriptionID: subscription.ID,
+			EventID:        event.ID,
+			EventType:      event.Type,
+			Payload:        payload,
+			Status:         entity2.WebhookDeliveryPending,
+			NextAttemptAt:  event.OccurredAt,
+		})
+	}
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+	return uc.webhookRepo.CreateWebhookDeliveries(ctx, deliveries)
+}
+
+func (uc *webhookUseCase) DeliverPending(ctx context.Context) (*entity2.WebhookDeliveryResult, error) {
+	deliveries, err := uc.webhookRepo.GetDueWebhookDeliveries(ctx, time.Now(), webhookBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &entity2.WebhookDeliveryResult{}
+	for _, delivery := range deliveries {
+		if ctx.Err() != nil {
+			return result, ctx.Err()
+		}
+
+		sendErr := uc.sender.Send(ctx, delivery.URL, delivery.Payload, map[string]string{
+			"Content-Type":         "application/json",
+			WebhookEventHeader:     string(delivery.EventType),
+			WebhookEventIDHeader:   delivery.EventID,
+			WebhookDeliveryHeader:  strconv.FormatInt(delivery.ID, 10),
+			WebhookSignatureHeader: hmacsig.Sign([]byte(delivery.Secret), delivery.Payload),
+		})
+
+		now := time.Now()
+		delivery.Attempts++
+		switch {
+		case sendErr == nil:
+			delivery.Status = entity2.WebhookDeliveryDelivered
+			delivery.DeliveredAt = &now
+			delivery.LastError = ""
+			result.Delivered++
+		case delivery.Attempts >= webhookMaxAttempts:
+			delivery.Status = entity2.WebhookDeliveryDead
+			delivery.LastError = truncate(sendErr.Error(), webhookMaxErrorLength)
+			result.Dead++
+		default:
+			delivery.NextAttemptAt = now.Add(webhookBackoff(delivery.Attempts))
+			delivery.LastError = truncate(sendErr.Error(), webhookMaxErrorLength)
+			result.Retried++
+		}
+
+		if err := uc.webhookRepo.UpdateWebhookDelivery(ctx, delivery); err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+func (uc *webhookUseCase) GetDeadLetters(ctx context.Context) ([]*entity2.WebhookDelivery, error) {
+	return uc.webhookRepo.GetDeadWebhookDeliveries(ctx, webhookDeadLettersLimit)
+}
+
+func (uc *webhookUseCase) Redeliver(ctx context.Context, deliveryID int64) (*entity2.WebhookDelivery, error) {
+	delivery, err := uc.webhookRepo.GetWebhookDelivery(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	if delivery.Status != entity2.WebhookDeliveryDead {
+		return nil, entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "only dead deliveries can be redelivered")
+	}
+
+	delivery.Status = entity2.WebhookDeliveryPending
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = time.Now()
+	if err := uc.webhookRepo.UpdateWebhookDelivery(ctx, delivery); err != nil {
+		return nil, err
+	}
+
+	return delivery, nil
+}
+
+// webhookBackoff задержка перед следующей попыткой после attempts неудачных
+func webhookBackoff(attempts int) time.Duration {
+	delay := webhookBaseBackoff
+	for i := 1; i < attempts && delay < webhookMaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > webhookMaxBackoff {
+		delay = webhookMaxBackoff
+	}
+	return delay
+}
+
+// randomHex возвращает n случайных байт в шестнадцатеричной записи
+func randomHex(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// isHTTPURL проверяет, что rawURL — абсолютный http(s)-адрес
+func isHTTPURL(rawURL string) bool {
+	parsed, err := url.Parse(rawURL)
+	return err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
+
+// checkTargetURL отклоняет адрес исходящих запросов во внутреннюю сеть, если такие адреса не разрешены настройкой
+func checkTargetURL(rawURL string, allowPrivateTargets bool) error {
+	if allowPrivateTargets {
+		return nil
+	}
+	if err := netguard.CheckURL(rawURL); err != nil {
+		return entity2.NewDomainError(entity2.ErrorCodeInvalidInput, "url must not point to a loopback or private address")
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	entity2 "test_task_avito/backend/internal/entity"
+	port2 "test_task_avito/backend/internal/port"
+	"testing"
+)
+
+// fakeWebhookRepo хранилище подписок в памяти
+type fakeWebhookRepo struct {
+	port2.WebhookRepository
+
+	subscriptions []*entity2.WebhookSubscription
+}
+
+func (r *fakeWebhookRepo) CreateWebhookSubscription(_ context.Context, subscription *entity2.WebhookSubscription) error {
+	subscription.ID = int64(len(r.subscriptions) + 1)
+	r.subscriptions = append(r.subscriptions, subscription)
+	return nil
+}
+
+func TestSubscribeChecksTargetAddress(t *testing.T) {
+	tests := []struct {
+		name         string
+		url          string
+		allowPrivate bool
+		wantErr      bool
+	}{
+		{name: "public host", url: "https://bots.example.com/hook"},
+		{name: "loopback", url: "http://127.0.0.1:9000/hook", wantErr: true},
+		{name: "localhost", url: "http://localhost/hook", wantErr: true},
+		{name: "cloud metadata", url: "http://169.254.169.254/latest", wantErr: true},
+		{name: "private network", url: "http://10.0.0.7/hook", wantErr: true},
+		{name: "loopback allowed by config", url: "http://127.0.0.1:9000/hook", allowPrivate: true},
+		{name: "not http", url: "ftp://bots.example.com/hook", allowPrivate: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeWebhookRepo{}
+			uc := NewWebhookUseCase(repo, nil, tt.allowPrivate)
+
+			_, err := uc.Subscribe(context.Background(), tt.url, "", []entity2.EventType{entity2.EventReviewerAssigned})
+			if tt.wantErr {
+				if !isDomainError(err, entity2.ErrorCodeInvalidInput) || len(repo.subscriptions) != 0 {
+					t.Fatalf("error %v, subscriptions %d, want INVALID_INPUT and nothing saved", err, len(repo.subscriptions))
+				}
+				return
+			}
+			if err != nil || len(repo.subscriptions) != 1 {
+				t.Fatalf("error %v, subscriptions %d", err, len(repo.subscriptions))
+			}
+		})
+	}
+}
@@ -89,21 +89,78 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetDigestPreview request
+	GetDigestPreview(ctx context.Context, params *GetDigestPreviewParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetIntegrationsAccountsList request
+	GetIntegrationsAccountsList(ctx context.Context, params *GetIntegrationsAccountsListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostIntegrationsAccountsRemoveWithBody request with any body
+	PostIntegrationsAccountsRemoveWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostIntegrationsAccountsRemove(ctx context.Context, body PostIntegrationsAccountsRemoveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostIntegrationsAccountsSetWithBody request with any body
+	PostIntegrationsAccountsSetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostIntegrationsAccountsSet(ctx context.Context, body PostIntegrationsAccountsSetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetIntegrationsProjectsList request
+	GetIntegrationsProjectsList(ctx context.Context, params *GetIntegrationsProjectsListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostIntegrationsProjectsRemoveWithBody request with any body
+	PostIntegrationsProjectsRemoveWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostIntegrationsProjectsRemove(ctx context.Context, body PostIntegrationsProjectsRemoveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostIntegrationsProjectsSetWithBody request with any body
+	PostIntegrationsProjectsSetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostIntegrationsProjectsSet(ctx context.Context, body PostIntegrationsProjectsSetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostPullRequestAddReviewerWithBody request with any body
+	PostPullRequestAddReviewerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPullRequestAddReviewer(ctx context.Context, body PostPullRequestAddReviewerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostPullRequestCloseWithBody request with any body
+	PostPullRequestCloseWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPullRequestClose(ctx context.Context, body PostPullRequestCloseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostPullRequestCreateWithBody request with any body
 	PostPullRequestCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostPullRequestCreate(ctx context.Context, body PostPullRequestCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostPullRequestDeclineWithBody request with any body
+	PostPullRequestDeclineWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPullRequestDecline(ctx context.Context, body PostPullRequestDeclineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostPullRequestMergeWithBody request with any body
 	PostPullRequestMergeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostPullRequestMerge(ctx context.Context, body PostPullRequestMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetPullRequestOverdue request
+	GetPullRequestOverdue(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostPullRequestReassignWithBody request with any body
 	PostPullRequestReassignWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostPullRequestReassign(ctx context.Context, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostPullRequestRemoveReviewerWithBody request with any body
+	PostPullRequestRemoveReviewerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPullRequestRemoveReviewer(ctx context.Context, body PostPullRequestRemoveReviewerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostPullRequestReopenWithBody request with any body
+	PostPullRequestReopenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPullRequestReopen(ctx context.Context, body PostPullRequestReopenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetStatsReviewers request
 	GetStatsReviewers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -120,17 +177,128 @@ type ClientInterface interface {
 	// GetTeamGet request
 	GetTeamGet(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetTeamGetChatChannel request
+	GetTeamGetChatChannel(ctx context.Context, params *GetTeamGetChatChannelParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTeamGetCodeowners request
+	GetTeamGetCodeowners(ctx context.Context, params *GetTeamGetCodeownersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTeamGetHolidays request
+	GetTeamGetHolidays(ctx context.Context, params *GetTeamGetHolidaysParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTeamGetSettings request
+	GetTeamGetSettings(ctx context.Context, params *GetTeamGetSettingsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTeamRemoveChatChannelWithBody request with any body
+	PostTeamRemoveChatChannelWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTeamRemoveChatChannel(ctx context.Context, body PostTeamRemoveChatChannelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTeamSetChatChannelWithBody request with any body
+	PostTeamSetChatChannelWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTeamSetChatChannel(ctx context.Context, body PostTeamSetChatChannelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTeamSetCodeownersWithBody request with any body
+	PostTeamSetCodeownersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTeamSetCodeowners(ctx context.Context, body PostTeamSetCodeownersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTeamSetHolidaysWithBody request with any body
+	PostTeamSetHolidaysWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTeamSetHolidays(ctx context.Context, body PostTeamSetHolidaysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTeamSetSettingsWithBody request with any body
+	PostTeamSetSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTeamSetSettings(ctx context.Context, body PostTeamSetSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersAddExclusionWithBody request with any body
+	PostUsersAddExclusionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUsersAddExclusion(ctx context.Context, body PostUsersAddExclusionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsersGetExclusions request
+	GetUsersGetExclusions(ctx context.Context, params *GetUsersGetExclusionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetUsersGetReview request
 	GetUsersGetReview(ctx context.Context, params *GetUsersGetReviewParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostUsersImportAvailabilityWithBody request with any body
+	PostUsersImportAvailabilityWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUsersImportAvailability(ctx context.Context, body PostUsersImportAvailabilityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersRemoveExclusionWithBody request with any body
+	PostUsersRemoveExclusionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUsersRemoveExclusion(ctx context.Context, body PostUsersRemoveExclusionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersSetAvailabilityWithBody request with any body
+	PostUsersSetAvailabilityWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUsersSetAvailability(ctx context.Context, body PostUsersSetAvailabilityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersSetDigestWithBody request with any body
+	PostUsersSetDigestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUsersSetDigest(ctx context.Context, body PostUsersSetDigestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostUsersSetIsActiveWithBody request with any body
 	PostUsersSetIsActiveWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostUsersSetIsActive(ctx context.Context, body PostUsersSetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersSetLevelWithBody request with any body
+	PostUsersSetLevelWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUsersSetLevel(ctx context.Context, body PostUsersSetLevelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersSetMaxOpenReviewsWithBody request with any body
+	PostUsersSetMaxOpenReviewsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUsersSetMaxOpenReviews(ctx context.Context, body PostUsersSetMaxOpenReviewsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersSetReviewWeightWithBody request with any body
+	PostUsersSetReviewWeightWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUsersSetReviewWeight(ctx context.Context, body PostUsersSetReviewWeightJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersSetScheduleWithBody request with any body
+	PostUsersSetScheduleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUsersSetSchedule(ctx context.Context, body PostUsersSetScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersSetSkillsWithBody request with any body
+	PostUsersSetSkillsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUsersSetSkills(ctx context.Context, body PostUsersSetSkillsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhooksDeadLetters request
+	GetWebhooksDeadLetters(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhooksList request
+	GetWebhooksList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostWebhooksRedeliverWithBody request with any body
+	PostWebhooksRedeliverWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostWebhooksRedeliver(ctx context.Context, body PostWebhooksRedeliverJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostWebhooksSubscribeWithBody request with any body
+	PostWebhooksSubscribeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostWebhooksSubscribe(ctx context.Context, body PostWebhooksSubscribeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostWebhooksUnsubscribeWithBody request with any body
+	PostWebhooksUnsubscribeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostWebhooksUnsubscribe(ctx context.Context, body PostWebhooksUnsubscribeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) PostPullRequestCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostPullRequestCreateRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetDigestPreview(ctx context.Context, params *GetDigestPreviewParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDigestPreviewRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -141,8 +309,8 @@ func (c *Client) PostPullRequestCreateWithBody(ctx context.Context, contentType
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostPullRequestCreate(ctx context.Context, body PostPullRequestCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostPullRequestCreateRequest(c.Server, body)
+func (c *Client) GetIntegrationsAccountsList(ctx context.Context, params *GetIntegrationsAccountsListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetIntegrationsAccountsListRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -153,8 +321,8 @@ func (c *Client) PostPullRequestCreate(ctx context.Context, body PostPullRequest
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostPullRequestMergeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostPullRequestMergeRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostIntegrationsAccountsRemoveWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostIntegrationsAccountsRemoveRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -165,8 +333,8 @@ func (c *Client) PostPullRequestMergeWithBody(ctx context.Context, contentType s
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostPullRequestMerge(ctx context.Context, body PostPullRequestMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostPullRequestMergeRequest(c.Server, body)
+func (c *Client) PostIntegrationsAccountsRemove(ctx context.Context, body PostIntegrationsAccountsRemoveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostIntegrationsAccountsRemoveRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -177,8 +345,8 @@ func (c *Client) PostPullRequestMerge(ctx context.Context, body PostPullRequestM
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostPullRequestReassignWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostPullRequestReassignRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostIntegrationsAccountsSetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostIntegrationsAccountsSetRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -189,8 +357,8 @@ func (c *Client) PostPullRequestReassignWithBody(ctx context.Context, contentTyp
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostPullRequestReassign(ctx context.Context, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostPullRequestReassignRequest(c.Server, body)
+func (c *Client) PostIntegrationsAccountsSet(ctx context.Context, body PostIntegrationsAccountsSetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostIntegrationsAccountsSetRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -201,8 +369,8 @@ func (c *Client) PostPullRequestReassign(ctx context.Context, body PostPullReque
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetStatsReviewers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetStatsReviewersRequest(c.Server)
+func (c *Client) GetIntegrationsProjectsList(ctx context.Context, params *GetIntegrationsProjectsListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetIntegrationsProjectsListRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -213,8 +381,8 @@ func (c *Client) GetStatsReviewers(ctx context.Context, reqEditors ...RequestEdi
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostTeamAddWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostTeamAddRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostIntegrationsProjectsRemoveWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostIntegrationsProjectsRemoveRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -225,8 +393,8 @@ func (c *Client) PostTeamAddWithBody(ctx context.Context, contentType string, bo
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostTeamAdd(ctx context.Context, body PostTeamAddJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostTeamAddRequest(c.Server, body)
+func (c *Client) PostIntegrationsProjectsRemove(ctx context.Context, body PostIntegrationsProjectsRemoveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostIntegrationsProjectsRemoveRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -237,8 +405,8 @@ func (c *Client) PostTeamAdd(ctx context.Context, body PostTeamAddJSONRequestBod
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostTeamDeactivateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostTeamDeactivateRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostIntegrationsProjectsSetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostIntegrationsProjectsSetRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -249,8 +417,8 @@ func (c *Client) PostTeamDeactivateWithBody(ctx context.Context, contentType str
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostTeamDeactivate(ctx context.Context, body PostTeamDeactivateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostTeamDeactivateRequest(c.Server, body)
+func (c *Client) PostIntegrationsProjectsSet(ctx context.Context, body PostIntegrationsProjectsSetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostIntegrationsProjectsSetRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -261,8 +429,8 @@ func (c *Client) PostTeamDeactivate(ctx context.Context, body PostTeamDeactivate
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetTeamGet(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTeamGetRequest(c.Server, params)
+func (c *Client) PostPullRequestAddReviewerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestAddReviewerRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -273,8 +441,8 @@ func (c *Client) GetTeamGet(ctx context.Context, params *GetTeamGetParams, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetUsersGetReview(ctx context.Context, params *GetUsersGetReviewParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetUsersGetReviewRequest(c.Server, params)
+func (c *Client) PostPullRequestAddReviewer(ctx context.Context, body PostPullRequestAddReviewerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestAddReviewerRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -285,8 +453,8 @@ func (c *Client) GetUsersGetReview(ctx context.Context, params *GetUsersGetRevie
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostUsersSetIsActiveWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostUsersSetIsActiveRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostPullRequestCloseWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestCloseRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -297,8 +465,8 @@ func (c *Client) PostUsersSetIsActiveWithBody(ctx context.Context, contentType s
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostUsersSetIsActive(ctx context.Context, body PostUsersSetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostUsersSetIsActiveRequest(c.Server, body)
+func (c *Client) PostPullRequestClose(ctx context.Context, body PostPullRequestCloseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestCloseRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -309,852 +477,6308 @@ func (c *Client) PostUsersSetIsActive(ctx context.Context, body PostUsersSetIsAc
 	return c.Client.Do(req)
 }
 
-// NewPostPullRequestCreateRequest calls the generic PostPullRequestCreate builder with application/json body
-func NewPostPullRequestCreateRequest(server string, body PostPullRequestCreateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostPullRequestCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestCreateRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostPullRequestCreateRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostPullRequestCreateRequestWithBody generates requests for PostPullRequestCreate with any type of body
-func NewPostPullRequestCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostPullRequestCreate(ctx context.Context, body PostPullRequestCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestCreateRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/pullRequest/create")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostPullRequestDeclineWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestDeclineRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostPullRequestMergeRequest calls the generic PostPullRequestMerge builder with application/json body
-func NewPostPullRequestMergeRequest(server string, body PostPullRequestMergeJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostPullRequestDecline(ctx context.Context, body PostPullRequestDeclineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestDeclineRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostPullRequestMergeRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostPullRequestMergeRequestWithBody generates requests for PostPullRequestMerge with any type of body
-func NewPostPullRequestMergeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostPullRequestMergeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestMergeRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/pullRequest/merge")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostPullRequestMerge(ctx context.Context, body PostPullRequestMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestMergeRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostPullRequestReassignRequest calls the generic PostPullRequestReassign builder with application/json body
-func NewPostPullRequestReassignRequest(server string, body PostPullRequestReassignJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetPullRequestOverdue(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPullRequestOverdueRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostPullRequestReassignRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostPullRequestReassignRequestWithBody generates requests for PostPullRequestReassign with any type of body
-func NewPostPullRequestReassignRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostPullRequestReassignWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestReassignRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/pullRequest/reassign")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostPullRequestReassign(ctx context.Context, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestReassignRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetStatsReviewersRequest generates requests for GetStatsReviewers
-func NewGetStatsReviewersRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostPullRequestRemoveReviewerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestRemoveReviewerRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/stats/reviewers")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostPullRequestRemoveReviewer(ctx context.Context, body PostPullRequestRemoveReviewerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestRemoveReviewerRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostTeamAddRequest calls the generic PostTeamAdd builder with application/json body
-func NewPostTeamAddRequest(server string, body PostTeamAddJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostPullRequestReopenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestReopenRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostTeamAddRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostTeamAddRequestWithBody generates requests for PostTeamAdd with any type of body
-func NewPostTeamAddRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostPullRequestReopen(ctx context.Context, body PostPullRequestReopenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestReopenRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/team/add")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetStatsReviewers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStatsReviewersRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) PostTeamAddWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamAddRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostTeamDeactivateRequest calls the generic PostTeamDeactivate builder with application/json body
-func NewPostTeamDeactivateRequest(server string, body PostTeamDeactivateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostTeamAdd(ctx context.Context, body PostTeamAddJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamAddRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostTeamDeactivateRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostTeamDeactivateRequestWithBody generates requests for PostTeamDeactivate with any type of body
-func NewPostTeamDeactivateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostTeamDeactivateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamDeactivateRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/team/deactivate")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostTeamDeactivate(ctx context.Context, body PostTeamDeactivateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamDeactivateRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) GetTeamGet(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTeamGetRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetTeamGetRequest generates requests for GetTeamGet
-func NewGetTeamGetRequest(server string, params *GetTeamGetParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetTeamGetChatChannel(ctx context.Context, params *GetTeamGetChatChannelParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTeamGetChatChannelRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/team/get")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetTeamGetCodeowners(ctx context.Context, params *GetTeamGetCodeownersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTeamGetCodeownersRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "team_name", runtime.ParamLocationQuery, params.TeamName); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetTeamGetHolidays(ctx context.Context, params *GetTeamGetHolidaysParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTeamGetHolidaysRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetUsersGetReviewRequest generates requests for GetUsersGetReview
-func NewGetUsersGetReviewRequest(server string, params *GetUsersGetReviewParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetTeamGetSettings(ctx context.Context, params *GetTeamGetSettingsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTeamGetSettingsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/users/getReview")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostTeamRemoveChatChannelWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamRemoveChatChannelRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) PostTeamRemoveChatChannel(ctx context.Context, body PostTeamRemoveChatChannelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamRemoveChatChannelRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostUsersSetIsActiveRequest calls the generic PostUsersSetIsActive builder with application/json body
-func NewPostUsersSetIsActiveRequest(server string, body PostUsersSetIsActiveJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostTeamSetChatChannelWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamSetChatChannelRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostUsersSetIsActiveRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostUsersSetIsActiveRequestWithBody generates requests for PostUsersSetIsActive with any type of body
-func NewPostUsersSetIsActiveRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostTeamSetChatChannel(ctx context.Context, body PostTeamSetChatChannelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamSetChatChannelRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/users/setIsActive")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostTeamSetCodeownersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamSetCodeownersRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) PostTeamSetCodeowners(ctx context.Context, body PostTeamSetCodeownersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamSetCodeownersRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+func (c *Client) PostTeamSetHolidaysWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamSetHolidaysRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+func (c *Client) PostTeamSetHolidays(ctx context.Context, body PostTeamSetHolidaysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamSetHolidaysRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return nil
+	return c.Client.Do(req)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+func (c *Client) PostTeamSetSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamSetSettingsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+func (c *Client) PostTeamSetSettings(ctx context.Context, body PostTeamSetSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamSetSettingsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+func (c *Client) PostUsersAddExclusionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersAddExclusionRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// PostPullRequestCreateWithBodyWithResponse request with any body
-	PostPullRequestCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error)
-
-	PostPullRequestCreateWithResponse(ctx context.Context, body PostPullRequestCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error)
-
-	// PostPullRequestMergeWithBodyWithResponse request with any body
-	PostPullRequestMergeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error)
-
-	PostPullRequestMergeWithResponse(ctx context.Context, body PostPullRequestMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error)
-
-	// PostPullRequestReassignWithBodyWithResponse request with any body
-	PostPullRequestReassignWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error)
-
-	PostPullRequestReassignWithResponse(ctx context.Context, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error)
-
-	// GetStatsReviewersWithResponse request
-	GetStatsReviewersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatsReviewersResponse, error)
-
-	// PostTeamAddWithBodyWithResponse request with any body
-	PostTeamAddWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error)
-
-	PostTeamAddWithResponse(ctx context.Context, body PostTeamAddJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error)
-
-	// PostTeamDeactivateWithBodyWithResponse request with any body
-	PostTeamDeactivateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamDeactivateResponse, error)
-
-	PostTeamDeactivateWithResponse(ctx context.Context, body PostTeamDeactivateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamDeactivateResponse, error)
-
-	// GetTeamGetWithResponse request
-	GetTeamGetWithResponse(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*GetTeamGetResponse, error)
-
-	// GetUsersGetReviewWithResponse request
-	GetUsersGetReviewWithResponse(ctx context.Context, params *GetUsersGetReviewParams, reqEditors ...RequestEditorFn) (*GetUsersGetReviewResponse, error)
-
-	// PostUsersSetIsActiveWithBodyWithResponse request with any body
-	PostUsersSetIsActiveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error)
-
-	PostUsersSetIsActiveWithResponse(ctx context.Context, body PostUsersSetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error)
-}
-
-type PostPullRequestCreateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *struct {
-		Pr *PullRequest `json:"pr,omitempty"`
+func (c *Client) PostUsersAddExclusion(ctx context.Context, body PostUsersAddExclusionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersAddExclusionRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	JSON404 *ErrorResponse
-	JSON409 *ErrorResponse
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostPullRequestCreateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) GetUsersGetExclusions(ctx context.Context, params *GetUsersGetExclusionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersGetExclusionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostPullRequestCreateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) GetUsersGetReview(ctx context.Context, params *GetUsersGetReviewParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersGetReviewRequest(c.Server, params)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type PostPullRequestMergeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Pr *PullRequest `json:"pr,omitempty"`
+func (c *Client) PostUsersImportAvailabilityWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersImportAvailabilityRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	JSON404 *ErrorResponse
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostPullRequestMergeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) PostUsersImportAvailability(ctx context.Context, body PostUsersImportAvailabilityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersImportAvailabilityRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostPullRequestMergeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) PostUsersRemoveExclusionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersRemoveExclusionRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type PostPullRequestReassignResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Pr PullRequest `json:"pr"`
-
-		// ReplacedBy user_id нового ревьювера
-		ReplacedBy string `json:"replaced_by"`
+func (c *Client) PostUsersRemoveExclusion(ctx context.Context, body PostUsersRemoveExclusionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersRemoveExclusionRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	JSON404 *ErrorResponse
-	JSON409 *ErrorResponse
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostPullRequestReassignResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) PostUsersSetAvailabilityWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetAvailabilityRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostPullRequestReassignResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) PostUsersSetAvailability(ctx context.Context, body PostUsersSetAvailabilityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetAvailabilityRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type GetStatsReviewersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ReviewerStatsResponse
+func (c *Client) PostUsersSetDigestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetDigestRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetStatsReviewersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) PostUsersSetDigest(ctx context.Context, body PostUsersSetDigestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetDigestRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetStatsReviewersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) PostUsersSetIsActiveWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetIsActiveRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type PostTeamAddResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *struct {
-		Team *Team `json:"team,omitempty"`
+func (c *Client) PostUsersSetIsActive(ctx context.Context, body PostUsersSetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetIsActiveRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	JSON400 *ErrorResponse
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostTeamAddResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) PostUsersSetLevelWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetLevelRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostTeamAddResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) PostUsersSetLevel(ctx context.Context, body PostUsersSetLevelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetLevelRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type PostTeamDeactivateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TeamDeactivateResult
-	JSON404      *ErrorResponse
-	JSON409      *ErrorResponse
+func (c *Client) PostUsersSetMaxOpenReviewsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetMaxOpenReviewsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostTeamDeactivateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) PostUsersSetMaxOpenReviews(ctx context.Context, body PostUsersSetMaxOpenReviewsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetMaxOpenReviewsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostTeamDeactivateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) PostUsersSetReviewWeightWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetReviewWeightRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type GetTeamGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Team
-	JSON404      *ErrorResponse
+func (c *Client) PostUsersSetReviewWeight(ctx context.Context, body PostUsersSetReviewWeightJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetReviewWeightRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetTeamGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) PostUsersSetScheduleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetScheduleRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTeamGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) PostUsersSetSchedule(ctx context.Context, body PostUsersSetScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetScheduleRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type GetUsersGetReviewResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		PullRequests []PullRequestShort `json:"pull_requests"`
-		UserId       string             `json:"user_id"`
+func (c *Client) PostUsersSetSkillsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetSkillsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	JSON404 *ErrorResponse
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetUsersGetReviewResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) PostUsersSetSkills(ctx context.Context, body PostUsersSetSkillsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetSkillsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetUsersGetReviewResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) GetWebhooksDeadLetters(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhooksDeadLettersRequest(c.Server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type PostUsersSetIsActiveResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		User *User `json:"user,omitempty"`
+func (c *Client) GetWebhooksList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhooksListRequest(c.Server)
+	if err != nil {
+		return nil, err
 	}
-	JSON401 *ErrorResponse
-	JSON404 *ErrorResponse
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostUsersSetIsActiveResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) PostWebhooksRedeliverWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksRedeliverRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostUsersSetIsActiveResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) PostWebhooksRedeliver(ctx context.Context, body PostWebhooksRedeliverJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksRedeliverRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// PostPullRequestCreateWithBodyWithResponse request with arbitrary body returning *PostPullRequestCreateResponse
-func (c *ClientWithResponses) PostPullRequestCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error) {
-	rsp, err := c.PostPullRequestCreateWithBody(ctx, contentType, body, reqEditors...)
+func (c *Client) PostWebhooksSubscribeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksSubscribeRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostPullRequestCreateResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *ClientWithResponses) PostPullRequestCreateWithResponse(ctx context.Context, body PostPullRequestCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error) {
-	rsp, err := c.PostPullRequestCreate(ctx, body, reqEditors...)
+func (c *Client) PostWebhooksSubscribe(ctx context.Context, body PostWebhooksSubscribeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksSubscribeRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostPullRequestCreateResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// PostPullRequestMergeWithBodyWithResponse request with arbitrary body returning *PostPullRequestMergeResponse
-func (c *ClientWithResponses) PostPullRequestMergeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error) {
-	rsp, err := c.PostPullRequestMergeWithBody(ctx, contentType, body, reqEditors...)
+func (c *Client) PostWebhooksUnsubscribeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksUnsubscribeRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostPullRequestMergeResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *ClientWithResponses) PostPullRequestMergeWithResponse(ctx context.Context, body PostPullRequestMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error) {
-	rsp, err := c.PostPullRequestMerge(ctx, body, reqEditors...)
+func (c *Client) PostWebhooksUnsubscribe(ctx context.Context, body PostWebhooksUnsubscribeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksUnsubscribeRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostPullRequestMergeResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// PostPullRequestReassignWithBodyWithResponse request with arbitrary body returning *PostPullRequestReassignResponse
-func (c *ClientWithResponses) PostPullRequestReassignWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error) {
-	rsp, err := c.PostPullRequestReassignWithBody(ctx, contentType, body, reqEditors...)
+// NewGetDigestPreviewRequest generates requests for GetDigestPreview
+func NewGetDigestPreviewRequest(server string, params *GetDigestPreviewParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostPullRequestReassignResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostPullRequestReassignWithResponse(ctx context.Context, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error) {
-	rsp, err := c.PostPullRequestReassign(ctx, body, reqEditors...)
+	operationPath := fmt.Sprintf("/digest/preview")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostPullRequestReassignResponse(rsp)
-}
 
-// GetStatsReviewersWithResponse request returning *GetStatsReviewersResponse
-func (c *ClientWithResponses) GetStatsReviewersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatsReviewersResponse, error) {
-	rsp, err := c.GetStatsReviewers(ctx, reqEditors...)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetStatsReviewersResponse(rsp)
+
+	return req, nil
 }
 
-// PostTeamAddWithBodyWithResponse request with arbitrary body returning *PostTeamAddResponse
-func (c *ClientWithResponses) PostTeamAddWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error) {
-	rsp, err := c.PostTeamAddWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// NewGetIntegrationsAccountsListRequest generates requests for GetIntegrationsAccountsList
+func NewGetIntegrationsAccountsListRequest(server string, params *GetIntegrationsAccountsListParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/integrations/accounts/list")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "provider", runtime.ParamLocationQuery, params.Provider); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostIntegrationsAccountsRemoveRequest calls the generic PostIntegrationsAccountsRemove builder with application/json body
+func NewPostIntegrationsAccountsRemoveRequest(server string, body PostIntegrationsAccountsRemoveJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostIntegrationsAccountsRemoveRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostIntegrationsAccountsRemoveRequestWithBody generates requests for PostIntegrationsAccountsRemove with any type of body
+func NewPostIntegrationsAccountsRemoveRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/integrations/accounts/remove")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostIntegrationsAccountsSetRequest calls the generic PostIntegrationsAccountsSet builder with application/json body
+func NewPostIntegrationsAccountsSetRequest(server string, body PostIntegrationsAccountsSetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostIntegrationsAccountsSetRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostIntegrationsAccountsSetRequestWithBody generates requests for PostIntegrationsAccountsSet with any type of body
+func NewPostIntegrationsAccountsSetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/integrations/accounts/set")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetIntegrationsProjectsListRequest generates requests for GetIntegrationsProjectsList
+func NewGetIntegrationsProjectsListRequest(server string, params *GetIntegrationsProjectsListParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/integrations/projects/list")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "provider", runtime.ParamLocationQuery, params.Provider); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostIntegrationsProjectsRemoveRequest calls the generic PostIntegrationsProjectsRemove builder with application/json body
+func NewPostIntegrationsProjectsRemoveRequest(server string, body PostIntegrationsProjectsRemoveJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostIntegrationsProjectsRemoveRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostIntegrationsProjectsRemoveRequestWithBody generates requests for PostIntegrationsProjectsRemove with any type of body
+func NewPostIntegrationsProjectsRemoveRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/integrations/projects/remove")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostIntegrationsProjectsSetRequest calls the generic PostIntegrationsProjectsSet builder with application/json body
+func NewPostIntegrationsProjectsSetRequest(server string, body PostIntegrationsProjectsSetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostIntegrationsProjectsSetRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostIntegrationsProjectsSetRequestWithBody generates requests for PostIntegrationsProjectsSet with any type of body
+func NewPostIntegrationsProjectsSetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/integrations/projects/set")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostPullRequestAddReviewerRequest calls the generic PostPullRequestAddReviewer builder with application/json body
+func NewPostPullRequestAddReviewerRequest(server string, body PostPullRequestAddReviewerJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestAddReviewerRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPullRequestAddReviewerRequestWithBody generates requests for PostPullRequestAddReviewer with any type of body
+func NewPostPullRequestAddReviewerRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/addReviewer")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostPullRequestCloseRequest calls the generic PostPullRequestClose builder with application/json body
+func NewPostPullRequestCloseRequest(server string, body PostPullRequestCloseJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestCloseRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPullRequestCloseRequestWithBody generates requests for PostPullRequestClose with any type of body
+func NewPostPullRequestCloseRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/close")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostPullRequestCreateRequest calls the generic PostPullRequestCreate builder with application/json body
+func NewPostPullRequestCreateRequest(server string, body PostPullRequestCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestCreateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPullRequestCreateRequestWithBody generates requests for PostPullRequestCreate with any type of body
+func NewPostPullRequestCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/create")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostPullRequestDeclineRequest calls the generic PostPullRequestDecline builder with application/json body
+func NewPostPullRequestDeclineRequest(server string, body PostPullRequestDeclineJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestDeclineRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPullRequestDeclineRequestWithBody generates requests for PostPullRequestDecline with any type of body
+func NewPostPullRequestDeclineRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/decline")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostPullRequestMergeRequest calls the generic PostPullRequestMerge builder with application/json body
+func NewPostPullRequestMergeRequest(server string, body PostPullRequestMergeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestMergeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPullRequestMergeRequestWithBody generates requests for PostPullRequestMerge with any type of body
+func NewPostPullRequestMergeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/merge")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPullRequestOverdueRequest generates requests for GetPullRequestOverdue
+func NewGetPullRequestOverdueRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/overdue")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostPullRequestReassignRequest calls the generic PostPullRequestReassign builder with application/json body
+func NewPostPullRequestReassignRequest(server string, body PostPullRequestReassignJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestReassignRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPullRequestReassignRequestWithBody generates requests for PostPullRequestReassign with any type of body
+func NewPostPullRequestReassignRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/reassign")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostPullRequestRemoveReviewerRequest calls the generic PostPullRequestRemoveReviewer builder with application/json body
+func NewPostPullRequestRemoveReviewerRequest(server string, body PostPullRequestRemoveReviewerJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestRemoveReviewerRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPullRequestRemoveReviewerRequestWithBody generates requests for PostPullRequestRemoveReviewer with any type of body
+func NewPostPullRequestRemoveReviewerRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/removeReviewer")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostPullRequestReopenRequest calls the generic PostPullRequestReopen builder with application/json body
+func NewPostPullRequestReopenRequest(server string, body PostPullRequestReopenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestReopenRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPullRequestReopenRequestWithBody generates requests for PostPullRequestReopen with any type of body
+func NewPostPullRequestReopenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/reopen")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetStatsReviewersRequest generates requests for GetStatsReviewers
+func NewGetStatsReviewersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stats/reviewers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostTeamAddRequest calls the generic PostTeamAdd builder with application/json body
+func NewPostTeamAddRequest(server string, body PostTeamAddJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTeamAddRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTeamAddRequestWithBody generates requests for PostTeamAdd with any type of body
+func NewPostTeamAddRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/add")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostTeamDeactivateRequest calls the generic PostTeamDeactivate builder with application/json body
+func NewPostTeamDeactivateRequest(server string, body PostTeamDeactivateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTeamDeactivateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTeamDeactivateRequestWithBody generates requests for PostTeamDeactivate with any type of body
+func NewPostTeamDeactivateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/deactivate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTeamGetRequest generates requests for GetTeamGet
+func NewGetTeamGetRequest(server string, params *GetTeamGetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/get")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "team_name", runtime.ParamLocationQuery, params.TeamName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTeamGetChatChannelRequest generates requests for GetTeamGetChatChannel
+func NewGetTeamGetChatChannelRequest(server string, params *GetTeamGetChatChannelParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/getChatChannel")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "team_name", runtime.ParamLocationQuery, params.TeamName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTeamGetCodeownersRequest generates requests for GetTeamGetCodeowners
+func NewGetTeamGetCodeownersRequest(server string, params *GetTeamGetCodeownersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/getCodeowners")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "team_name", runtime.ParamLocationQuery, params.TeamName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTeamGetHolidaysRequest generates requests for GetTeamGetHolidays
+func NewGetTeamGetHolidaysRequest(server string, params *GetTeamGetHolidaysParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/getHolidays")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "team_name", runtime.ParamLocationQuery, params.TeamName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTeamGetSettingsRequest generates requests for GetTeamGetSettings
+func NewGetTeamGetSettingsRequest(server string, params *GetTeamGetSettingsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/getSettings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "team_name", runtime.ParamLocationQuery, params.TeamName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostTeamRemoveChatChannelRequest calls the generic PostTeamRemoveChatChannel builder with application/json body
+func NewPostTeamRemoveChatChannelRequest(server string, body PostTeamRemoveChatChannelJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTeamRemoveChatChannelRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTeamRemoveChatChannelRequestWithBody generates requests for PostTeamRemoveChatChannel with any type of body
+func NewPostTeamRemoveChatChannelRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/removeChatChannel")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostTeamSetChatChannelRequest calls the generic PostTeamSetChatChannel builder with application/json body
+func NewPostTeamSetChatChannelRequest(server string, body PostTeamSetChatChannelJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTeamSetChatChannelRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTeamSetChatChannelRequestWithBody generates requests for PostTeamSetChatChannel with any type of body
+func NewPostTeamSetChatChannelRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/setChatChannel")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostTeamSetCodeownersRequest calls the generic PostTeamSetCodeowners builder with application/json body
+func NewPostTeamSetCodeownersRequest(server string, body PostTeamSetCodeownersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTeamSetCodeownersRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTeamSetCodeownersRequestWithBody generates requests for PostTeamSetCodeowners with any type of body
+func NewPostTeamSetCodeownersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/setCodeowners")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostTeamSetHolidaysRequest calls the generic PostTeamSetHolidays builder with application/json body
+func NewPostTeamSetHolidaysRequest(server string, body PostTeamSetHolidaysJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTeamSetHolidaysRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTeamSetHolidaysRequestWithBody generates requests for PostTeamSetHolidays with any type of body
+func NewPostTeamSetHolidaysRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/setHolidays")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostTeamSetSettingsRequest calls the generic PostTeamSetSettings builder with application/json body
+func NewPostTeamSetSettingsRequest(server string, body PostTeamSetSettingsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTeamSetSettingsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTeamSetSettingsRequestWithBody generates requests for PostTeamSetSettings with any type of body
+func NewPostTeamSetSettingsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/setSettings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostUsersAddExclusionRequest calls the generic PostUsersAddExclusion builder with application/json body
+func NewPostUsersAddExclusionRequest(server string, body PostUsersAddExclusionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersAddExclusionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersAddExclusionRequestWithBody generates requests for PostUsersAddExclusion with any type of body
+func NewPostUsersAddExclusionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/addExclusion")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUsersGetExclusionsRequest generates requests for GetUsersGetExclusions
+func NewGetUsersGetExclusionsRequest(server string, params *GetUsersGetExclusionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/getExclusions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUsersGetReviewRequest generates requests for GetUsersGetReview
+func NewGetUsersGetReviewRequest(server string, params *GetUsersGetReviewParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/getReview")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostUsersImportAvailabilityRequest calls the generic PostUsersImportAvailability builder with application/json body
+func NewPostUsersImportAvailabilityRequest(server string, body PostUsersImportAvailabilityJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersImportAvailabilityRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersImportAvailabilityRequestWithBody generates requests for PostUsersImportAvailability with any type of body
+func NewPostUsersImportAvailabilityRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/importAvailability")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostUsersRemoveExclusionRequest calls the generic PostUsersRemoveExclusion builder with application/json body
+func NewPostUsersRemoveExclusionRequest(server string, body PostUsersRemoveExclusionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersRemoveExclusionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersRemoveExclusionRequestWithBody generates requests for PostUsersRemoveExclusion with any type of body
+func NewPostUsersRemoveExclusionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/removeExclusion")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostUsersSetAvailabilityRequest calls the generic PostUsersSetAvailability builder with application/json body
+func NewPostUsersSetAvailabilityRequest(server string, body PostUsersSetAvailabilityJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersSetAvailabilityRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersSetAvailabilityRequestWithBody generates requests for PostUsersSetAvailability with any type of body
+func NewPostUsersSetAvailabilityRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/setAvailability")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostUsersSetDigestRequest calls the generic PostUsersSetDigest builder with application/json body
+func NewPostUsersSetDigestRequest(server string, body PostUsersSetDigestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersSetDigestRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersSetDigestRequestWithBody generates requests for PostUsersSetDigest with any type of body
+func NewPostUsersSetDigestRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/setDigest")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostUsersSetIsActiveRequest calls the generic PostUsersSetIsActive builder with application/json body
+func NewPostUsersSetIsActiveRequest(server string, body PostUsersSetIsActiveJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersSetIsActiveRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersSetIsActiveRequestWithBody generates requests for PostUsersSetIsActive with any type of body
+func NewPostUsersSetIsActiveRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/setIsActive")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostUsersSetLevelRequest calls the generic PostUsersSetLevel builder with application/json body
+func NewPostUsersSetLevelRequest(server string, body PostUsersSetLevelJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersSetLevelRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersSetLevelRequestWithBody generates requests for PostUsersSetLevel with any type of body
+func NewPostUsersSetLevelRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/setLevel")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostUsersSetMaxOpenReviewsRequest calls the generic PostUsersSetMaxOpenReviews builder with application/json body
+func NewPostUsersSetMaxOpenReviewsRequest(server string, body PostUsersSetMaxOpenReviewsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersSetMaxOpenReviewsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersSetMaxOpenReviewsRequestWithBody generates requests for PostUsersSetMaxOpenReviews with any type of body
+func NewPostUsersSetMaxOpenReviewsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/setMaxOpenReviews")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostUsersSetReviewWeightRequest calls the generic PostUsersSetReviewWeight builder with application/json body
+func NewPostUsersSetReviewWeightRequest(server string, body PostUsersSetReviewWeightJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersSetReviewWeightRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersSetReviewWeightRequestWithBody generates requests for PostUsersSetReviewWeight with any type of body
+func NewPostUsersSetReviewWeightRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/setReviewWeight")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostUsersSetScheduleRequest calls the generic PostUsersSetSchedule builder with application/json body
+func NewPostUsersSetScheduleRequest(server string, body PostUsersSetScheduleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersSetScheduleRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersSetScheduleRequestWithBody generates requests for PostUsersSetSchedule with any type of body
+func NewPostUsersSetScheduleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/setSchedule")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostUsersSetSkillsRequest calls the generic PostUsersSetSkills builder with application/json body
+func NewPostUsersSetSkillsRequest(server string, body PostUsersSetSkillsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersSetSkillsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersSetSkillsRequestWithBody generates requests for PostUsersSetSkills with any type of body
+func NewPostUsersSetSkillsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/setSkills")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetWebhooksDeadLettersRequest generates requests for GetWebhooksDeadLetters
+func NewGetWebhooksDeadLettersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/deadLetters")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWebhooksListRequest generates requests for GetWebhooksList
+func NewGetWebhooksListRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/list")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostWebhooksRedeliverRequest calls the generic PostWebhooksRedeliver builder with application/json body
+func NewPostWebhooksRedeliverRequest(server string, body PostWebhooksRedeliverJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostWebhooksRedeliverRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostWebhooksRedeliverRequestWithBody generates requests for PostWebhooksRedeliver with any type of body
+func NewPostWebhooksRedeliverRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/redeliver")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostWebhooksSubscribeRequest calls the generic PostWebhooksSubscribe builder with application/json body
+func NewPostWebhooksSubscribeRequest(server string, body PostWebhooksSubscribeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostWebhooksSubscribeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostWebhooksSubscribeRequestWithBody generates requests for PostWebhooksSubscribe with any type of body
+func NewPostWebhooksSubscribeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/subscribe")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostWebhooksUnsubscribeRequest calls the generic PostWebhooksUnsubscribe builder with application/json body
+func NewPostWebhooksUnsubscribeRequest(server string, body PostWebhooksUnsubscribeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostWebhooksUnsubscribeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostWebhooksUnsubscribeRequestWithBody generates requests for PostWebhooksUnsubscribe with any type of body
+func NewPostWebhooksUnsubscribeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/unsubscribe")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetDigestPreviewWithResponse request
+	GetDigestPreviewWithResponse(ctx context.Context, params *GetDigestPreviewParams, reqEditors ...RequestEditorFn) (*GetDigestPreviewResponse, error)
+
+	// GetIntegrationsAccountsListWithResponse request
+	GetIntegrationsAccountsListWithResponse(ctx context.Context, params *GetIntegrationsAccountsListParams, reqEditors ...RequestEditorFn) (*GetIntegrationsAccountsListResponse, error)
+
+	// PostIntegrationsAccountsRemoveWithBodyWithResponse request with any body
+	PostIntegrationsAccountsRemoveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostIntegrationsAccountsRemoveResponse, error)
+
+	PostIntegrationsAccountsRemoveWithResponse(ctx context.Context, body PostIntegrationsAccountsRemoveJSONRequestBody, reqEditors ...RequestEditorFn) (*PostIntegrationsAccountsRemoveResponse, error)
+
+	// PostIntegrationsAccountsSetWithBodyWithResponse request with any body
+	PostIntegrationsAccountsSetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostIntegrationsAccountsSetResponse, error)
+
+	PostIntegrationsAccountsSetWithResponse(ctx context.Context, body PostIntegrationsAccountsSetJSONRequestBody, reqEditors ...RequestEditorFn) (*PostIntegrationsAccountsSetResponse, error)
+
+	// GetIntegrationsProjectsListWithResponse request
+	GetIntegrationsProjectsListWithResponse(ctx context.Context, params *GetIntegrationsProjectsListParams, reqEditors ...RequestEditorFn) (*GetIntegrationsProjectsListResponse, error)
+
+	// PostIntegrationsProjectsRemoveWithBodyWithResponse request with any body
+	PostIntegrationsProjectsRemoveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostIntegrationsProjectsRemoveResponse, error)
+
+	PostIntegrationsProjectsRemoveWithResponse(ctx context.Context, body PostIntegrationsProjectsRemoveJSONRequestBody, reqEditors ...RequestEditorFn) (*PostIntegrationsProjectsRemoveResponse, error)
+
+	// PostIntegrationsProjectsSetWithBodyWithResponse request with any body
+	PostIntegrationsProjectsSetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostIntegrationsProjectsSetResponse, error)
+
+	PostIntegrationsProjectsSetWithResponse(ctx context.Context, body PostIntegrationsProjectsSetJSONRequestBody, reqEditors ...RequestEditorFn) (*PostIntegrationsProjectsSetResponse, error)
+
+	// PostPullRequestAddReviewerWithBodyWithResponse request with any body
+	PostPullRequestAddReviewerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestAddReviewerResponse, error)
+
+	PostPullRequestAddReviewerWithResponse(ctx context.Context, body PostPullRequestAddReviewerJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestAddReviewerResponse, error)
+
+	// PostPullRequestCloseWithBodyWithResponse request with any body
+	PostPullRequestCloseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestCloseResponse, error)
+
+	PostPullRequestCloseWithResponse(ctx context.Context, body PostPullRequestCloseJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestCloseResponse, error)
+
+	// PostPullRequestCreateWithBodyWithResponse request with any body
+	PostPullRequestCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error)
+
+	PostPullRequestCreateWithResponse(ctx context.Context, body PostPullRequestCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error)
+
+	// PostPullRequestDeclineWithBodyWithResponse request with any body
+	PostPullRequestDeclineWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestDeclineResponse, error)
+
+	PostPullRequestDeclineWithResponse(ctx context.Context, body PostPullRequestDeclineJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestDeclineResponse, error)
+
+	// PostPullRequestMergeWithBodyWithResponse request with any body
+	PostPullRequestMergeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error)
+
+	PostPullRequestMergeWithResponse(ctx context.Context, body PostPullRequestMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error)
+
+	// GetPullRequestOverdueWithResponse request
+	GetPullRequestOverdueWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPullRequestOverdueResponse, error)
+
+	// PostPullRequestReassignWithBodyWithResponse request with any body
+	PostPullRequestReassignWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error)
+
+	PostPullRequestReassignWithResponse(ctx context.Context, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error)
+
+	// PostPullRequestRemoveReviewerWithBodyWithResponse request with any body
+	PostPullRequestRemoveReviewerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestRemoveReviewerResponse, error)
+
+	PostPullRequestRemoveReviewerWithResponse(ctx context.Context, body PostPullRequestRemoveReviewerJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestRemoveReviewerResponse, error)
+
+	// PostPullRequestReopenWithBodyWithResponse request with any body
+	PostPullRequestReopenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestReopenResponse, error)
+
+	PostPullRequestReopenWithResponse(ctx context.Context, body PostPullRequestReopenJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestReopenResponse, error)
+
+	// GetStatsReviewersWithResponse request
+	GetStatsReviewersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatsReviewersResponse, error)
+
+	// PostTeamAddWithBodyWithResponse request with any body
+	PostTeamAddWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error)
+
+	PostTeamAddWithResponse(ctx context.Context, body PostTeamAddJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error)
+
+	// PostTeamDeactivateWithBodyWithResponse request with any body
+	PostTeamDeactivateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamDeactivateResponse, error)
+
+	PostTeamDeactivateWithResponse(ctx context.Context, body PostTeamDeactivateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamDeactivateResponse, error)
+
+	// GetTeamGetWithResponse request
+	GetTeamGetWithResponse(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*GetTeamGetResponse, error)
+
+	// GetTeamGetChatChannelWithResponse request
+	GetTeamGetChatChannelWithResponse(ctx context.Context, params *GetTeamGetChatChannelParams, reqEditors ...RequestEditorFn) (*GetTeamGetChatChannelResponse, error)
+
+	// GetTeamGetCodeownersWithResponse request
+	GetTeamGetCodeownersWithResponse(ctx context.Context, params *GetTeamGetCodeownersParams, reqEditors ...RequestEditorFn) (*GetTeamGetCodeownersResponse, error)
+
+	// GetTeamGetHolidaysWithResponse request
+	GetTeamGetHolidaysWithResponse(ctx context.Context, params *GetTeamGetHolidaysParams, reqEditors ...RequestEditorFn) (*GetTeamGetHolidaysResponse, error)
+
+	// GetTeamGetSettingsWithResponse request
+	GetTeamGetSettingsWithResponse(ctx context.Context, params *GetTeamGetSettingsParams, reqEditors ...RequestEditorFn) (*GetTeamGetSettingsResponse, error)
+
+	// PostTeamRemoveChatChannelWithBodyWithResponse request with any body
+	PostTeamRemoveChatChannelWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamRemoveChatChannelResponse, error)
+
+	PostTeamRemoveChatChannelWithResponse(ctx context.Context, body PostTeamRemoveChatChannelJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamRemoveChatChannelResponse, error)
+
+	// PostTeamSetChatChannelWithBodyWithResponse request with any body
+	PostTeamSetChatChannelWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamSetChatChannelResponse, error)
+
+	PostTeamSetChatChannelWithResponse(ctx context.Context, body PostTeamSetChatChannelJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamSetChatChannelResponse, error)
+
+	// PostTeamSetCodeownersWithBodyWithResponse request with any body
+	PostTeamSetCodeownersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamSetCodeownersResponse, error)
+
+	PostTeamSetCodeownersWithResponse(ctx context.Context, body PostTeamSetCodeownersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamSetCodeownersResponse, error)
+
+	// PostTeamSetHolidaysWithBodyWithResponse request with any body
+	PostTeamSetHolidaysWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamSetHolidaysResponse, error)
+
+	PostTeamSetHolidaysWithResponse(ctx context.Context, body PostTeamSetHolidaysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamSetHolidaysResponse, error)
+
+	// PostTeamSetSettingsWithBodyWithResponse request with any body
+	PostTeamSetSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamSetSettingsResponse, error)
+
+	PostTeamSetSettingsWithResponse(ctx context.Context, body PostTeamSetSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamSetSettingsResponse, error)
+
+	// PostUsersAddExclusionWithBodyWithResponse request with any body
+	PostUsersAddExclusionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersAddExclusionResponse, error)
+
+	PostUsersAddExclusionWithResponse(ctx context.Context, body PostUsersAddExclusionJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersAddExclusionResponse, error)
+
+	// GetUsersGetExclusionsWithResponse request
+	GetUsersGetExclusionsWithResponse(ctx context.Context, params *GetUsersGetExclusionsParams, reqEditors ...RequestEditorFn) (*GetUsersGetExclusionsResponse, error)
+
+	// GetUsersGetReviewWithResponse request
+	GetUsersGetReviewWithResponse(ctx context.Context, params *GetUsersGetReviewParams, reqEditors ...RequestEditorFn) (*GetUsersGetReviewResponse, error)
+
+	// PostUsersImportAvailabilityWithBodyWithResponse request with any body
+	PostUsersImportAvailabilityWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersImportAvailabilityResponse, error)
+
+	PostUsersImportAvailabilityWithResponse(ctx context.Context, body PostUsersImportAvailabilityJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersImportAvailabilityResponse, error)
+
+	// PostUsersRemoveExclusionWithBodyWithResponse request with any body
+	PostUsersRemoveExclusionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersRemoveExclusionResponse, error)
+
+	PostUsersRemoveExclusionWithResponse(ctx context.Context, body PostUsersRemoveExclusionJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersRemoveExclusionResponse, error)
+
+	// PostUsersSetAvailabilityWithBodyWithResponse request with any body
+	PostUsersSetAvailabilityWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetAvailabilityResponse, error)
+
+	PostUsersSetAvailabilityWithResponse(ctx context.Context, body PostUsersSetAvailabilityJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetAvailabilityResponse, error)
+
+	// PostUsersSetDigestWithBodyWithResponse request with any body
+	PostUsersSetDigestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetDigestResponse, error)
+
+	PostUsersSetDigestWithResponse(ctx context.Context, body PostUsersSetDigestJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetDigestResponse, error)
+
+	// PostUsersSetIsActiveWithBodyWithResponse request with any body
+	PostUsersSetIsActiveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error)
+
+	PostUsersSetIsActiveWithResponse(ctx context.Context, body PostUsersSetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error)
+
+	// PostUsersSetLevelWithBodyWithResponse request with any body
+	PostUsersSetLevelWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetLevelResponse, error)
+
+	PostUsersSetLevelWithResponse(ctx context.Context, body PostUsersSetLevelJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetLevelResponse, error)
+
+	// PostUsersSetMaxOpenReviewsWithBodyWithResponse request with any body
+	PostUsersSetMaxOpenReviewsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetMaxOpenReviewsResponse, error)
+
+	PostUsersSetMaxOpenReviewsWithResponse(ctx context.Context, body PostUsersSetMaxOpenReviewsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetMaxOpenReviewsResponse, error)
+
+	// PostUsersSetReviewWeightWithBodyWithResponse request with any body
+	PostUsersSetReviewWeightWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetReviewWeightResponse, error)
+
+	PostUsersSetReviewWeightWithResponse(ctx context.Context, body PostUsersSetReviewWeightJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetReviewWeightResponse, error)
+
+	// PostUsersSetScheduleWithBodyWithResponse request with any body
+	PostUsersSetScheduleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetScheduleResponse, error)
+
+	PostUsersSetScheduleWithResponse(ctx context.Context, body PostUsersSetScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetScheduleResponse, error)
+
+	// PostUsersSetSkillsWithBodyWithResponse request with any body
+	PostUsersSetSkillsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetSkillsResponse, error)
+
+	PostUsersSetSkillsWithResponse(ctx context.Context, body PostUsersSetSkillsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetSkillsResponse, error)
+
+	// GetWebhooksDeadLettersWithResponse request
+	GetWebhooksDeadLettersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWebhooksDeadLettersResponse, error)
+
+	// GetWebhooksListWithResponse request
+	GetWebhooksListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWebhooksListResponse, error)
+
+	// PostWebhooksRedeliverWithBodyWithResponse request with any body
+	PostWebhooksRedeliverWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWebhooksRedeliverResponse, error)
+
+	PostWebhooksRedeliverWithResponse(ctx context.Context, body PostWebhooksRedeliverJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWebhooksRedeliverResponse, error)
+
+	// PostWebhooksSubscribeWithBodyWithResponse request with any body
+	PostWebhooksSubscribeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWebhooksSubscribeResponse, error)
+
+	PostWebhooksSubscribeWithResponse(ctx context.Context, body PostWebhooksSubscribeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWebhooksSubscribeResponse, error)
+
+	// PostWebhooksUnsubscribeWithBodyWithResponse request with any body
+	PostWebhooksUnsubscribeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWebhooksUnsubscribeResponse, error)
+
+	PostWebhooksUnsubscribeWithResponse(ctx context.Context, body PostWebhooksUnsubscribeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWebhooksUnsubscribeResponse, error)
+}
+
+type GetDigestPreviewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Digest DigestPreview `json:"digest"`
+	}
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDigestPreviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDigestPreviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetIntegrationsAccountsListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Accounts []CodeHostAccount `json:"accounts"`
+	}
+	JSON400 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetIntegrationsAccountsListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetIntegrationsAccountsListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostIntegrationsAccountsRemoveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Login    string `json:"login"`
+		Provider string `json:"provider"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostIntegrationsAccountsRemoveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostIntegrationsAccountsRemoveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostIntegrationsAccountsSetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Account CodeHostAccount `json:"account"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostIntegrationsAccountsSetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostIntegrationsAccountsSetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetIntegrationsProjectsListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Projects []CodeHostProject `json:"projects"`
+	}
+	JSON400 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetIntegrationsProjectsListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetIntegrationsProjectsListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostIntegrationsProjectsRemoveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Provider   string `json:"provider"`
+		Repository string `json:"repository"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostIntegrationsProjectsRemoveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostIntegrationsProjectsRemoveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostIntegrationsProjectsSetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Project CodeHostProject `json:"project"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostIntegrationsProjectsSetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostIntegrationsProjectsSetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestAddReviewerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Pr PullRequest `json:"pr"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+	JSON409 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestAddReviewerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestAddReviewerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestCloseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Pr *PullRequest `json:"pr,omitempty"`
+	}
+	JSON404 *ErrorResponse
+	JSON409 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestCloseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestCloseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Pr *PullRequest `json:"pr,omitempty"`
+
+		// UncoveredTags Требуемые навыки, для которых не нашлось ревьювера
+		UncoveredTags *[]string `json:"uncovered_tags,omitempty"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+	JSON409 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestDeclineResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Pr PullRequest `json:"pr"`
+
+		// ReplacedBy user_id нового ревьювера
+		ReplacedBy string `json:"replaced_by"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+	JSON409 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestDeclineResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestDeclineResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestMergeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Pr *PullRequest `json:"pr,omitempty"`
+	}
+	JSON404 *ErrorResponse
+	JSON409 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestMergeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestMergeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPullRequestOverdueResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Reviews []OverdueReview `json:"reviews"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPullRequestOverdueResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPullRequestOverdueResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestReassignResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Pr PullRequest `json:"pr"`
+
+		// ReplacedBy user_id нового ревьювера
+		ReplacedBy string `json:"replaced_by"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+	JSON409 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestReassignResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestReassignResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestRemoveReviewerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Pr PullRequest `json:"pr"`
+	}
+	JSON404 *ErrorResponse
+	JSON409 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestRemoveReviewerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestRemoveReviewerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestReopenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Pr *PullRequest `json:"pr,omitempty"`
+	}
+	JSON404 *ErrorResponse
+	JSON409 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestReopenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestReopenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStatsReviewersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReviewerStatsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStatsReviewersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStatsReviewersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTeamAddResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Team *Team `json:"team,omitempty"`
+	}
+	JSON400 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTeamAddResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTeamAddResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTeamDeactivateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TeamDeactivateResult
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTeamDeactivateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTeamDeactivateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTeamGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Team
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTeamGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTeamGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTeamGetChatChannelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Channel ChatChannel `json:"channel"`
+	}
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTeamGetChatChannelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTeamGetChatChannelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTeamGetCodeownersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Codeowners string `json:"codeowners"`
+		TeamName   string `json:"team_name"`
+	}
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTeamGetCodeownersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTeamGetCodeownersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTeamGetHolidaysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TeamHolidaysResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTeamGetHolidaysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTeamGetHolidaysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTeamGetSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TeamSettings
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTeamGetSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTeamGetSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTeamRemoveChatChannelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		TeamName string `json:"team_name"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTeamRemoveChatChannelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTeamRemoveChatChannelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTeamSetChatChannelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Channel ChatChannel `json:"channel"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTeamSetChatChannelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTeamSetChatChannelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTeamSetCodeownersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// Rules Количество правил в файле
+		Rules    int    `json:"rules"`
+		TeamName string `json:"team_name"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTeamSetCodeownersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTeamSetCodeownersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTeamSetHolidaysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TeamHolidaysResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTeamSetHolidaysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTeamSetHolidaysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTeamSetSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TeamSettings
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTeamSetSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTeamSetSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersAddExclusionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Exclusion ReviewerExclusion `json:"exclusion"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersAddExclusionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersAddExclusionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersGetExclusionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Exclusions []ReviewerExclusion `json:"exclusions"`
+		UserId     string              `json:"user_id"`
+	}
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersGetExclusionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersGetExclusionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersGetReviewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		PullRequests []PullRequestShort `json:"pull_requests"`
+		UserId       string             `json:"user_id"`
+	}
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersGetReviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersGetReviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersImportAvailabilityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// Imported Количество созданных или обновленных окон недоступности
+		Imported int64 `json:"imported"`
+
+		// ReassignedPrs Количество открытых PR, переназначенных по начавшимся окнам
+		ReassignedPrs int64 `json:"reassigned_prs"`
+
+		// Skipped Количество событий, не являющихся отсутствием или уже завершившихся
+		Skipped int64 `json:"skipped"`
+
+		// UnmatchedEvents События отсутствия, для которых не найден пользователь или не удалось определить время (например, TZID неизвестной зоны)
+		UnmatchedEvents []string `json:"unmatched_events"`
+	}
+	JSON400 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersImportAvailabilityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersImportAvailabilityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersRemoveExclusionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Exclusion ReviewerExclusion `json:"exclusion"`
+	}
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersRemoveExclusionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersRemoveExclusionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersSetAvailabilityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Availability UserAvailability `json:"availability"`
+
+		// ReassignedPrs Количество открытых PR, переназначенных сразу (если окно уже началось)
+		ReassignedPrs int64 `json:"reassigned_prs"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersSetAvailabilityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersSetAvailabilityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersSetDigestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Digest DigestSettings `json:"digest"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersSetDigestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersSetDigestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersSetIsActiveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		User *User `json:"user,omitempty"`
+	}
+	JSON401 *ErrorResponse
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersSetIsActiveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersSetIsActiveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersSetLevelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		User *User `json:"user,omitempty"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersSetLevelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersSetLevelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersSetMaxOpenReviewsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		User *User `json:"user,omitempty"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersSetMaxOpenReviewsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersSetMaxOpenReviewsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersSetReviewWeightResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		User *User `json:"user,omitempty"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersSetReviewWeightResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersSetReviewWeightResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersSetScheduleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		User *User `json:"user,omitempty"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersSetScheduleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersSetScheduleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersSetSkillsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Skills []string `json:"skills"`
+		UserId string   `json:"user_id"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersSetSkillsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersSetSkillsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWebhooksDeadLettersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Deliveries []WebhookDelivery `json:"deliveries"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWebhooksDeadLettersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhooksDeadLettersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWebhooksListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Subscriptions []WebhookSubscription `json:"subscriptions"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWebhooksListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhooksListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWebhooksRedeliverResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Delivery WebhookDelivery `json:"delivery"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWebhooksRedeliverResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWebhooksRedeliverResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWebhooksSubscribeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Subscription WebhookSubscription `json:"subscription"`
+	}
+	JSON400 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWebhooksSubscribeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWebhooksSubscribeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWebhooksUnsubscribeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		SubscriptionId int64 `json:"subscription_id"`
+	}
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWebhooksUnsubscribeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWebhooksUnsubscribeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetDigestPreviewWithResponse request returning *GetDigestPreviewResponse
+func (c *ClientWithResponses) GetDigestPreviewWithResponse(ctx context.Context, params *GetDigestPreviewParams, reqEditors ...RequestEditorFn) (*GetDigestPreviewResponse, error) {
+	rsp, err := c.GetDigestPreview(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDigestPreviewResponse(rsp)
+}
+
+// GetIntegrationsAccountsListWithResponse request returning *GetIntegrationsAccountsListResponse
+func (c *ClientWithResponses) GetIntegrationsAccountsListWithResponse(ctx context.Context, params *GetIntegrationsAccountsListParams, reqEditors ...RequestEditorFn) (*GetIntegrationsAccountsListResponse, error) {
+	rsp, err := c.GetIntegrationsAccountsList(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetIntegrationsAccountsListResponse(rsp)
+}
+
+// PostIntegrationsAccountsRemoveWithBodyWithResponse request with arbitrary body returning *PostIntegrationsAccountsRemoveResponse
+func (c *ClientWithResponses) PostIntegrationsAccountsRemoveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostIntegrationsAccountsRemoveResponse, error) {
+	rsp, err := c.PostIntegrationsAccountsRemoveWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostIntegrationsAccountsRemoveResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostIntegrationsAccountsRemoveWithResponse(ctx context.Context, body PostIntegrationsAccountsRemoveJSONRequestBody, reqEditors ...RequestEditorFn) (*PostIntegrationsAccountsRemoveResponse, error) {
+	rsp, err := c.PostIntegrationsAccountsRemove(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostIntegrationsAccountsRemoveResponse(rsp)
+}
+
+// PostIntegrationsAccountsSetWithBodyWithResponse request with arbitrary body returning *PostIntegrationsAccountsSetResponse
+func (c *ClientWithResponses) PostIntegrationsAccountsSetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostIntegrationsAccountsSetResponse, error) {
+	rsp, err := c.PostIntegrationsAccountsSetWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostIntegrationsAccountsSetResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostIntegrationsAccountsSetWithResponse(ctx context.Context, body PostIntegrationsAccountsSetJSONRequestBody, reqEditors ...RequestEditorFn) (*PostIntegrationsAccountsSetResponse, error) {
+	rsp, err := c.PostIntegrationsAccountsSet(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostIntegrationsAccountsSetResponse(rsp)
+}
+
+// GetIntegrationsProjectsListWithResponse request returning *GetIntegrationsProjectsListResponse
+func (c *ClientWithResponses) GetIntegrationsProjectsListWithResponse(ctx context.Context, params *GetIntegrationsProjectsListParams, reqEditors ...RequestEditorFn) (*GetIntegrationsProjectsListResponse, error) {
+	rsp, err := c.GetIntegrationsProjectsList(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetIntegrationsProjectsListResponse(rsp)
+}
+
+// PostIntegrationsProjectsRemoveWithBodyWithResponse request with arbitrary body returning *PostIntegrationsProjectsRemoveResponse
+func (c *ClientWithResponses) PostIntegrationsProjectsRemoveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostIntegrationsProjectsRemoveResponse, error) {
+	rsp, err := c.PostIntegrationsProjectsRemoveWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostIntegrationsProjectsRemoveResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostIntegrationsProjectsRemoveWithResponse(ctx context.Context, body PostIntegrationsProjectsRemoveJSONRequestBody, reqEditors ...RequestEditorFn) (*PostIntegrationsProjectsRemoveResponse, error) {
+	rsp, err := c.PostIntegrationsProjectsRemove(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostIntegrationsProjectsRemoveResponse(rsp)
+}
+
+// PostIntegrationsProjectsSetWithBodyWithResponse request with arbitrary body returning *PostIntegrationsProjectsSetResponse
+func (c *ClientWithResponses) PostIntegrationsProjectsSetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostIntegrationsProjectsSetResponse, error) {
+	rsp, err := c.PostIntegrationsProjectsSetWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostIntegrationsProjectsSetResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostIntegrationsProjectsSetWithResponse(ctx context.Context, body PostIntegrationsProjectsSetJSONRequestBody, reqEditors ...RequestEditorFn) (*PostIntegrationsProjectsSetResponse, error) {
+	rsp, err := c.PostIntegrationsProjectsSet(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostIntegrationsProjectsSetResponse(rsp)
+}
+
+// PostPullRequestAddReviewerWithBodyWithResponse request with arbitrary body returning *PostPullRequestAddReviewerResponse
+func (c *ClientWithResponses) PostPullRequestAddReviewerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestAddReviewerResponse, error) {
+	rsp, err := c.PostPullRequestAddReviewerWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestAddReviewerResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestAddReviewerWithResponse(ctx context.Context, body PostPullRequestAddReviewerJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestAddReviewerResponse, error) {
+	rsp, err := c.PostPullRequestAddReviewer(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestAddReviewerResponse(rsp)
+}
+
+// PostPullRequestCloseWithBodyWithResponse request with arbitrary body returning *PostPullRequestCloseResponse
+func (c *ClientWithResponses) PostPullRequestCloseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestCloseResponse, error) {
+	rsp, err := c.PostPullRequestCloseWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestCloseResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestCloseWithResponse(ctx context.Context, body PostPullRequestCloseJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestCloseResponse, error) {
+	rsp, err := c.PostPullRequestClose(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestCloseResponse(rsp)
+}
+
+// PostPullRequestCreateWithBodyWithResponse request with arbitrary body returning *PostPullRequestCreateResponse
+func (c *ClientWithResponses) PostPullRequestCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error) {
+	rsp, err := c.PostPullRequestCreateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestCreateResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestCreateWithResponse(ctx context.Context, body PostPullRequestCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error) {
+	rsp, err := c.PostPullRequestCreate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestCreateResponse(rsp)
+}
+
+// PostPullRequestDeclineWithBodyWithResponse request with arbitrary body returning *PostPullRequestDeclineResponse
+func (c *ClientWithResponses) PostPullRequestDeclineWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestDeclineResponse, error) {
+	rsp, err := c.PostPullRequestDeclineWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestDeclineResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestDeclineWithResponse(ctx context.Context, body PostPullRequestDeclineJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestDeclineResponse, error) {
+	rsp, err := c.PostPullRequestDecline(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestDeclineResponse(rsp)
+}
+
+// PostPullRequestMergeWithBodyWithResponse request with arbitrary body returning *PostPullRequestMergeResponse
+func (c *ClientWithResponses) PostPullRequestMergeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error) {
+	rsp, err := c.PostPullRequestMergeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestMergeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestMergeWithResponse(ctx context.Context, body PostPullRequestMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error) {
+	rsp, err := c.PostPullRequestMerge(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestMergeResponse(rsp)
+}
+
+// GetPullRequestOverdueWithResponse request returning *GetPullRequestOverdueResponse
+func (c *ClientWithResponses) GetPullRequestOverdueWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPullRequestOverdueResponse, error) {
+	rsp, err := c.GetPullRequestOverdue(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPullRequestOverdueResponse(rsp)
+}
+
+// PostPullRequestReassignWithBodyWithResponse request with arbitrary body returning *PostPullRequestReassignResponse
+func (c *ClientWithResponses) PostPullRequestReassignWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error) {
+	rsp, err := c.PostPullRequestReassignWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestReassignResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestReassignWithResponse(ctx context.Context, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error) {
+	rsp, err := c.PostPullRequestReassign(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestReassignResponse(rsp)
+}
+
+// PostPullRequestRemoveReviewerWithBodyWithResponse request with arbitrary body returning *PostPullRequestRemoveReviewerResponse
+func (c *ClientWithResponses) PostPullRequestRemoveReviewerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestRemoveReviewerResponse, error) {
+	rsp, err := c.PostPullRequestRemoveReviewerWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestRemoveReviewerResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestRemoveReviewerWithResponse(ctx context.Context, body PostPullRequestRemoveReviewerJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestRemoveReviewerResponse, error) {
+	rsp, err := c.PostPullRequestRemoveReviewer(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestRemoveReviewerResponse(rsp)
+}
+
+// PostPullRequestReopenWithBodyWithResponse request with arbitrary body returning *PostPullRequestReopenResponse
+func (c *ClientWithResponses) PostPullRequestReopenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestReopenResponse, error) {
+	rsp, err := c.PostPullRequestReopenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestReopenResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestReopenWithResponse(ctx context.Context, body PostPullRequestReopenJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestReopenResponse, error) {
+	rsp, err := c.PostPullRequestReopen(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestReopenResponse(rsp)
+}
+
+// GetStatsReviewersWithResponse request returning *GetStatsReviewersResponse
+func (c *ClientWithResponses) GetStatsReviewersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatsReviewersResponse, error) {
+	rsp, err := c.GetStatsReviewers(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStatsReviewersResponse(rsp)
+}
+
+// PostTeamAddWithBodyWithResponse request with arbitrary body returning *PostTeamAddResponse
+func (c *ClientWithResponses) PostTeamAddWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error) {
+	rsp, err := c.PostTeamAddWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamAddResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTeamAddWithResponse(ctx context.Context, body PostTeamAddJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error) {
+	rsp, err := c.PostTeamAdd(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamAddResponse(rsp)
+}
+
+// PostTeamDeactivateWithBodyWithResponse request with arbitrary body returning *PostTeamDeactivateResponse
+func (c *ClientWithResponses) PostTeamDeactivateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamDeactivateResponse, error) {
+	rsp, err := c.PostTeamDeactivateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamDeactivateResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTeamDeactivateWithResponse(ctx context.Context, body PostTeamDeactivateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamDeactivateResponse, error) {
+	rsp, err := c.PostTeamDeactivate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamDeactivateResponse(rsp)
+}
+
+// GetTeamGetWithResponse request returning *GetTeamGetResponse
+func (c *ClientWithResponses) GetTeamGetWithResponse(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*GetTeamGetResponse, error) {
+	rsp, err := c.GetTeamGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTeamGetResponse(rsp)
+}
+
+// GetTeamGetChatChannelWithResponse request returning *GetTeamGetChatChannelResponse
+func (c *ClientWithResponses) GetTeamGetChatChannelWithResponse(ctx context.Context, params *GetTeamGetChatChannelParams, reqEditors ...RequestEditorFn) (*GetTeamGetChatChannelResponse, error) {
+	rsp, err := c.GetTeamGetChatChannel(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTeamGetChatChannelResponse(rsp)
+}
+
+// GetTeamGetCodeownersWithResponse request returning *GetTeamGetCodeownersResponse
+func (c *ClientWithResponses) GetTeamGetCodeownersWithResponse(ctx context.Context, params *GetTeamGetCodeownersParams, reqEditors ...RequestEditorFn) (*GetTeamGetCodeownersResponse, error) {
+	rsp, err := c.GetTeamGetCodeowners(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTeamGetCodeownersResponse(rsp)
+}
+
+// GetTeamGetHolidaysWithResponse request returning *GetTeamGetHolidaysResponse
+func (c *ClientWithResponses) GetTeamGetHolidaysWithResponse(ctx context.Context, params *GetTeamGetHolidaysParams, reqEditors ...RequestEditorFn) (*GetTeamGetHolidaysResponse, error) {
+	rsp, err := c.GetTeamGetHolidays(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTeamGetHolidaysResponse(rsp)
+}
+
+// GetTeamGetSettingsWithResponse request returning *GetTeamGetSettingsResponse
+func (c *ClientWithResponses) GetTeamGetSettingsWithResponse(ctx context.Context, params *GetTeamGetSettingsParams, reqEditors ...RequestEditorFn) (*GetTeamGetSettingsResponse, error) {
+	rsp, err := c.GetTeamGetSettings(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTeamGetSettingsResponse(rsp)
+}
+
+// PostTeamRemoveChatChannelWithBodyWithResponse request with arbitrary body returning *PostTeamRemoveChatChannelResponse
+func (c *ClientWithResponses) PostTeamRemoveChatChannelWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamRemoveChatChannelResponse, error) {
+	rsp, err := c.PostTeamRemoveChatChannelWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamRemoveChatChannelResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTeamRemoveChatChannelWithResponse(ctx context.Context, body PostTeamRemoveChatChannelJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamRemoveChatChannelResponse, error) {
+	rsp, err := c.PostTeamRemoveChatChannel(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamRemoveChatChannelResponse(rsp)
+}
+
+// PostTeamSetChatChannelWithBodyWithResponse request with arbitrary body returning *PostTeamSetChatChannelResponse
+func (c *ClientWithResponses) PostTeamSetChatChannelWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamSetChatChannelResponse, error) {
+	rsp, err := c.PostTeamSetChatChannelWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamSetChatChannelResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTeamSetChatChannelWithResponse(ctx context.Context, body PostTeamSetChatChannelJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamSetChatChannelResponse, error) {
+	rsp, err := c.PostTeamSetChatChannel(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamSetChatChannelResponse(rsp)
+}
+
+// PostTeamSetCodeownersWithBodyWithResponse request with arbitrary body returning *PostTeamSetCodeownersResponse
+func (c *ClientWithResponses) PostTeamSetCodeownersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamSetCodeownersResponse, error) {
+	rsp, err := c.PostTeamSetCodeownersWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamSetCodeownersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTeamSetCodeownersWithResponse(ctx context.Context, body PostTeamSetCodeownersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamSetCodeownersResponse, error) {
+	rsp, err := c.PostTeamSetCodeowners(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamSetCodeownersResponse(rsp)
+}
+
+// PostTeamSetHolidaysWithBodyWithResponse request with arbitrary body returning *PostTeamSetHolidaysResponse
+func (c *ClientWithResponses) PostTeamSetHolidaysWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamSetHolidaysResponse, error) {
+	rsp, err := c.PostTeamSetHolidaysWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamSetHolidaysResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTeamSetHolidaysWithResponse(ctx context.Context, body PostTeamSetHolidaysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamSetHolidaysResponse, error) {
+	rsp, err := c.PostTeamSetHolidays(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamSetHolidaysResponse(rsp)
+}
+
+// PostTeamSetSettingsWithBodyWithResponse request with arbitrary body returning *PostTeamSetSettingsResponse
+func (c *ClientWithResponses) PostTeamSetSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamSetSettingsResponse, error) {
+	rsp, err := c.PostTeamSetSettingsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamSetSettingsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTeamSetSettingsWithResponse(ctx context.Context, body PostTeamSetSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamSetSettingsResponse, error) {
+	rsp, err := c.PostTeamSetSettings(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamSetSettingsResponse(rsp)
+}
+
+// PostUsersAddExclusionWithBodyWithResponse request with arbitrary body returning *PostUsersAddExclusionResponse
+func (c *ClientWithResponses) PostUsersAddExclusionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersAddExclusionResponse, error) {
+	rsp, err := c.PostUsersAddExclusionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersAddExclusionResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersAddExclusionWithResponse(ctx context.Context, body PostUsersAddExclusionJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersAddExclusionResponse, error) {
+	rsp, err := c.PostUsersAddExclusion(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersAddExclusionResponse(rsp)
+}
+
+// GetUsersGetExclusionsWithResponse request returning *GetUsersGetExclusionsResponse
+func (c *ClientWithResponses) GetUsersGetExclusionsWithResponse(ctx context.Context, params *GetUsersGetExclusionsParams, reqEditors ...RequestEditorFn) (*GetUsersGetExclusionsResponse, error) {
+	rsp, err := c.GetUsersGetExclusions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersGetExclusionsResponse(rsp)
+}
+
+// GetUsersGetReviewWithResponse request returning *GetUsersGetReviewResponse
+func (c *ClientWithResponses) GetUsersGetReviewWithResponse(ctx context.Context, params *GetUsersGetReviewParams, reqEditors ...RequestEditorFn) (*GetUsersGetReviewResponse, error) {
+	rsp, err := c.GetUsersGetReview(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersGetReviewResponse(rsp)
+}
+
+// PostUsersImportAvailabilityWithBodyWithResponse request with arbitrary body returning *PostUsersImportAvailabilityResponse
+func (c *ClientWithResponses) PostUsersImportAvailabilityWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersImportAvailabilityResponse, error) {
+	rsp, err := c.PostUsersImportAvailabilityWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersImportAvailabilityResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersImportAvailabilityWithResponse(ctx context.Context, body PostUsersImportAvailabilityJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersImportAvailabilityResponse, error) {
+	rsp, err := c.PostUsersImportAvailability(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersImportAvailabilityResponse(rsp)
+}
+
+// PostUsersRemoveExclusionWithBodyWithResponse request with arbitrary body returning *PostUsersRemoveExclusionResponse
+func (c *ClientWithResponses) PostUsersRemoveExclusionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersRemoveExclusionResponse, error) {
+	rsp, err := c.PostUsersRemoveExclusionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersRemoveExclusionResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersRemoveExclusionWithResponse(ctx context.Context, body PostUsersRemoveExclusionJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersRemoveExclusionResponse, error) {
+	rsp, err := c.PostUsersRemoveExclusion(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersRemoveExclusionResponse(rsp)
+}
+
+// PostUsersSetAvailabilityWithBodyWithResponse request with arbitrary body returning *PostUsersSetAvailabilityResponse
+func (c *ClientWithResponses) PostUsersSetAvailabilityWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetAvailabilityResponse, error) {
+	rsp, err := c.PostUsersSetAvailabilityWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetAvailabilityResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersSetAvailabilityWithResponse(ctx context.Context, body PostUsersSetAvailabilityJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetAvailabilityResponse, error) {
+	rsp, err := c.PostUsersSetAvailability(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetAvailabilityResponse(rsp)
+}
+
+// PostUsersSetDigestWithBodyWithResponse request with arbitrary body returning *PostUsersSetDigestResponse
+func (c *ClientWithResponses) PostUsersSetDigestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetDigestResponse, error) {
+	rsp, err := c.PostUsersSetDigestWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetDigestResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersSetDigestWithResponse(ctx context.Context, body PostUsersSetDigestJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetDigestResponse, error) {
+	rsp, err := c.PostUsersSetDigest(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetDigestResponse(rsp)
+}
+
+// PostUsersSetIsActiveWithBodyWithResponse request with arbitrary body returning *PostUsersSetIsActiveResponse
+func (c *ClientWithResponses) PostUsersSetIsActiveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error) {
+	rsp, err := c.PostUsersSetIsActiveWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetIsActiveResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersSetIsActiveWithResponse(ctx context.Context, body PostUsersSetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error) {
+	rsp, err := c.PostUsersSetIsActive(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetIsActiveResponse(rsp)
+}
+
+// PostUsersSetLevelWithBodyWithResponse request with arbitrary body returning *PostUsersSetLevelResponse
+func (c *ClientWithResponses) PostUsersSetLevelWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetLevelResponse, error) {
+	rsp, err := c.PostUsersSetLevelWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetLevelResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersSetLevelWithResponse(ctx context.Context, body PostUsersSetLevelJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetLevelResponse, error) {
+	rsp, err := c.PostUsersSetLevel(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetLevelResponse(rsp)
+}
+
+// PostUsersSetMaxOpenReviewsWithBodyWithResponse request with arbitrary body returning *PostUsersSetMaxOpenReviewsResponse
+func (c *ClientWithResponses) PostUsersSetMaxOpenReviewsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetMaxOpenReviewsResponse, error) {
+	rsp, err := c.PostUsersSetMaxOpenReviewsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetMaxOpenReviewsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersSetMaxOpenReviewsWithResponse(ctx context.Context, body PostUsersSetMaxOpenReviewsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetMaxOpenReviewsResponse, error) {
+	rsp, err := c.PostUsersSetMaxOpenReviews(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetMaxOpenReviewsResponse(rsp)
+}
+
+// PostUsersSetReviewWeightWithBodyWithResponse request with arbitrary body returning *PostUsersSetReviewWeightResponse
+func (c *ClientWithResponses) PostUsersSetReviewWeightWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetReviewWeightResponse, error) {
+	rsp, err := c.PostUsersSetReviewWeightWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetReviewWeightResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersSetReviewWeightWithResponse(ctx context.Context, body PostUsersSetReviewWeightJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetReviewWeightResponse, error) {
+	rsp, err := c.PostUsersSetReviewWeight(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetReviewWeightResponse(rsp)
+}
+
+// PostUsersSetScheduleWithBodyWithResponse request with arbitrary body returning *PostUsersSetScheduleResponse
+func (c *ClientWithResponses) PostUsersSetScheduleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetScheduleResponse, error) {
+	rsp, err := c.PostUsersSetScheduleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetScheduleResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersSetScheduleWithResponse(ctx context.Context, body PostUsersSetScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetScheduleResponse, error) {
+	rsp, err := c.PostUsersSetSchedule(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetScheduleResponse(rsp)
+}
+
+// PostUsersSetSkillsWithBodyWithResponse request with arbitrary body returning *PostUsersSetSkillsResponse
+func (c *ClientWithResponses) PostUsersSetSkillsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetSkillsResponse, error) {
+	rsp, err := c.PostUsersSetSkillsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetSkillsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersSetSkillsWithResponse(ctx context.Context, body PostUsersSetSkillsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetSkillsResponse, error) {
+	rsp, err := c.PostUsersSetSkills(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetSkillsResponse(rsp)
+}
+
+// GetWebhooksDeadLettersWithResponse request returning *GetWebhooksDeadLettersResponse
+func (c *ClientWithResponses) GetWebhooksDeadLettersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWebhooksDeadLettersResponse, error) {
+	rsp, err := c.GetWebhooksDeadLetters(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWebhooksDeadLettersResponse(rsp)
+}
+
+// GetWebhooksListWithResponse request returning *GetWebhooksListResponse
+func (c *ClientWithResponses) GetWebhooksListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWebhooksListResponse, error) {
+	rsp, err := c.GetWebhooksList(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWebhooksListResponse(rsp)
+}
+
+// PostWebhooksRedeliverWithBodyWithResponse request with arbitrary body returning *PostWebhooksRedeliverResponse
+func (c *ClientWithResponses) PostWebhooksRedeliverWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWebhooksRedeliverResponse, error) {
+	rsp, err := c.PostWebhooksRedeliverWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksRedeliverResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostWebhooksRedeliverWithResponse(ctx context.Context, body PostWebhooksRedeliverJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWebhooksRedeliverResponse, error) {
+	rsp, err := c.PostWebhooksRedeliver(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksRedeliverResponse(rsp)
+}
+
+// PostWebhooksSubscribeWithBodyWithResponse request with arbitrary body returning *PostWebhooksSubscribeResponse
+func (c *ClientWithResponses) PostWebhooksSubscribeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWebhooksSubscribeResponse, error) {
+	rsp, err := c.PostWebhooksSubscribeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksSubscribeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostWebhooksSubscribeWithResponse(ctx context.Context, body PostWebhooksSubscribeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWebhooksSubscribeResponse, error) {
+	rsp, err := c.PostWebhooksSubscribe(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksSubscribeResponse(rsp)
+}
+
+// PostWebhooksUnsubscribeWithBodyWithResponse request with arbitrary body returning *PostWebhooksUnsubscribeResponse
+func (c *ClientWithResponses) PostWebhooksUnsubscribeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWebhooksUnsubscribeResponse, error) {
+	rsp, err := c.PostWebhooksUnsubscribeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksUnsubscribeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostWebhooksUnsubscribeWithResponse(ctx context.Context, body PostWebhooksUnsubscribeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWebhooksUnsubscribeResponse, error) {
+	rsp, err := c.PostWebhooksUnsubscribe(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksUnsubscribeResponse(rsp)
+}
+
+// ParseGetDigestPreviewResponse parses an HTTP response from a GetDigestPreviewWithResponse call
+func ParseGetDigestPreviewResponse(rsp *http.Response) (*GetDigestPreviewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDigestPreviewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Digest DigestPreview `json:"digest"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetIntegrationsAccountsListResponse parses an HTTP response from a GetIntegrationsAccountsListWithResponse call
+func ParseGetIntegrationsAccountsListResponse(rsp *http.Response) (*GetIntegrationsAccountsListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetIntegrationsAccountsListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Accounts []CodeHostAccount `json:"accounts"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostIntegrationsAccountsRemoveResponse parses an HTTP response from a PostIntegrationsAccountsRemoveWithResponse call
+func ParsePostIntegrationsAccountsRemoveResponse(rsp *http.Response) (*PostIntegrationsAccountsRemoveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostIntegrationsAccountsRemoveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Login    string `json:"login"`
+			Provider string `json:"provider"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostIntegrationsAccountsSetResponse parses an HTTP response from a PostIntegrationsAccountsSetWithResponse call
+func ParsePostIntegrationsAccountsSetResponse(rsp *http.Response) (*PostIntegrationsAccountsSetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostIntegrationsAccountsSetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Account CodeHostAccount `json:"account"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetIntegrationsProjectsListResponse parses an HTTP response from a GetIntegrationsProjectsListWithResponse call
+func ParseGetIntegrationsProjectsListResponse(rsp *http.Response) (*GetIntegrationsProjectsListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetIntegrationsProjectsListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Projects []CodeHostProject `json:"projects"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostIntegrationsProjectsRemoveResponse parses an HTTP response from a PostIntegrationsProjectsRemoveWithResponse call
+func ParsePostIntegrationsProjectsRemoveResponse(rsp *http.Response) (*PostIntegrationsProjectsRemoveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostIntegrationsProjectsRemoveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Provider   string `json:"provider"`
+			Repository string `json:"repository"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostIntegrationsProjectsSetResponse parses an HTTP response from a PostIntegrationsProjectsSetWithResponse call
+func ParsePostIntegrationsProjectsSetResponse(rsp *http.Response) (*PostIntegrationsProjectsSetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostIntegrationsProjectsSetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Project CodeHostProject `json:"project"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestAddReviewerResponse parses an HTTP response from a PostPullRequestAddReviewerWithResponse call
+func ParsePostPullRequestAddReviewerResponse(rsp *http.Response) (*PostPullRequestAddReviewerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestAddReviewerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Pr PullRequest `json:"pr"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestCloseResponse parses an HTTP response from a PostPullRequestCloseWithResponse call
+func ParsePostPullRequestCloseResponse(rsp *http.Response) (*PostPullRequestCloseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestCloseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Pr *PullRequest `json:"pr,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestCreateResponse parses an HTTP response from a PostPullRequestCreateWithResponse call
+func ParsePostPullRequestCreateResponse(rsp *http.Response) (*PostPullRequestCreateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestCreateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Pr *PullRequest `json:"pr,omitempty"`
+
+			// UncoveredTags Требуемые навыки, для которых не нашлось ревьювера
+			UncoveredTags *[]string `json:"uncovered_tags,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestDeclineResponse parses an HTTP response from a PostPullRequestDeclineWithResponse call
+func ParsePostPullRequestDeclineResponse(rsp *http.Response) (*PostPullRequestDeclineResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestDeclineResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Pr PullRequest `json:"pr"`
+
+			// ReplacedBy user_id нового ревьювера
+			ReplacedBy string `json:"replaced_by"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestMergeResponse parses an HTTP response from a PostPullRequestMergeWithResponse call
+func ParsePostPullRequestMergeResponse(rsp *http.Response) (*PostPullRequestMergeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestMergeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Pr *PullRequest `json:"pr,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPullRequestOverdueResponse parses an HTTP response from a GetPullRequestOverdueWithResponse call
+func ParseGetPullRequestOverdueResponse(rsp *http.Response) (*GetPullRequestOverdueResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPullRequestOverdueResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Reviews []OverdueReview `json:"reviews"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestReassignResponse parses an HTTP response from a PostPullRequestReassignWithResponse call
+func ParsePostPullRequestReassignResponse(rsp *http.Response) (*PostPullRequestReassignResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestReassignResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Pr PullRequest `json:"pr"`
+
+			// ReplacedBy user_id нового ревьювера
+			ReplacedBy string `json:"replaced_by"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestRemoveReviewerResponse parses an HTTP response from a PostPullRequestRemoveReviewerWithResponse call
+func ParsePostPullRequestRemoveReviewerResponse(rsp *http.Response) (*PostPullRequestRemoveReviewerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestRemoveReviewerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Pr PullRequest `json:"pr"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestReopenResponse parses an HTTP response from a PostPullRequestReopenWithResponse call
+func ParsePostPullRequestReopenResponse(rsp *http.Response) (*PostPullRequestReopenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestReopenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Pr *PullRequest `json:"pr,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStatsReviewersResponse parses an HTTP response from a GetStatsReviewersWithResponse call
+func ParseGetStatsReviewersResponse(rsp *http.Response) (*GetStatsReviewersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStatsReviewersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReviewerStatsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTeamAddResponse parses an HTTP response from a PostTeamAddWithResponse call
+func ParsePostTeamAddResponse(rsp *http.Response) (*PostTeamAddResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTeamAddResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Team *Team `json:"team,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTeamDeactivateResponse parses an HTTP response from a PostTeamDeactivateWithResponse call
+func ParsePostTeamDeactivateResponse(rsp *http.Response) (*PostTeamDeactivateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTeamDeactivateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TeamDeactivateResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTeamGetResponse parses an HTTP response from a GetTeamGetWithResponse call
+func ParseGetTeamGetResponse(rsp *http.Response) (*GetTeamGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTeamGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Team
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTeamGetChatChannelResponse parses an HTTP response from a GetTeamGetChatChannelWithResponse call
+func ParseGetTeamGetChatChannelResponse(rsp *http.Response) (*GetTeamGetChatChannelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTeamGetChatChannelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Channel ChatChannel `json:"channel"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTeamGetCodeownersResponse parses an HTTP response from a GetTeamGetCodeownersWithResponse call
+func ParseGetTeamGetCodeownersResponse(rsp *http.Response) (*GetTeamGetCodeownersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTeamGetCodeownersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Codeowners string `json:"codeowners"`
+			TeamName   string `json:"team_name"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTeamGetHolidaysResponse parses an HTTP response from a GetTeamGetHolidaysWithResponse call
+func ParseGetTeamGetHolidaysResponse(rsp *http.Response) (*GetTeamGetHolidaysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTeamGetHolidaysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TeamHolidaysResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTeamGetSettingsResponse parses an HTTP response from a GetTeamGetSettingsWithResponse call
+func ParseGetTeamGetSettingsResponse(rsp *http.Response) (*GetTeamGetSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTeamGetSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TeamSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTeamRemoveChatChannelResponse parses an HTTP response from a PostTeamRemoveChatChannelWithResponse call
+func ParsePostTeamRemoveChatChannelResponse(rsp *http.Response) (*PostTeamRemoveChatChannelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTeamRemoveChatChannelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			TeamName string `json:"team_name"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTeamSetChatChannelResponse parses an HTTP response from a PostTeamSetChatChannelWithResponse call
+func ParsePostTeamSetChatChannelResponse(rsp *http.Response) (*PostTeamSetChatChannelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTeamSetChatChannelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Channel ChatChannel `json:"channel"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTeamSetCodeownersResponse parses an HTTP response from a PostTeamSetCodeownersWithResponse call
+func ParsePostTeamSetCodeownersResponse(rsp *http.Response) (*PostTeamSetCodeownersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTeamSetCodeownersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// Rules Количество правил в файле
+			Rules    int    `json:"rules"`
+			TeamName string `json:"team_name"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTeamSetHolidaysResponse parses an HTTP response from a PostTeamSetHolidaysWithResponse call
+func ParsePostTeamSetHolidaysResponse(rsp *http.Response) (*PostTeamSetHolidaysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTeamSetHolidaysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TeamHolidaysResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTeamSetSettingsResponse parses an HTTP response from a PostTeamSetSettingsWithResponse call
+func ParsePostTeamSetSettingsResponse(rsp *http.Response) (*PostTeamSetSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTeamSetSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TeamSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostUsersAddExclusionResponse parses an HTTP response from a PostUsersAddExclusionWithResponse call
+func ParsePostUsersAddExclusionResponse(rsp *http.Response) (*PostUsersAddExclusionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUsersAddExclusionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Exclusion ReviewerExclusion `json:"exclusion"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersGetExclusionsResponse parses an HTTP response from a GetUsersGetExclusionsWithResponse call
+func ParseGetUsersGetExclusionsResponse(rsp *http.Response) (*GetUsersGetExclusionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersGetExclusionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Exclusions []ReviewerExclusion `json:"exclusions"`
+			UserId     string              `json:"user_id"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersGetReviewResponse parses an HTTP response from a GetUsersGetReviewWithResponse call
+func ParseGetUsersGetReviewResponse(rsp *http.Response) (*GetUsersGetReviewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersGetReviewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			PullRequests []PullRequestShort `json:"pull_requests"`
+			UserId       string             `json:"user_id"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostUsersImportAvailabilityResponse parses an HTTP response from a PostUsersImportAvailabilityWithResponse call
+func ParsePostUsersImportAvailabilityResponse(rsp *http.Response) (*PostUsersImportAvailabilityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUsersImportAvailabilityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// Imported Количество созданных или обновленных окон недоступности
+			Imported int64 `json:"imported"`
+
+			// ReassignedPrs Количество открытых PR, переназначенных по начавшимся окнам
+			ReassignedPrs int64 `json:"reassigned_prs"`
+
+			// Skipped Количество событий, не являющихся отсутствием или уже завершившихся
+			Skipped int64 `json:"skipped"`
+
+			// UnmatchedEvents События отсутствия, для которых не найден пользователь или не удалось определить время (например, TZID неизвестной зоны)
+			UnmatchedEvents []string `json:"unmatched_events"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostUsersRemoveExclusionResponse parses an HTTP response from a PostUsersRemoveExclusionWithResponse call
+func ParsePostUsersRemoveExclusionResponse(rsp *http.Response) (*PostUsersRemoveExclusionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUsersRemoveExclusionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Exclusion ReviewerExclusion `json:"exclusion"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostUsersSetAvailabilityResponse parses an HTTP response from a PostUsersSetAvailabilityWithResponse call
+func ParsePostUsersSetAvailabilityResponse(rsp *http.Response) (*PostUsersSetAvailabilityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUsersSetAvailabilityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Availability UserAvailability `json:"availability"`
+
+			// ReassignedPrs Количество открытых PR, переназначенных сразу (если окно уже началось)
+			ReassignedPrs int64 `json:"reassigned_prs"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParsePostTeamAddResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostTeamAddWithResponse(ctx context.Context, body PostTeamAddJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error) {
-	rsp, err := c.PostTeamAdd(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostTeamAddResponse(rsp)
+	return response, nil
 }
 
-// PostTeamDeactivateWithBodyWithResponse request with arbitrary body returning *PostTeamDeactivateResponse
-func (c *ClientWithResponses) PostTeamDeactivateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamDeactivateResponse, error) {
-	rsp, err := c.PostTeamDeactivateWithBody(ctx, contentType, body, reqEditors...)
+// ParsePostUsersSetDigestResponse parses an HTTP response from a PostUsersSetDigestWithResponse call
+func ParsePostUsersSetDigestResponse(rsp *http.Response) (*PostUsersSetDigestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostTeamDeactivateResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostTeamDeactivateWithResponse(ctx context.Context, body PostTeamDeactivateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamDeactivateResponse, error) {
-	rsp, err := c.PostTeamDeactivate(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostUsersSetDigestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostTeamDeactivateResponse(rsp)
-}
 
-// GetTeamGetWithResponse request returning *GetTeamGetResponse
-func (c *ClientWithResponses) GetTeamGetWithResponse(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*GetTeamGetResponse, error) {
-	rsp, err := c.GetTeamGet(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetTeamGetResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Digest DigestSettings `json:"digest"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// GetUsersGetReviewWithResponse request returning *GetUsersGetReviewResponse
-func (c *ClientWithResponses) GetUsersGetReviewWithResponse(ctx context.Context, params *GetUsersGetReviewParams, reqEditors ...RequestEditorFn) (*GetUsersGetReviewResponse, error) {
-	rsp, err := c.GetUsersGetReview(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetUsersGetReviewResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// PostUsersSetIsActiveWithBodyWithResponse request with arbitrary body returning *PostUsersSetIsActiveResponse
-func (c *ClientWithResponses) PostUsersSetIsActiveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error) {
-	rsp, err := c.PostUsersSetIsActiveWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostUsersSetIsActiveResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-func (c *ClientWithResponses) PostUsersSetIsActiveWithResponse(ctx context.Context, body PostUsersSetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error) {
-	rsp, err := c.PostUsersSetIsActive(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParsePostUsersSetIsActiveResponse(rsp)
+
+	return response, nil
 }
 
-// ParsePostPullRequestCreateResponse parses an HTTP response from a PostPullRequestCreateWithResponse call
-func ParsePostPullRequestCreateResponse(rsp *http.Response) (*PostPullRequestCreateResponse, error) {
+// ParsePostUsersSetIsActiveResponse parses an HTTP response from a PostUsersSetIsActiveWithResponse call
+func ParsePostUsersSetIsActiveResponse(rsp *http.Response) (*PostUsersSetIsActiveResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostPullRequestCreateResponse{
+	response := &PostUsersSetIsActiveResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Pr *PullRequest `json:"pr,omitempty"`
+			User *User `json:"user,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParsePostPullRequestMergeResponse parses an HTTP response from a PostPullRequestMergeWithResponse call
-func ParsePostPullRequestMergeResponse(rsp *http.Response) (*PostPullRequestMergeResponse, error) {
+// ParsePostUsersSetLevelResponse parses an HTTP response from a PostUsersSetLevelWithResponse call
+func ParsePostUsersSetLevelResponse(rsp *http.Response) (*PostUsersSetLevelResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostPullRequestMergeResponse{
+	response := &PostUsersSetLevelResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -1162,13 +6786,20 @@ func ParsePostPullRequestMergeResponse(rsp *http.Response) (*PostPullRequestMerg
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Pr *PullRequest `json:"pr,omitempty"`
+			User *User `json:"user,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -1181,15 +6812,15 @@ func ParsePostPullRequestMergeResponse(rsp *http.Response) (*PostPullRequestMerg
 	return response, nil
 }
 
-// ParsePostPullRequestReassignResponse parses an HTTP response from a PostPullRequestReassignWithResponse call
-func ParsePostPullRequestReassignResponse(rsp *http.Response) (*PostPullRequestReassignResponse, error) {
+// ParsePostUsersSetMaxOpenReviewsResponse parses an HTTP response from a PostUsersSetMaxOpenReviewsWithResponse call
+func ParsePostUsersSetMaxOpenReviewsResponse(rsp *http.Response) (*PostUsersSetMaxOpenReviewsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostPullRequestReassignResponse{
+	response := &PostUsersSetMaxOpenReviewsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -1197,83 +6828,96 @@ func ParsePostPullRequestReassignResponse(rsp *http.Response) (*PostPullRequestR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Pr PullRequest `json:"pr"`
-
-			// ReplacedBy user_id нового ревьювера
-			ReplacedBy string `json:"replaced_by"`
+			User *User `json:"user,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetStatsReviewersResponse parses an HTTP response from a GetStatsReviewersWithResponse call
-func ParseGetStatsReviewersResponse(rsp *http.Response) (*GetStatsReviewersResponse, error) {
+// ParsePostUsersSetReviewWeightResponse parses an HTTP response from a PostUsersSetReviewWeightWithResponse call
+func ParsePostUsersSetReviewWeightResponse(rsp *http.Response) (*PostUsersSetReviewWeightResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetStatsReviewersResponse{
+	response := &PostUsersSetReviewWeightResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ReviewerStatsResponse
+		var dest struct {
+			User *User `json:"user,omitempty"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParsePostTeamAddResponse parses an HTTP response from a PostTeamAddWithResponse call
-func ParsePostTeamAddResponse(rsp *http.Response) (*PostTeamAddResponse, error) {
+// ParsePostUsersSetScheduleResponse parses an HTTP response from a PostUsersSetScheduleWithResponse call
+func ParsePostUsersSetScheduleResponse(rsp *http.Response) (*PostUsersSetScheduleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostTeamAddResponse{
+	response := &PostUsersSetScheduleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Team *Team `json:"team,omitempty"`
+			User *User `json:"user,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorResponse
@@ -1282,93 +6926,126 @@ func ParsePostTeamAddResponse(rsp *http.Response) (*PostTeamAddResponse, error)
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParsePostTeamDeactivateResponse parses an HTTP response from a PostTeamDeactivateWithResponse call
-func ParsePostTeamDeactivateResponse(rsp *http.Response) (*PostTeamDeactivateResponse, error) {
+// ParsePostUsersSetSkillsResponse parses an HTTP response from a PostUsersSetSkillsWithResponse call
+func ParsePostUsersSetSkillsResponse(rsp *http.Response) (*PostUsersSetSkillsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostTeamDeactivateResponse{
+	response := &PostUsersSetSkillsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TeamDeactivateResult
+		var dest struct {
+			Skills []string `json:"skills"`
+			UserId string   `json:"user_id"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetTeamGetResponse parses an HTTP response from a GetTeamGetWithResponse call
-func ParseGetTeamGetResponse(rsp *http.Response) (*GetTeamGetResponse, error) {
+// ParseGetWebhooksDeadLettersResponse parses an HTTP response from a GetWebhooksDeadLettersWithResponse call
+func ParseGetWebhooksDeadLettersResponse(rsp *http.Response) (*GetWebhooksDeadLettersResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetTeamGetResponse{
+	response := &GetWebhooksDeadLettersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Team
+		var dest struct {
+			Deliveries []WebhookDelivery `json:"deliveries"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
+	}
+
+	return response, nil
+}
+
+// ParseGetWebhooksListResponse parses an HTTP response from a GetWebhooksListWithResponse call
+func ParseGetWebhooksListResponse(rsp *http.Response) (*GetWebhooksListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWebhooksListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Subscriptions []WebhookSubscription `json:"subscriptions"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetUsersGetReviewResponse parses an HTTP response from a GetUsersGetReviewWithResponse call
-func ParseGetUsersGetReviewResponse(rsp *http.Response) (*GetUsersGetReviewResponse, error) {
+// ParsePostWebhooksRedeliverResponse parses an HTTP response from a PostWebhooksRedeliverWithResponse call
+func ParsePostWebhooksRedeliverResponse(rsp *http.Response) (*PostWebhooksRedeliverResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetUsersGetReviewResponse{
+	response := &PostWebhooksRedeliverResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -1376,14 +7053,20 @@ func ParseGetUsersGetReviewResponse(rsp *http.Response) (*GetUsersGetReviewRespo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			PullRequests []PullRequestShort `json:"pull_requests"`
-			UserId       string             `json:"user_id"`
+			Delivery WebhookDelivery `json:"delivery"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -1396,35 +7079,63 @@ func ParseGetUsersGetReviewResponse(rsp *http.Response) (*GetUsersGetReviewRespo
 	return response, nil
 }
 
-// ParsePostUsersSetIsActiveResponse parses an HTTP response from a PostUsersSetIsActiveWithResponse call
-func ParsePostUsersSetIsActiveResponse(rsp *http.Response) (*PostUsersSetIsActiveResponse, error) {
+// ParsePostWebhooksSubscribeResponse parses an HTTP response from a PostWebhooksSubscribeWithResponse call
+func ParsePostWebhooksSubscribeResponse(rsp *http.Response) (*PostWebhooksSubscribeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostUsersSetIsActiveResponse{
+	response := &PostWebhooksSubscribeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
 		var dest struct {
-			User *User `json:"user,omitempty"`
+			Subscription WebhookSubscription `json:"subscription"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostWebhooksUnsubscribeResponse parses an HTTP response from a PostWebhooksUnsubscribeWithResponse call
+func ParsePostWebhooksUnsubscribeResponse(rsp *http.Response) (*PostWebhooksUnsubscribeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostWebhooksUnsubscribeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			SubscriptionId int64 `json:"subscription_id"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
@@ -0,0 +1,181 @@
+// Package codeowners разбирает файлы в синтаксисе GitHub CODEOWNERS
+// и определяет владельцев путей.
+package codeowners
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// Rule правило CODEOWNERS: шаблон пути и его владельцы
+type Rule struct {
+	Pattern string
+	Owners  []string
+	Line    int
+	re      *regexp.Regexp
+}
+
+// Ruleset набор правил в порядке следования в файле
+type Ruleset struct {
+	Rules []Rule
+}
+
+// Parse читает правила CODEOWNERS. Пустые строки и комментарии (#) пропускаются.
+func Parse(r io.Reader) (*Ruleset, error) {
+	ruleset := &Ruleset{}
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := stripComment(scanner.Text())
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		pattern := strings.ReplaceAll(fields[0], `\#`, "#")
+		re, err := compilePattern(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+
+		owners := fields[1:]
+		for _, owner := range owners {
+			if !validOwner(owner) {
+				return nil, fmt.Errorf("line %d: invalid owner %q", lineNumber, owner)
+			}
+		}
+
+		ruleset.Rules = append(ruleset.Rules, Rule{
+			Pattern: pattern,
+			Owners:  owners,
+			Line:    lineNumber,
+			re:      re,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return ruleset, nil
+}
+
+// Owners возвращает владельцев пути. Как и в GitHub, действует последнее подходящее правило;
+// правило без владельцев снимает владение с пути.
+func (rs *Ruleset) Owners(path string) []string {
+	path = strings.TrimPrefix(path, "/")
+	for i := len(rs.Rules) - 1; i >= 0; i-- {
+		if rs.Rules[i].re.MatchString(path) {
+			return rs.Rules[i].Owners
+		}
+	}
+	return nil
+}
+
+// Owner описывает владельца из правила
+type Owner struct {
+	// User логин пользователя (@login)
+	User string
+	// Team имя команды без организации (@org/team)
+	Team string
+	// Email адрес электронной почты
+	Email string
+}
+
+// ParseOwner разбирает владельца вида @login, @org/team или email
+func ParseOwner(owner string) Owner {
+	if !strings.HasPrefix(owner, "@") {
+		return Owner{Email: owner}
+	}
+	name := owner[1:]
+	if _, team, found := strings.Cut(name, "/"); found {
+		return Owner{Team: team}
+	}
+	return Owner{User: name}
+}
+
+func validOwner(owner string) bool {
+	if strings.HasPrefix(owner, "@") {
+		name := owner[1:]
+		org, team, isTeam := strings.Cut(name, "/")
+		if isTeam {
+			return org != "" && team != "" && !strings.Contains(team, "/")
+		}
+		return name != ""
+	}
+	local, domain, found := strings.Cut(owner, "@")
+	return found && local != "" && domain != ""
+}
+
+// stripComment удаляет комментарий, начинающийся с неэкранированного #
+func stripComment(line string) string {
+	for i := 0; i < len(line); i++ {
+		if line[i] == '#' && (i == 0 || line[i-1] != '\\') {
+			return line[:i]
+		}
+	}
+	return line
+}
+
+// compilePattern переводит шаблон в стиле gitignore в регулярное выражение:
+//   - шаблон со слешем в начале или середине привязан к корню, без слеша совпадает на любой глубине;
+//   - слеш в конце означает каталог со всем содержимым;
+//   - шаблон, совпавший с каталогом, распространяется на все файлы внутри, кроме шаблонов
+//     с * или ? в последнем сегменте (docs/* не захватывает вложенные каталоги, как в GitHub);
+//   - * — любые символы, кроме /, ** — любое количество каталогов, ? — один символ, кроме /.
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, fmt.Errorf("empty pattern")
+	}
+	if strings.HasPrefix(pattern, "!") {
+		return nil, fmt.Errorf("negation patterns are not supported: %q", pattern)
+	}
+	if strings.ContainsAny(pattern, "[]") {
+		return nil, fmt.Errorf("character ranges are not supported: %q", pattern)
+	}
+
+	dirOnly := strings.HasSuffix(pattern, "/")
+	trimmed := strings.Trim(pattern, "/")
+	anchored := strings.HasPrefix(pattern, "/") || strings.Contains(trimmed, "/")
+
+	var expr strings.Builder
+	expr.WriteString("^")
+	if !anchored {
+		expr.WriteString("(?:.*/)?")
+	}
+
+	for i := 0; i < len(trimmed); i++ {
+		ch := trimmed[i]
+		switch {
+		case ch == '*' && i+1 < len(trimmed) && trimmed[i+1] == '*':
+			// "**/" — ноль или больше каталогов, завершающий "**" — все содержимое
+			if i+2 < len(trimmed) && trimmed[i+2] == '/' {
+				expr.WriteString("(?:.*/)?")
+				i += 2
+			} else {
+				expr.WriteString(".*")
+				i++
+			}
+		case ch == '*':
+			expr.WriteString("[^/]*")
+		case ch == '?':
+			expr.WriteString("[^/]")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(ch)))
+		}
+	}
+
+	lastSegment := trimmed[strings.LastIndex(trimmed, "/")+1:]
+	switch {
+	case dirOnly:
+		expr.WriteString("/.*$")
+	case strings.ContainsAny(lastSegment, "*?") && lastSegment != "**":
+		expr.WriteString("$")
+	default:
+		expr.WriteString("(?:/.*)?$")
+	}
+
+	return regexp.Compile(expr.String())
+}
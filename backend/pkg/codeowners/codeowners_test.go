@@ -0,0 +1,79 @@
+package codeowners
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const sampleCodeowners = `# Владельцы по умолчанию
+*                       @acme/backend
+
+# Документация
+docs/*                  docs@example.com
+/build/logs/            @alice
+*.sql                   @bob @acme/dba
+apps/**/api             @carol
+/scripts/generated      # без владельцев
+\#notes.md              @dave
+`
+
+func TestOwners(t *testing.T) {
+	ruleset, err := Parse(strings.NewReader(sampleCodeowners))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	cases := map[string][]string{
+		"main.go":                      {"@acme/backend"},
+		"docs/index.md":                {"docs@example.com"},
+		"docs/guides/setup.md":         {"@acme/backend"},
+		"build/logs/today.log":         {"@alice"},
+		"src/build/logs/today.log":     {"@acme/backend"},
+		"db/migrations/001.sql":        {"@bob", "@acme/dba"},
+		"apps/api/handler.go":          {"@carol"},
+		"apps/shop/v2/api/handler.go":  {"@carol"},
+		"scripts/generated/client.go":  nil,
+		"#notes.md":                    {"@dave"},
+		"/db/schema.sql":               {"@bob", "@acme/dba"},
+		"scripts/generated_helpers.go": {"@acme/backend"},
+	}
+	for path, expected := range cases {
+		owners := ruleset.Owners(path)
+		if len(owners) == 0 && len(expected) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(owners, expected) {
+			t.Errorf("Owners(%q) = %v, expected %v", path, owners, expected)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	cases := map[string]string{
+		"negation":     "!docs/ @alice",
+		"range":        "[a-z].go @alice",
+		"bad owner":    "*.go alice",
+		"bad team":     "*.go @acme/",
+		"nested team":  "*.go @acme/a/b",
+		"empty handle": "*.go @",
+	}
+	for name, content := range cases {
+		if _, err := Parse(strings.NewReader(content)); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestParseOwner(t *testing.T) {
+	cases := map[string]Owner{
+		"@alice":            {User: "alice"},
+		"@acme/backend":     {Team: "backend"},
+		"alice@example.com": {Email: "alice@example.com"},
+	}
+	for raw, expected := range cases {
+		if owner := ParseOwner(raw); owner != expected {
+			t.Errorf("ParseOwner(%q) = %+v, expected %+v", raw, owner, expected)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+// Package gitlabhook разбирает события Merge Request Hook вебхуков GitLab
+// и проверяет секретный токен вебхука.
+package gitlabhook
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+)
+
+// Заголовки запросов вебхуков GitLab
+const (
+	EventHeader = "X-Gitlab-Event"
+	TokenHeader = "X-Gitlab-Token"
+)
+
+// MergeRequestHook значение заголовка X-Gitlab-Event для событий merge request
+const MergeRequestHook = "Merge Request Hook"
+
+// Action действие с merge request
+type Action string
+
+const (
+	ActionOpen   Action = "open"
+	ActionReopen Action = "reopen"
+	ActionClose  Action = "close"
+	ActionMerge  Action = "merge"
+	// ActionUpdate изменение MR, не меняющее признак черновика
+	ActionUpdate Action = "update"
+	// ActionReady черновик помечен готовым к ревью (update со снятием признака черновика)
+	ActionReady Action = "ready"
+	// ActionDraft MR помечен черновиком (update с установкой признака черновика)
+	ActionDraft Action = "draft"
+)
+
+// MergeRequestEvent событие Merge Request Hook
+type MergeRequestEvent struct {
+	Action Action
+	// Project полный путь проекта (group/subgroup/name)
+	Project string
+	// IID номер MR внутри проекта
+	IID   int
+	Title string
+	Draft bool
+	// AuthorID ID автора MR в GitLab
+	AuthorID int64
+	// UserID и Username пользователь, выполнивший действие; событие не содержит логина автора,
+	// поэтому логин автора известен, только если действие выполнил сам автор
+	UserID   int64
+	Username string
+}
+
+// AuthorUsername возвращает логин автора MR или пустую строку, если действие выполнил другой пользователь
+func (e *MergeRequestEvent) AuthorUsername() string {
+	if e.UserID != e.AuthorID {
+		return ""
+	}
+	return e.Username
+}
+
+// ErrNotMergeRequest тело не является событием merge request
+var ErrNotMergeRequest = errors.New("gitlabhook: not a merge request event")
+
+type payload struct {
+	ObjectKind string `json:"object_kind"`
+	User       struct {
+		ID       int64  `json:"id"`
+		Username string `json:"username"`
+	} `json:"user"`
+	Project struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		IID      int    `json:"iid"`
+		Title    string `json:"title"`
+		AuthorID int64  `json:"author_id"`
+		Action   string `json:"action"`
+		Draft    *bool  `json:"draft"`
+		// WorkInProgress признак черновика в версиях GitLab до появления поля draft
+		WorkInProgress bool `json:"work_in_progress"`
+	} `json:"object_attributes"`
+	Changes struct {
+		Draft          *change `json:"draft"`
+		WorkInProgress *change `json:"work_in_progress"`
+	} `json:"changes"`
+}
+
+type change struct {
+	Previous bool `json:"previous"`
+	Current  bool `json:"current"`
+}
+
+// ParseMergeRequest разбирает тело события Merge Request Hook
+func ParseMergeRequest(body []byte) (*MergeRequestEvent, error) {
+	var p payload
+	if err := json.Unmarshal(body, &p); err != nil {
+		return nil, err
+	}
+	if p.ObjectKind != "merge_request" {
+		return nil, ErrNotMergeRequest
+	}
+
+	attrs := p.ObjectAttributes
+	event := &MergeRequestEvent{
+		Action:   Action(attrs.Action),
+		Project:  p.Project.PathWithNamespace,
+		IID:      attrs.IID,
+		Title:    attrs.Title,
+		Draft:    attrs.WorkInProgress,
+		AuthorID: attrs.AuthorID,
+		UserID:   p.User.ID,
+		Username: p.User.Username,
+	}
+	if attrs.Draft != nil {
+		event.Draft = *attrs.Draft
+	}
+
+	if event.Action == ActionUpdate {
+		draftChange := p.Changes.Draft
+		if draftChange == nil {
+			draftChange = p.Changes.WorkInProgress
+		}
+		if draftChange != nil && draftChange.Previous != draftChange.Current {
+			event.Action = ActionReady
+			if draftChange.Current {
+				event.Action = ActionDraft
+			}
+		}
+	}
+
+	return event, nil
+}
+
+// VerifyToken сравнивает токен из заголовка X-Gitlab-Token с секретом за постоянное время
+func VerifyToken(secret, token string) bool {
+	if secret == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(secret), []byte(token)) == 1
+}
@@ -0,0 +1,76 @@
+package gitlabhook
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseMergeRequestFixtures(t *testing.T) {
+	tests := []struct {
+		fixture  string
+		action   Action
+		draft    bool
+		username string
+	}{
+		{"open.json", ActionOpen, false, "alice"},
+		{"open_draft.json", ActionOpen, true, "alice"},
+		{"ready.json", ActionReady, false, "alice"},
+		{"ready_legacy.json", ActionReady, false, "alice"},
+		{"to_draft.json", ActionDraft, true, "alice"},
+		{"update_description.json", ActionUpdate, false, "alice"},
+		{"merge.json", ActionMerge, false, ""},
+		{"close.json", ActionClose, false, "alice"},
+		{"reopen.json", ActionReopen, false, "alice"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			body, err := os.ReadFile(filepath.Join("testdata", tt.fixture))
+			if err != nil {
+				t.Fatalf("read fixture: %v", err)
+			}
+
+			event, err := ParseMergeRequest(body)
+			if err != nil {
+				t.Fatalf("ParseMergeRequest: %v", err)
+			}
+			if event.Action != tt.action {
+				t.Errorf("expected action %q, got %q", tt.action, event.Action)
+			}
+			if event.Draft != tt.draft {
+				t.Errorf("expected draft %v, got %v", tt.draft, event.Draft)
+			}
+			if event.AuthorUsername() != tt.username {
+				t.Errorf("expected author username %q, got %q", tt.username, event.AuthorUsername())
+			}
+			if event.Project != "payments/billing" || event.IID != 128 {
+				t.Errorf("unexpected merge request %s!%d", event.Project, event.IID)
+			}
+		})
+	}
+}
+
+func TestParseMergeRequestRejectsOtherEvents(t *testing.T) {
+	_, err := ParseMergeRequest([]byte(`{"object_kind":"push","ref":"refs/heads/main"}`))
+	if !errors.Is(err, ErrNotMergeRequest) {
+		t.Fatalf("expected ErrNotMergeRequest, got %v", err)
+	}
+
+	if _, err := ParseMergeRequest([]byte(`{`)); err == nil {
+		t.Fatal("expected error for malformed payload")
+	}
+}
+
+func TestVerifyToken(t *testing.T) {
+	if !VerifyToken("s3cret", "s3cret") {
+		t.Error("expected matching token to be accepted")
+	}
+	if VerifyToken("s3cret", "S3cret") {
+		t.Error("expected different token to be rejected")
+	}
+	if VerifyToken("", "") {
+		t.Error("expected empty secret to reject every token")
+	}
+}
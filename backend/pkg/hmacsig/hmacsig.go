@@ -0,0 +1,34 @@
+// Package hmacsig подписывает и проверяет тела HTTP-запросов HMAC-SHA256
+// в формате "sha256=<hex>" (как заголовок X-Hub-Signature-256 GitHub).
+package hmacsig
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// Prefix префикс значения подписи
+const Prefix = "sha256="
+
+// Sign возвращает подпись body ключом secret
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return Prefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify проверяет подпись signature за постоянное время
+func Verify(secret, body []byte, signature string) bool {
+	if !strings.HasPrefix(signature, Prefix) {
+		return false
+	}
+	got, err := hex.DecodeString(strings.TrimPrefix(signature, Prefix))
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
@@ -0,0 +1,41 @@
+package hmacsig
+
+import "testing"
+
+func TestSign(t *testing.T) {
+	// Пример из документации GitHub о проверке подписи вебхуков
+	got := Sign([]byte("It's a Secret to Everybody"), []byte("Hello, World!"))
+	want := "sha256=757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17"
+	if got != want {
+		t.Errorf("Sign() = %s, want %s", got, want)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	secret := []byte("secret")
+	body := []byte(`{"id":"1"}`)
+	signature := Sign(secret, body)
+
+	tests := []struct {
+		name      string
+		secret    []byte
+		body      []byte
+		signature string
+		want      bool
+	}{
+		{"valid", secret, body, signature, true},
+		{"other secret", []byte("other"), body, signature, false},
+		{"modified body", secret, []byte(`{"id":"2"}`), signature, false},
+		{"missing prefix", secret, body, signature[len(Prefix):], false},
+		{"not hex", secret, body, Prefix + "zz", false},
+		{"empty", secret, body, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Verify(tt.secret, tt.body, tt.signature); got != tt.want {
+				t.Errorf("Verify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
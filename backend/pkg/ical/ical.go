@@ -0,0 +1,395 @@
+// Package ical содержит минимальный разбор календарей iCalendar (RFC 5545),
+// достаточный для импорта событий отсутствия сотрудников.
+package ical
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Person участник события (ORGANIZER или ATTENDEE)
+type Person struct {
+	Email      string
+	CommonName string
+}
+
+// Event событие VEVENT
+type Event struct {
+	UID        string
+	Summary    string
+	Start      time.Time
+	End        time.Time
+	AllDay     bool
+	Status     string
+	Categories []string
+	Organizer  *Person
+	Attendees  []Person
+	// BusyStatus значение X-MICROSOFT-CDO-INTENDEDSTATUS / X-MICROSOFT-CDO-BUSYSTATUS (Outlook, Exchange)
+	BusyStatus string
+	// TimeError ошибка определения времени события (например, TZID неизвестной зоны);
+	// Start и End такого события недостоверны
+	TimeError error
+
+	// duration значение DURATION; End по нему вычисляется в конце VEVENT, когда известен DTSTART
+	duration *time.Duration
+}
+
+// ErrUnknownTimezone TZID не соответствует ни одной известной зоне (например, имя зоны Windows из Outlook)
+var ErrUnknownTimezone = errors.New("unknown time zone")
+
+// outOfOfficeCategories категории, которыми календари помечают отсутствие
+var outOfOfficeCategories = map[string]struct{}{
+	"OUT OF OFFICE": {},
+	"OUT-OF-OFFICE": {},
+	"OOO":           {},
+	"VACATION":      {},
+	"HOLIDAY":       {},
+	"LEAVE":         {},
+}
+
+// IsOutOfOffice проверяет, помечено ли событие как отсутствие на работе
+func (e *Event) IsOutOfOffice() bool {
+	if strings.EqualFold(e.Status, "CANCELLED") {
+		return false
+	}
+	if strings.EqualFold(e.BusyStatus, "OOF") {
+		return true
+	}
+	for _, category := range e.Categories {
+		if _, ok := outOfOfficeCategories[strings.ToUpper(strings.TrimSpace(category))]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// People возвращает организатора и участников события
+func (e *Event) People() []Person {
+	people := make([]Person, 0, len(e.Attendees)+1)
+	if e.Organizer != nil {
+		people = append(people, *e.Organizer)
+	}
+	return append(people, e.Attendees...)
+}
+
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// ParseEvents читает календарь и возвращает все события VEVENT.
+// Повторяющиеся события (RRULE) возвращаются одним первым вхождением.
+func ParseEvents(r io.Reader) ([]Event, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []Event
+	var current *Event
+	// nested считает вложенные в VEVENT компоненты (например, VALARM), их свойства пропускаются
+	nested := 0
+
+	for i, line := range lines {
+		if line == "" {
+			continue
+		}
+		prop, err := parseLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		switch {
+		case prop.name == "BEGIN" && strings.EqualFold(prop.value, "VEVENT") && current == nil:
+			current = &Event{}
+			continue
+		case prop.name == "END" && strings.EqualFold(prop.value, "VEVENT") && current != nil && nested == 0:
+			current.finish()
+			events = append(events, *current)
+			current = nil
+			continue
+		case current == nil:
+			continue
+		case prop.name == "BEGIN":
+			nested++
+			continue
+		case prop.name == "END":
+			nested--
+			continue
+		case nested > 0:
+			continue
+		}
+
+		if err := current.apply(prop); err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+	}
+
+	if current != nil {
+		return nil, fmt.Errorf("unterminated VEVENT")
+	}
+
+	return events, nil
+}
+
+func (e *Event) apply(prop property) error {
+	switch prop.name {
+	case "UID":
+		e.UID = prop.value
+	case "SUMMARY":
+		e.Summary = unescapeText(prop.value)
+	case "STATUS":
+		e.Status = prop.value
+	case "CATEGORIES":
+		e.Categories = append(e.Categories, splitText(prop.value)...)
+	case "X-MICROSOFT-CDO-INTENDEDSTATUS", "X-MICROSOFT-CDO-BUSYSTATUS":
+		if e.BusyStatus == "" || strings.EqualFold(prop.value, "OOF") {
+			e.BusyStatus = prop.value
+		}
+	case "ORGANIZER":
+		person := parsePerson(prop)
+		e.Organizer = &person
+	case "ATTENDEE":
+		e.Attendees = append(e.Attendees, parsePerson(prop))
+	case "DTSTART":
+		start, allDay, err := parseTime(prop)
+		if err != nil {
+			return e.timeError("DTSTART", err)
+		}
+		e.Start, e.AllDay = start, allDay
+	case "DTEND":
+		end, _, err := parseTime(prop)
+		if err != nil {
+			return e.timeError("DTEND", err)
+		}
+		e.End = end
+	case "DURATION":
+		duration, err := parseDuration(prop.value)
+		if err != nil {
+			return fmt.Errorf("DURATION: %w", err)
+		}
+		e.duration = &duration
+	}
+	return nil
+}
+
+// timeError запоминает неизвестную зону в TimeError, чтобы одно событие не прерывало разбор всего календаря;
+// остальные ошибки возвращаются как есть
+func (e *Event) timeError(name string, err error) error {
+	err = fmt.Errorf("%s: %w", name, err)
+	if !errors.Is(err, ErrUnknownTimezone) {
+		return err
+	}
+	if e.TimeError == nil {
+		e.TimeError = err
+	}
+	return nil
+}
+
+// finish вычисляет окончание события без DTEND: по DURATION, для событий на весь день — через сутки
+func (e *Event) finish() {
+	if !e.End.IsZero() {
+		return
+	}
+	switch {
+	case e.duration != nil:
+		e.End = e.Start.Add(*e.duration)
+	case e.AllDay:
+		e.End = e.Start.AddDate(0, 0, 1)
+	default:
+		e.End = e.Start
+	}
+}
+
+// unfold склеивает перенесенные строки (продолжение начинается с пробела или табуляции)
+func unfold(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(line) > 0 && (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, scanner.Err()
+}
+
+// parseLine разбирает строку вида NAME;PARAM=VALUE:VALUE
+func parseLine(line string) (property, error) {
+	prop := property{params: map[string]string{}}
+
+	inQuotes := false
+	colon := -1
+	for i, ch := range line {
+		if ch == '"' {
+			inQuotes = !inQuotes
+		}
+		if ch == ':' && !inQuotes {
+			colon = i
+			break
+		}
+	}
+	if colon < 0 {
+		return prop, fmt.Errorf("malformed content line %q", line)
+	}
+
+	head, value := line[:colon], line[colon+1:]
+	parts := splitParams(head)
+	prop.name = strings.ToUpper(parts[0])
+	prop.value = value
+	for _, param := range parts[1:] {
+		key, paramValue, found := strings.Cut(param, "=")
+		if !found {
+			continue
+		}
+		prop.params[strings.ToUpper(key)] = strings.Trim(paramValue, `"`)
+	}
+	return prop, nil
+}
+
+func splitParams(head string) []string {
+	var parts []string
+	var buf bytes.Buffer
+	inQuotes := false
+	for _, ch := range head {
+		switch {
+		case ch == '"':
+			inQuotes = !inQuotes
+			buf.WriteRune(ch)
+		case ch == ';' && !inQuotes:
+			parts = append(parts, buf.String())
+			buf.Reset()
+		default:
+			buf.WriteRune(ch)
+		}
+	}
+	return append(parts, buf.String())
+}
+
+func parsePerson(prop property) Person {
+	person := Person{CommonName: prop.params["CN"]}
+	value := prop.value
+	if len(value) >= len("mailto:") && strings.EqualFold(value[:len("mailto:")], "mailto:") {
+		value = value[len("mailto:"):]
+	}
+	person.Email = value
+	return person
+}
+
+func parseTime(prop property) (time.Time, bool, error) {
+	loc := time.UTC
+	if tzid := prop.params["TZID"]; tzid != "" {
+		tz, err := time.LoadLocation(tzid)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("%w %q", ErrUnknownTimezone, tzid)
+		}
+		loc = tz
+	}
+
+	value := prop.value
+	if strings.EqualFold(prop.params["VALUE"], "DATE") || len(value) == len("20060102") {
+		t, err := time.ParseInLocation("20060102", value, loc)
+		return t, true, err
+	}
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse("20060102T150405Z", value)
+		return t, false, err
+	}
+	// Время без зоны и без TZID ("плавающее") трактуется как UTC
+	t, err := time.ParseInLocation("20060102T150405", value, loc)
+	return t, false, err
+}
+
+// parseDuration разбирает длительность вида [+-]P[nW][nD][T[nH][nM][nS]]
+func parseDuration(value string) (time.Duration, error) {
+	sign := time.Duration(1)
+	switch {
+	case strings.HasPrefix(value, "-"):
+		sign = -1
+		value = value[1:]
+	case strings.HasPrefix(value, "+"):
+		value = value[1:]
+	}
+	if !strings.HasPrefix(value, "P") {
+		return 0, fmt.Errorf("malformed duration %q", value)
+	}
+
+	var total time.Duration
+	var number strings.Builder
+	inTime := false
+	for _, ch := range value[1:] {
+		switch {
+		case ch >= '0' && ch <= '9':
+			number.WriteRune(ch)
+			continue
+		case ch == 'T':
+			inTime = true
+			continue
+		}
+
+		n, err := strconv.Atoi(number.String())
+		if err != nil {
+			return 0, fmt.Errorf("malformed duration %q", value)
+		}
+		number.Reset()
+
+		switch {
+		case ch == 'W' && !inTime:
+			total += time.Duration(n) * 7 * 24 * time.Hour
+		case ch == 'D' && !inTime:
+			total += time.Duration(n) * 24 * time.Hour
+		case ch == 'H' && inTime:
+			total += time.Duration(n) * time.Hour
+		case ch == 'M' && inTime:
+			total += time.Duration(n) * time.Minute
+		case ch == 'S' && inTime:
+			total += time.Duration(n) * time.Second
+		default:
+			return 0, fmt.Errorf("malformed duration %q", value)
+		}
+	}
+	if number.Len() > 0 {
+		return 0, fmt.Errorf("malformed duration %q", value)
+	}
+
+	return sign * total, nil
+}
+
+// splitText разбивает список значений TEXT по неэкранированным запятым
+func splitText(value string) []string {
+	var items []string
+	var buf strings.Builder
+	escaped := false
+	for _, ch := range value {
+		switch {
+		case escaped:
+			buf.WriteRune('\\')
+			buf.WriteRune(ch)
+			escaped = false
+		case ch == '\\':
+			escaped = true
+		case ch == ',':
+			items = append(items, unescapeText(buf.String()))
+			buf.Reset()
+		default:
+			buf.WriteRune(ch)
+		}
+	}
+	return append(items, unescapeText(buf.String()))
+}
+
+func unescapeText(value string) string {
+	replacer := strings.NewReplacer(`\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";", `\\`, `\`)
+	return replacer.Replace(value)
+}
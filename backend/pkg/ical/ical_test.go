@@ -0,0 +1,136 @@
+package ical
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+const sampleCalendar = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"PRODID:-//Test//EN\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:vacation-1@example.com\r\n" +
+	"SUMMARY:Alice\\, vacation\r\n" +
+	"DTSTART;VALUE=DATE:20251103\r\n" +
+	"DTEND;VALUE=DATE:20251108\r\n" +
+	"ORGANIZER;CN=\"Alice: Backend\":mailto:alice@example.com\r\n" +
+	"X-MICROSOFT-CDO-INTENDEDSTATUS:OOF\r\n" +
+	"BEGIN:VALARM\r\n" +
+	"ACTION:DISPLAY\r\n" +
+	"DESCRIPTION:Reminder\r\n" +
+	"END:VALARM\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:sick-1\r\n" +
+	"SUMMARY:Sick leave\r\n" +
+	"DTSTART;TZID=Europe/Moscow:20251110T090000\r\n" +
+	"DURATION:PT8H\r\n" +
+	"CATEGORIES:Personal,Out of Office\r\n" +
+	"ATTENDEE;CN=Bob;ROLE=REQ-PARTICIPANT:mailto:b\r\n" +
+	" ob@example.com\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:standup\r\n" +
+	"SUMMARY:Standup\r\n" +
+	"DTSTART:20251110T070000Z\r\n" +
+	"DTEND:20251110T071500Z\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestParseEvents(t *testing.T) {
+	events, err := ParseEvents(strings.NewReader(sampleCalendar))
+	if err != nil {
+		t.Fatalf("ParseEvents: %v", err)
+	}
+	if len(events) != 3 {
+		t.Fatalf("expected 3 events, got %d", len(events))
+	}
+
+	vacation := events[0]
+	if vacation.Summary != "Alice, vacation" {
+		t.Errorf("unexpected summary %q", vacation.Summary)
+	}
+	if !vacation.AllDay || !vacation.Start.Equal(time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC)) ||
+		!vacation.End.Equal(time.Date(2025, 11, 8, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected vacation period %v - %v", vacation.Start, vacation.End)
+	}
+	if vacation.Organizer == nil || vacation.Organizer.Email != "alice@example.com" ||
+		vacation.Organizer.CommonName != "Alice: Backend" {
+		t.Errorf("unexpected organizer %+v", vacation.Organizer)
+	}
+	if !vacation.IsOutOfOffice() {
+		t.Error("vacation should be out of office")
+	}
+
+	sick := events[1]
+	if got := sick.End.Sub(sick.Start); got != 8*time.Hour {
+		t.Errorf("unexpected sick leave duration %v", got)
+	}
+	if len(sick.Attendees) != 1 || sick.Attendees[0].Email != "bob@example.com" {
+		t.Errorf("unexpected attendees %+v", sick.Attendees)
+	}
+	if !sick.IsOutOfOffice() {
+		t.Error("sick leave should be out of office")
+	}
+
+	if events[2].IsOutOfOffice() {
+		t.Error("standup should not be out of office")
+	}
+}
+
+func TestParseEventsErrors(t *testing.T) {
+	cases := map[string]string{
+		"unterminated": "BEGIN:VEVENT\r\nUID:1\r\n",
+		"bad date":     "BEGIN:VEVENT\r\nDTSTART:2025-11-03\r\nEND:VEVENT\r\n",
+		"bad duration": "BEGIN:VEVENT\r\nDTSTART:20251103T090000Z\r\nDURATION:8H\r\nEND:VEVENT\r\n",
+		"no colon":     "BEGIN:VEVENT\r\nSUMMARY\r\nEND:VEVENT\r\n",
+	}
+	for name, calendar := range cases {
+		if _, err := ParseEvents(strings.NewReader(calendar)); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestParseEventsDurationBeforeStart(t *testing.T) {
+	calendar := "BEGIN:VEVENT\r\n" +
+		"DURATION:P2D\r\n" +
+		"DTSTART:20251103T090000Z\r\n" +
+		"END:VEVENT\r\n"
+
+	events, err := ParseEvents(strings.NewReader(calendar))
+	if err != nil {
+		t.Fatalf("ParseEvents: %v", err)
+	}
+	if len(events) != 1 || !events[0].End.Equal(time.Date(2025, 11, 5, 9, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected events %+v", events)
+	}
+}
+
+func TestParseEventsUnknownTimezone(t *testing.T) {
+	calendar := "BEGIN:VEVENT\r\n" +
+		"UID:outlook-1\r\n" +
+		"DTSTART;TZID=Russian Standard Time:20251103T090000\r\n" +
+		"DTEND;TZID=Russian Standard Time:20251103T180000\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:known\r\n" +
+		"DTSTART;TZID=Europe/Moscow:20251103T090000\r\n" +
+		"END:VEVENT\r\n"
+
+	events, err := ParseEvents(strings.NewReader(calendar))
+	if err != nil {
+		t.Fatalf("an unknown zone of one event must not fail the calendar: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(events))
+	}
+	if !errors.Is(events[0].TimeError, ErrUnknownTimezone) || !strings.Contains(events[0].TimeError.Error(), "Russian Standard Time") {
+		t.Errorf("unexpected time error %v", events[0].TimeError)
+	}
+	if events[1].TimeError != nil || events[1].Start.UTC().Hour() != 6 {
+		t.Errorf("known zone: start %v, error %v", events[1].Start, events[1].TimeError)
+	}
+}
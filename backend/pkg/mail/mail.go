@@ -0,0 +1,98 @@
+// Package mail формирует письма MIME с текстовой и HTML-версией для отправки по SMTP.
+package mail
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// Message письмо одному получателю
+type Message struct {
+	From    string
+	To      string
+	Subject string
+	Date    time.Time
+	// Text и HTML версии тела; почтовый клиент показывает HTML, если умеет
+	Text string
+	HTML string
+}
+
+// ValidateAddress проверяет, что address — одиночный адрес вида user@example.com без отображаемого имени
+func ValidateAddress(address string) error {
+	parsed, err := mail.ParseAddress(address)
+	if err != nil {
+		return err
+	}
+	if parsed.Name != "" || parsed.Address != address {
+		return fmt.Errorf("mail: expected bare address, got %q", address)
+	}
+	return nil
+}
+
+// Encode возвращает письмо в формате RFC 5322: multipart/alternative с текстовой и HTML-частями
+// в кодировке quoted-printable
+func (m Message) Encode() ([]byte, error) {
+	if err := ValidateAddress(m.From); err != nil {
+		return nil, fmt.Errorf("invalid sender: %w", err)
+	}
+	if err := ValidateAddress(m.To); err != nil {
+		return nil, fmt.Errorf("invalid recipient: %w", err)
+	}
+	if strings.ContainsAny(m.Subject, "\r\n") {
+		return nil, errors.New("mail: subject must be a single line")
+	}
+
+	date := m.Date
+	if date.IsZero() {
+		date = time.Now()
+	}
+
+	var body bytes.Buffer
+	parts := multipart.NewWriter(&body)
+	if err := writePart(parts, "text/plain; charset=utf-8", m.Text); err != nil {
+		return nil, err
+	}
+	if err := writePart(parts, "text/html; charset=utf-8", m.HTML); err != nil {
+		return nil, err
+	}
+	if err := parts.Close(); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	header := func(name, value string) {
+		buf.WriteString(name + ": " + value + "\r\n")
+	}
+	header("From", m.From)
+	header("To", m.To)
+	header("Subject", mime.QEncoding.Encode("utf-8", m.Subject))
+	header("Date", date.Format(time.RFC1123Z))
+	header("MIME-Version", "1.0")
+	header("Content-Type", `multipart/alternative; boundary="`+parts.Boundary()+`"`)
+	buf.WriteString("\r\n")
+	buf.Write(body.Bytes())
+	return buf.Bytes(), nil
+}
+
+func writePart(parts *multipart.Writer, contentType, content string) error {
+	part, err := parts.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {contentType},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	})
+	if err != nil {
+		return err
+	}
+	encoder := quotedprintable.NewWriter(part)
+	if _, err := encoder.Write([]byte(content)); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
@@ -0,0 +1,109 @@
+package mail
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEncodeMultipartAlternative(t *testing.T) {
+	message := Message{
+		From:    "reviews@example.com",
+		To:      "alice@example.com",
+		Subject: "Ревью: 2 открытых PR",
+		Date:    time.Date(2024, 3, 4, 9, 0, 0, 0, time.UTC),
+		Text:    "Hello, Alice\nPR-1 is waiting for you",
+		HTML:    `<p>Hello, <b>Alice</b></p>`,
+	}
+
+	raw, err := message.Encode()
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+
+	parsed, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		t.Fatalf("ReadMessage: %v", err)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	if err != nil || subject != message.Subject {
+		t.Errorf("expected subject %q, got %q (%v)", message.Subject, subject, err)
+	}
+	if parsed.Header.Get("To") != "alice@example.com" {
+		t.Errorf("unexpected To header %q", parsed.Header.Get("To"))
+	}
+	if date, err := parsed.Header.Date(); err != nil || !date.Equal(message.Date) {
+		t.Errorf("unexpected Date header %q", parsed.Header.Get("Date"))
+	}
+
+	mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/alternative" {
+		t.Fatalf("unexpected content type %q", parsed.Header.Get("Content-Type"))
+	}
+
+	reader := multipart.NewReader(parsed.Body, params["boundary"])
+	var contents []string
+	var types []string
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("NextPart: %v", err)
+		}
+		// multipart.Reader декодирует quoted-printable прозрачно
+		body, err := io.ReadAll(part)
+		if err != nil {
+			t.Fatalf("read part: %v", err)
+		}
+		types = append(types, part.Header.Get("Content-Type"))
+		contents = append(contents, strings.ReplaceAll(string(body), "\r\n", "\n"))
+	}
+
+	if len(contents) != 2 {
+		t.Fatalf("expected 2 parts, got %d", len(contents))
+	}
+	if !strings.HasPrefix(types[0], "text/plain") || contents[0] != message.Text {
+		t.Errorf("unexpected text part %q: %q", types[0], contents[0])
+	}
+	if !strings.HasPrefix(types[1], "text/html") || contents[1] != message.HTML {
+		t.Errorf("unexpected html part %q: %q", types[1], contents[1])
+	}
+}
+
+func TestEncodeRejectsInvalidHeaders(t *testing.T) {
+	valid := Message{From: "reviews@example.com", To: "alice@example.com", Subject: "Digest"}
+
+	tests := map[string]func(m *Message){
+		"recipient":         func(m *Message) { m.To = "not an address" },
+		"recipient list":    func(m *Message) { m.To = "alice@example.com, bob@example.com" },
+		"sender":            func(m *Message) { m.From = "" },
+		"subject injection": func(m *Message) { m.Subject = "Digest\r\nBcc: eve@example.com" },
+	}
+	for name, mutate := range tests {
+		t.Run(name, func(t *testing.T) {
+			message := valid
+			mutate(&message)
+			if _, err := message.Encode(); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestValidateAddress(t *testing.T) {
+	if err := ValidateAddress("alice@example.com"); err != nil {
+		t.Errorf("expected valid address, got %v", err)
+	}
+	for _, address := range []string{"", "alice", "Alice <alice@example.com>", " alice@example.com"} {
+		if err := ValidateAddress(address); err == nil {
+			t.Errorf("expected %q to be rejected", address)
+		}
+	}
+}
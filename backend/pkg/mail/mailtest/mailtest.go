@@ -0,0 +1,237 @@
+// Package mailtest содержит локальный SMTP-сервер для тестов: сервер принимает письма
+// по основному диалогу SMTP (в том числе AUTH PLAIN и STARTTLS) и сохраняет их для проверки.
+package mailtest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"net"
+	"net/textproto"
+	"strings"
+	"test_task_avito/backend/pkg/testserver"
+	"time"
+)
+
+// Message принятое письмо
+type Message struct {
+	From string
+	To   []string
+	// Username логин из AUTH PLAIN (пустой — клиент не аутентифицировался)
+	Username string
+	// TLS письмо передано по соединению, зашифрованному через STARTTLS
+	TLS bool
+	// Data письмо целиком, как его передал клиент
+	Data []byte
+}
+
+// Server локальный SMTP-сервер. FailNext заставляет сервер отклонить следующие n писем временной ошибкой 451,
+// Messages возвращает принятые письма.
+type Server struct {
+	*testserver.TCPServer
+	testserver.Recorder[Message]
+
+	// tlsConfig включает STARTTLS (nil — сервер не предлагает шифрование)
+	tlsConfig *tls.Config
+	// certPool содержит самоподписанный сертификат сервера с STARTTLS
+	certPool *x509.CertPool
+}
+
+// NewServer запускает сервер без шифрования на свободном порту; адрес — Server.Addr.
+// Сервер нужно остановить через Close.
+func NewServer() (*Server, error) {
+	return serve(&Server{})
+}
+
+// NewTLSServer запускает сервер, который предлагает STARTTLS с самоподписанным сертификатом для 127.0.0.1.
+// Чтобы клиент доверял сертификату, передайте ему CertPool.
+func NewTLSServer() (*Server, error) {
+	certificate, err := selfSignedCertificate()
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(certificate.Leaf)
+	return serve(&Server{
+		tlsConfig: &tls.Config{Certificates: []tls.Certificate{certificate}},
+		certPool:  pool,
+	})
+}
+
+// CertPool возвращает пул с сертификатом сервера (nil для сервера без STARTTLS)
+func (s *Server) CertPool() *x509.CertPool {
+	return s.certPool
+}
+
+func serve(s *Server) (*Server, error) {
+	tcp, err := testserver.ServeTCP(s.session)
+	if err != nil {
+		return nil, err
+	}
+	s.TCPServer = tcp
+	return s, nil
+}
+
+// session ведет диалог SMTP с одним клиентом; соединение закрывает TCPServer
+func (s *Server) session(raw net.Conn) {
+	conn := textproto.NewConn(raw)
+	reply := func(code int, text string) bool {
+		return conn.PrintfLine("%d %s", code, text) == nil
+	}
+	if !reply(220, "mailtest ESMTP") {
+		return
+	}
+
+	var current Message
+	var username string
+	secure := false
+	for {
+		line, err := conn.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+
+		switch strings.ToUpper(verb) {
+		case "EHLO":
+			if conn.PrintfLine("250-mailtest") != nil {
+				return
+			}
+			if s.tlsConfig != nil && !secure && conn.PrintfLine("250-STARTTLS") != nil {
+				return
+			}
+			if !reply(250, "AUTH PLAIN") {
+				return
+			}
+		case "STARTTLS":
+			if s.tlsConfig == nil || secure {
+				if !reply(502, "command not implemented") {
+					return
+				}
+				continue
+			}
+			if !reply(220, "ready to start TLS") {
+				return
+			}
+			// После STARTTLS диалог начинается заново по зашифрованному соединению
+			tlsConn := tls.Server(raw, s.tlsConfig)
+			if tlsConn.Handshake() != nil {
+				return
+			}
+			conn = textproto.NewConn(tlsConn)
+			current, username, secure = Message{}, "", true
+		case "HELO", "NOOP":
+			if !reply(250, "OK") {
+				return
+			}
+		case "AUTH":
+			mechanism, credentials, _ := strings.Cut(arg, " ")
+			decoded, err := base64.StdEncoding.DecodeString(credentials)
+			fields := strings.Split(string(decoded), "\x00")
+			if !strings.EqualFold(mechanism, "PLAIN") || err != nil || len(fields) != 3 {
+				if !reply(535, "authentication failed") {
+					return
+				}
+				continue
+			}
+			username = fields[1]
+			if !reply(235, "authenticated") {
+				return
+			}
+		case "MAIL":
+			current = Message{From: address(arg), Username: username, TLS: secure}
+			if !reply(250, "OK") {
+				return
+			}
+		case "RCPT":
+			current.To = append(current.To, address(arg))
+			if !reply(250, "OK") {
+				return
+			}
+		case "DATA":
+			if current.From == "" || len(current.To) == 0 {
+				if !reply(503, "need MAIL and RCPT first") {
+					return
+				}
+				continue
+			}
+			if !reply(354, "end data with <CR><LF>.<CR><LF>") {
+				return
+			}
+			data, err := conn.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			current.Data = data
+			if !reply(s.accept(current)) {
+				return
+			}
+			current = Message{}
+		case "RSET":
+			current = Message{}
+			if !reply(250, "OK") {
+				return
+			}
+		case "QUIT":
+			reply(221, "bye")
+			return
+		default:
+			if !reply(502, "command not implemented") {
+				return
+			}
+		}
+	}
+}
+
+// accept сохраняет письмо или отклоняет его, если задан отказ через FailNext
+func (s *Server) accept(message Message) (int, string) {
+	if !s.Accept(message) {
+		return 451, "temporary failure"
+	}
+	return 250, "queued"
+}
+
+// address извлекает адрес из аргумента MAIL FROM:<...> или RCPT TO:<...>
+func address(arg string) string {
+	_, value, _ := strings.Cut(arg, ":")
+	value = strings.TrimSpace(value)
+	if end := strings.IndexByte(value, '>'); strings.HasPrefix(value, "<") && end > 0 {
+		return value[1:end]
+	}
+	return value
+}
+
+// selfSignedCertificate создает сертификат для 127.0.0.1 и localhost, действующий сутки
+func selfSignedCertificate() (tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "mailtest"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(24 * time.Hour),
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1)},
+		DNSNames:              []string{"localhost"},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}, nil
+}
@@ -0,0 +1,101 @@
+package mailtest
+
+import (
+	"crypto/tls"
+	"net/smtp"
+	"strings"
+	"testing"
+)
+
+func TestServerRecordsMessages(t *testing.T) {
+	server, err := NewServer()
+	if err != nil {
+		t.Fatalf("NewServer: %v", err)
+	}
+	defer server.Close()
+
+	// PlainAuth разрешает передачу пароля без TLS только для localhost
+	auth := smtp.PlainAuth("", "robot", "secret", "127.0.0.1")
+	body := "Subject: hello\r\n\r\nline one\r\n.leading dot\r\n"
+	if err := smtp.SendMail(server.Addr, auth, "reviews@example.com", []string{"alice@example.com"}, []byte(body)); err != nil {
+		t.Fatalf("SendMail: %v", err)
+	}
+
+	messages := server.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("expected one message, got %d", len(messages))
+	}
+	message := messages[0]
+	if message.From != "reviews@example.com" || len(message.To) != 1 || message.To[0] != "alice@example.com" {
+		t.Errorf("unexpected envelope %+v", message)
+	}
+	if message.Username != "robot" {
+		t.Errorf("expected username robot, got %q", message.Username)
+	}
+	if data := string(message.Data); !strings.Contains(data, "line one\n.leading dot") {
+		t.Errorf("unexpected data %q", data)
+	}
+}
+
+func TestServerFailNext(t *testing.T) {
+	server, err := NewServer()
+	if err != nil {
+		t.Fatalf("NewServer: %v", err)
+	}
+	defer server.Close()
+	server.FailNext(1)
+
+	send := func() error {
+		return smtp.SendMail(server.Addr, nil, "reviews@example.com", []string{"alice@example.com"}, []byte("Subject: retry\r\n\r\nbody\r\n"))
+	}
+	if err := send(); err == nil || !strings.Contains(err.Error(), "451") {
+		t.Fatalf("expected 451 error, got %v", err)
+	}
+	if err := send(); err != nil {
+		t.Fatalf("expected delivery after failure, got %v", err)
+	}
+	if len(server.Messages()) != 1 {
+		t.Fatalf("expected one recorded message, got %d", len(server.Messages()))
+	}
+}
+
+func TestTLSServerStartTLS(t *testing.T) {
+	server, err := NewTLSServer()
+	if err != nil {
+		t.Fatalf("NewTLSServer: %v", err)
+	}
+	defer server.Close()
+
+	client, err := smtp.Dial(server.Addr)
+	if err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+	defer client.Close()
+	if ok, _ := client.Extension("STARTTLS"); !ok {
+		t.Fatal("server does not offer STARTTLS")
+	}
+	if err := client.StartTLS(&tls.Config{ServerName: "127.0.0.1", RootCAs: server.CertPool()}); err != nil {
+		t.Fatalf("StartTLS: %v", err)
+	}
+	if err := client.Mail("reviews@example.com"); err != nil {
+		t.Fatalf("MAIL: %v", err)
+	}
+	if err := client.Rcpt("alice@example.com"); err != nil {
+		t.Fatalf("RCPT: %v", err)
+	}
+	writer, err := client.Data()
+	if err != nil {
+		t.Fatalf("DATA: %v", err)
+	}
+	if _, err := writer.Write([]byte("Subject: secure\r\n\r\nbody\r\n")); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("close data: %v", err)
+	}
+
+	messages := server.Messages()
+	if len(messages) != 1 || !messages[0].TLS {
+		t.Fatalf("expected one message over TLS, got %+v", messages)
+	}
+}
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Окна недоступности пользователей (отпуск, больничный и т.п.)
+CREATE TABLE IF NOT EXISTS user_availability (
+    id BIGSERIAL PRIMARY KEY,
+    user_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP NOT NULL,
+    reason VARCHAR(255) NOT NULL DEFAULT '',
+    reviews_reassigned_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (ends_at > starts_at)
+    );
+
+CREATE INDEX IF NOT EXISTS idx_user_availability_user_period ON user_availability(user_id, starts_at, ends_at);
+CREATE INDEX IF NOT EXISTS idx_user_availability_pending ON user_availability(starts_at) WHERE reviews_reassigned_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_availability;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Идентификатор события во внешнем календаре (UID из iCalendar) для повторного импорта без дублей
+ALTER TABLE user_availability ADD COLUMN IF NOT EXISTS external_id VARCHAR(255);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_availability_external ON user_availability(user_id, external_id) WHERE external_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_user_availability_external;
+ALTER TABLE user_availability DROP COLUMN IF EXISTS external_id;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Лимит одновременно открытых ревью пользователя (NULL — значение по умолчанию команды)
+ALTER TABLE users ADD COLUMN IF NOT EXISTS max_open_reviews INT CHECK (max_open_reviews > 0);
+
+-- Настройки подбора ревьюверов команды
+CREATE TABLE IF NOT EXISTS team_settings (
+    team_name VARCHAR(255) PRIMARY KEY REFERENCES teams(team_name) ON DELETE CASCADE,
+    default_max_open_reviews INT NOT NULL DEFAULT 0 CHECK (default_max_open_reviews >= 0),
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+    );
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS team_settings;
+ALTER TABLE users DROP COLUMN IF EXISTS max_open_reviews;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Вес пользователя при взвешенном случайном выборе ревьюверов (1 — обычная нагрузка)
+ALTER TABLE users ADD COLUMN IF NOT EXISTS review_weight DOUBLE PRECISION NOT NULL DEFAULT 1 CHECK (review_weight > 0);
+
+-- Режим выбора ревьюверов команды
+ALTER TABLE team_settings ADD COLUMN IF NOT EXISTS selection_mode VARCHAR(20) NOT NULL DEFAULT 'random' CHECK (selection_mode IN ('random', 'weighted'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE team_settings DROP COLUMN IF EXISTS selection_mode;
+ALTER TABLE users DROP COLUMN IF EXISTS review_weight;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Правила CODEOWNERS команды (исходный текст в синтаксисе GitHub)
+CREATE TABLE IF NOT EXISTS team_codeowners (
+    team_name VARCHAR(255) PRIMARY KEY REFERENCES teams(team_name) ON DELETE CASCADE,
+    content TEXT NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+    );
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS team_codeowners;
+-- +goose StatementEnd
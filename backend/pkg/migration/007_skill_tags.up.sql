@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Навыки пользователей (например, go, sql, frontend)
+CREATE TABLE IF NOT EXISTS user_skills (
+    user_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    tag VARCHAR(64) NOT NULL,
+    PRIMARY KEY (user_id, tag)
+    );
+
+CREATE INDEX IF NOT EXISTS idx_user_skills_tag ON user_skills(tag);
+
+-- Навыки, требуемые для ревью PR
+CREATE TABLE IF NOT EXISTS pull_request_tags (
+    pull_request_id VARCHAR(255) NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    tag VARCHAR(64) NOT NULL,
+    PRIMARY KEY (pull_request_id, tag)
+    );
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS pull_request_tags;
+DROP TABLE IF EXISTS user_skills;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Команда-партнер, из которой в каждый PR команды назначается один ревьювер
+ALTER TABLE team_settings ADD COLUMN IF NOT EXISTS required_reviewer_team VARCHAR(255) REFERENCES teams(team_name) ON DELETE SET NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE team_settings DROP COLUMN IF EXISTS required_reviewer_team;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Уровень пользователя; существующие пользователи считаются middle
+ALTER TABLE users ADD COLUMN IF NOT EXISTS level VARCHAR(20) NOT NULL DEFAULT 'middle' CHECK (level IN ('junior', 'middle', 'senior', 'lead'));
+
+-- Минимальный уровень хотя бы одного ревьювера PR команды
+ALTER TABLE team_settings ADD COLUMN IF NOT EXISTS min_reviewer_level VARCHAR(20) CHECK (min_reviewer_level IN ('junior', 'middle', 'senior', 'lead'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE team_settings DROP COLUMN IF EXISTS min_reviewer_level;
+ALTER TABLE users DROP COLUMN IF EXISTS level;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Количество последних PR автора, в которых учитываются повторные пары автор–ревьювер (0 — не учитываются)
+ALTER TABLE team_settings ADD COLUMN IF NOT EXISTS pair_history_window INTEGER NOT NULL DEFAULT 10 CHECK (pair_history_window >= 0);
+
+-- Последние PR автора выбираются по дате создания
+CREATE INDEX IF NOT EXISTS idx_pull_requests_author_created_at ON pull_requests(author_id, created_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_pull_requests_author_created_at;
+ALTER TABLE team_settings DROP COLUMN IF EXISTS pair_history_window;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Пары пользователей, которые не могут быть ревьюверами PR друг друга.
+-- Пара хранится один раз: user_id меньше excluded_user_id.
+CREATE TABLE IF NOT EXISTS reviewer_exclusions (
+    user_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    excluded_user_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    reason VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, excluded_user_id),
+    CHECK (user_id < excluded_user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_reviewer_exclusions_excluded_user_id ON reviewer_exclusions(excluded_user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS reviewer_exclusions;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Отказы ревьюверов от ревью PR; отказавшийся больше не выбирается для этого PR автоматически
+CREATE TABLE IF NOT EXISTS pull_request_declines (
+    pull_request_id VARCHAR(255) NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    user_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    reason VARCHAR(255) NOT NULL,
+    declined_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (pull_request_id, user_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS pull_request_declines;
+-- +goose StatementEnd
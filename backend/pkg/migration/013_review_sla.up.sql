@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Момент назначения ревьювера: от него отсчитывается SLA ревью
+ALTER TABLE pull_request_reviewers ADD COLUMN IF NOT EXISTS assigned_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP;
+-- Срок первого ревью в рабочих часах (0 — SLA не задан)
+ALTER TABLE team_settings ADD COLUMN IF NOT EXISTS review_sla_hours INTEGER NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE team_settings DROP COLUMN IF EXISTS review_sla_hours;
+ALTER TABLE pull_request_reviewers DROP COLUMN IF EXISTS assigned_at;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Пороги обработки зависших ревью в рабочих часах (0 — не применяется)
+ALTER TABLE team_settings ADD COLUMN IF NOT EXISTS stale_reassign_hours INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE team_settings ADD COLUMN IF NOT EXISTS stale_escalation_hours INTEGER NOT NULL DEFAULT 0;
+
+-- Эскалации зависших PR: в ревью добавлен тимлид команды автора; эскалация выполняется один раз на PR
+CREATE TABLE IF NOT EXISTS pull_request_escalations (
+    pull_request_id VARCHAR(255) PRIMARY KEY REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    user_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    escalated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS pull_request_escalations;
+ALTER TABLE team_settings DROP COLUMN IF EXISTS stale_escalation_hours;
+ALTER TABLE team_settings DROP COLUMN IF EXISTS stale_reassign_hours;
+-- +goose StatementEnd
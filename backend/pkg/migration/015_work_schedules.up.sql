@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Рабочий график пользователя: часовой пояс IANA и рабочее окно в минутах от местной полуночи (NULL — весь будний день по UTC)
+ALTER TABLE users ADD COLUMN IF NOT EXISTS timezone VARCHAR(64);
+ALTER TABLE users ADD COLUMN IF NOT EXISTS work_start_minute INTEGER CHECK (work_start_minute BETWEEN 0 AND 1440);
+ALTER TABLE users ADD COLUMN IF NOT EXISTS work_end_minute INTEGER CHECK (work_end_minute BETWEEN 0 AND 1440);
+
+-- Праздники команды: нерабочие дни участников при расчете SLA и сроков зависших ревью
+CREATE TABLE IF NOT EXISTS team_holidays (
+    team_name VARCHAR(255) NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE,
+    holiday_date DATE NOT NULL,
+    name VARCHAR(255) NOT NULL DEFAULT '',
+    PRIMARY KEY (team_name, holiday_date)
+);
+
+-- Предпочтение ревьюверов, у которых сейчас рабочее время
+ALTER TABLE team_settings ADD COLUMN IF NOT EXISTS prefer_working_hours BOOLEAN NOT NULL DEFAULT false;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE team_settings DROP COLUMN IF EXISTS prefer_working_hours;
+DROP TABLE IF EXISTS team_holidays;
+ALTER TABLE users DROP COLUMN IF EXISTS work_end_minute;
+ALTER TABLE users DROP COLUMN IF EXISTS work_start_minute;
+ALTER TABLE users DROP COLUMN IF EXISTS timezone;
+-- +goose StatementEnd
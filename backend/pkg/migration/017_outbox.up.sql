@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Outbox доменных событий: записывается в одной транзакции с изменением и публикуется фоновой задачей
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id BIGSERIAL PRIMARY KEY,
+    event_id VARCHAR(64) NOT NULL UNIQUE,
+    event_type VARCHAR(64) NOT NULL,
+    payload TEXT NOT NULL,
+    occurred_at TIMESTAMP NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    published_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_events_pending ON outbox_events(id) WHERE published_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS outbox_events;
+-- +goose StatementEnd
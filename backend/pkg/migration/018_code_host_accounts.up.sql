@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- PR, закрытые без слияния на хостинге кода
+ALTER TABLE pull_requests DROP CONSTRAINT IF EXISTS pull_requests_status_check;
+ALTER TABLE pull_requests ADD CONSTRAINT pull_requests_status_check CHECK (status IN ('OPEN', 'MERGED', 'CLOSED'));
+
+-- Логины пользователей на хостингах кода (в нижнем регистре)
+CREATE TABLE IF NOT EXISTS code_host_accounts (
+    provider VARCHAR(32) NOT NULL,
+    login VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    PRIMARY KEY (provider, login)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS code_host_accounts;
+UPDATE pull_requests SET status = 'OPEN' WHERE status = 'CLOSED';
+ALTER TABLE pull_requests DROP CONSTRAINT IF EXISTS pull_requests_status_check;
+ALTER TABLE pull_requests ADD CONSTRAINT pull_requests_status_check CHECK (status IN ('OPEN', 'MERGED'));
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Команды, по правилам которых назначаются ревьюверы PR проектов хостингов кода (имя проекта в нижнем регистре)
+CREATE TABLE IF NOT EXISTS code_host_projects (
+    provider VARCHAR(32) NOT NULL,
+    repository VARCHAR(255) NOT NULL,
+    team_name VARCHAR(255) NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE,
+    PRIMARY KEY (provider, repository)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS code_host_projects;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Очередь изменений ревьюверов, передаваемых на хостинги кода (запрос ревью или снятие запроса)
+CREATE TABLE IF NOT EXISTS code_host_reviewer_syncs (
+    id BIGSERIAL PRIMARY KEY,
+    event_id VARCHAR(64) NOT NULL,
+    pull_request_id VARCHAR(255) NOT NULL,
+    provider VARCHAR(32) NOT NULL,
+    repository VARCHAR(255) NOT NULL,
+    number INTEGER NOT NULL,
+    operation VARCHAR(16) NOT NULL CHECK (operation IN ('request', 'remove')),
+    user_id VARCHAR(255) NOT NULL,
+    login VARCHAR(255) NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'done', 'dead')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (event_id, operation, user_id)
+);
+CREATE INDEX IF NOT EXISTS idx_code_host_reviewer_syncs_status_next_attempt ON code_host_reviewer_syncs(status, next_attempt_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS code_host_reviewer_syncs;
+-- +goose StatementEnd
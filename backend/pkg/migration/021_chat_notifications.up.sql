@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Каналы команд для уведомлений ревьюверов (входящие вебхуки, совместимые со Slack)
+CREATE TABLE IF NOT EXISTS chat_channels (
+    team_name VARCHAR(255) PRIMARY KEY REFERENCES teams(team_name) ON DELETE CASCADE,
+    webhook_url TEXT NOT NULL,
+    -- Шаблоны сообщений по поводам уведомления (JSON-объект)
+    templates TEXT NOT NULL DEFAULT '{}'
+);
+
+-- Очередь сообщений в чаты
+CREATE TABLE IF NOT EXISTS chat_messages (
+    id BIGSERIAL PRIMARY KEY,
+    dedup_key VARCHAR(255) NOT NULL UNIQUE,
+    team_name VARCHAR(255) NOT NULL,
+    kind VARCHAR(32) NOT NULL,
+    url TEXT NOT NULL,
+    payload TEXT NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'sent', 'dead')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_chat_messages_status_next_attempt ON chat_messages(status, next_attempt_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS chat_messages;
+DROP TABLE IF EXISTS chat_channels;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Настройки ежедневной сводки ревью на email
+CREATE TABLE IF NOT EXISTS user_digests (
+    user_id VARCHAR(255) PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    email VARCHAR(255) NOT NULL DEFAULT '',
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    -- Местная дата последней сводки: не больше одной сводки в день
+    last_sent_on DATE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_digests;
+-- +goose StatementEnd
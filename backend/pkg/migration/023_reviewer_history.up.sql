@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- История назначений ревьюверов: строка остается после снятия или переназначения ревьювера,
+-- по ней считаются повторные пары автор–ревьювер
+CREATE TABLE IF NOT EXISTS pull_request_reviewer_history (
+    pull_request_id VARCHAR(255) NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    reviewer_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    assigned_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (pull_request_id, reviewer_id)
+);
+
+INSERT INTO pull_request_reviewer_history (pull_request_id, reviewer_id, assigned_at)
+SELECT pull_request_id, reviewer_id, assigned_at FROM pull_request_reviewers
+ON CONFLICT DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS pull_request_reviewer_history;
+-- +goose StatementEnd
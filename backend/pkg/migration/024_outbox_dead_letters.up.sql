@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Повторы публикации событий outbox с задержкой и недоставленные события: после исчерпания попыток
+-- событие отмечается failed_at и больше не задерживает публикацию следующих
+ALTER TABLE outbox_events ADD COLUMN IF NOT EXISTS next_attempt_at TIMESTAMP;
+ALTER TABLE outbox_events ADD COLUMN IF NOT EXISTS failed_at TIMESTAMP;
+
+DROP INDEX IF EXISTS idx_outbox_events_pending;
+CREATE INDEX IF NOT EXISTS idx_outbox_events_pending ON outbox_events(id) WHERE published_at IS NULL AND failed_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_outbox_events_pending;
+CREATE INDEX IF NOT EXISTS idx_outbox_events_pending ON outbox_events(id) WHERE published_at IS NULL;
+ALTER TABLE outbox_events DROP COLUMN IF EXISTS failed_at;
+ALTER TABLE outbox_events DROP COLUMN IF EXISTS next_attempt_at;
+-- +goose StatementEnd
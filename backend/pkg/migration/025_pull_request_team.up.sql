@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Команда, по правилам которой выбраны ревьюверы PR (команда проекта хостинга или команда автора);
+-- по ней же подбираются замены, действуют SLA и эскалация. NULL — команда удалена, действуют правила команды автора
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS team_name VARCHAR(255) REFERENCES teams(team_name) ON DELETE SET NULL;
+
+UPDATE pull_requests pr SET team_name = u.team_name
+FROM users u
+WHERE u.user_id = pr.author_id AND pr.team_name IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS team_name;
+-- +goose StatementEnd
@@ -0,0 +1,87 @@
+// Package netguard защищает исходящие запросы по адресам, которые задают пользователи API (вебхуки,
+// каналы чатов), от обращений во внутреннюю сеть: к loopback, частным и служебным адресам.
+// Адрес проверяется дважды: при сохранении (CheckURL) и при подключении (NewTransport), поэтому
+// имя, которое позже начнет указывать на внутренний адрес, тоже отклоняется.
+package netguard
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// ErrPrivateAddress адрес указывает во внутреннюю сеть
+var ErrPrivateAddress = errors.New("address is not public")
+
+// reservedPrefixes служебные диапазоны, которые netip не относит к частным или loopback
+var reservedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+}
+
+// IsPublic проверяет, что addr — внешний unicast-адрес
+func IsPublic(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() || !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, prefix := range reservedPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckURL отклоняет URL, хост которого — внутренний IP-адрес или localhost.
+// Доменные имена не разрешаются: их адреса проверяются при подключении.
+func CheckURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	host := strings.TrimSuffix(strings.ToLower(parsed.Hostname()), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("%w: %s", ErrPrivateAddress, host)
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && !IsPublic(addr) {
+		return fmt.Errorf("%w: %s", ErrPrivateAddress, host)
+	}
+	return nil
+}
+
+// Control отклоняет подключение к внутреннему адресу; подходит для net.Dialer.Control
+func Control(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil || !IsPublic(addr) {
+		return fmt.Errorf("%w: %s", ErrPrivateAddress, host)
+	}
+	return nil
+}
+
+// NewTransport возвращает транспорт HTTP, который подключается только к внешним адресам, в том числе
+// при перенаправлениях. Прокси из окружения не используется: его адрес обычно внутренний.
+func NewTransport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = (&net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   Control,
+	}).DialContext
+	return transport
+}
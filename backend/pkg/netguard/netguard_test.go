@@ -0,0 +1,73 @@
+package netguard
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+)
+
+func TestIsPublic(t *testing.T) {
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:4700::1111", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"fd00::1", false},
+		{"0.0.0.0", false},
+		{"::", false},
+		{"100.64.0.1", false},
+		{"224.0.0.1", false},
+		{"255.255.255.255", false},
+		{"::ffff:127.0.0.1", false},
+		{"::ffff:8.8.8.8", true},
+	}
+	for _, tt := range tests {
+		if got := IsPublic(netip.MustParseAddr(tt.addr)); got != tt.want {
+			t.Errorf("IsPublic(%s) = %v, want %v", tt.addr, got, tt.want)
+		}
+	}
+}
+
+func TestCheckURL(t *testing.T) {
+	tests := []struct {
+		url     string
+		private bool
+	}{
+		{url: "https://hooks.example.com/services/T000/B000"},
+		{url: "http://93.184.216.34:8080/hook"},
+		{url: "http://localhost:8080/hook", private: true},
+		{url: "http://LOCALHOST./hook", private: true},
+		{url: "http://api.localhost/hook", private: true},
+		{url: "http://127.0.0.1/hook", private: true},
+		{url: "http://[::1]:9000/hook", private: true},
+		{url: "http://169.254.169.254/latest/meta-data", private: true},
+		{url: "http://10.0.0.5/hook", private: true},
+	}
+	for _, tt := range tests {
+		err := CheckURL(tt.url)
+		if tt.private != errors.Is(err, ErrPrivateAddress) {
+			t.Errorf("CheckURL(%s) = %v, want private = %v", tt.url, err, tt.private)
+		}
+	}
+}
+
+func TestTransportRefusesPrivateAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewTransport()}
+	_, err := client.Get(server.URL)
+	if !errors.Is(err, ErrPrivateAddress) {
+		t.Fatalf("request to %s: %v, want ErrPrivateAddress", server.URL, err)
+	}
+}
@@ -0,0 +1,46 @@
+// Package random предоставляет потокобезопасный источник псевдослучайных чисел
+// с задаваемым начальным значением: при одинаковом seed последовательность повторяется.
+package random
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Source источник псевдослучайных чисел, безопасный для использования из нескольких горутин
+type Source struct {
+	mu  sync.Mutex
+	rng *rand.Rand
+}
+
+// New создает источник с начальным значением seed
+func New(seed int64) *Source {
+	return &Source{rng: rand.New(rand.NewSource(seed))}
+}
+
+// NewFromTime создает источник с начальным значением из текущего времени
+func NewFromTime() *Source {
+	return New(time.Now().UnixNano())
+}
+
+// Float64 возвращает число из [0.0, 1.0)
+func (s *Source) Float64() float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.rng.Float64()
+}
+
+// Intn возвращает число из [0, n)
+func (s *Source) Intn(n int) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.rng.Intn(n)
+}
+
+// Shuffle перемешивает n элементов с помощью swap
+func (s *Source) Shuffle(n int, swap func(i, j int)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rng.Shuffle(n, swap)
+}
@@ -0,0 +1,48 @@
+package random
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSameSeedRepeatsSequence(t *testing.T) {
+	first, second := New(42), New(42)
+	for i := 0; i < 100; i++ {
+		if a, b := first.Float64(), second.Float64(); a != b {
+			t.Fatalf("step %d: %v != %v", i, a, b)
+		}
+	}
+
+	shuffled := func(source *Source) []int {
+		items := []int{1, 2, 3, 4, 5, 6, 7, 8}
+		source.Shuffle(len(items), func(i, j int) {
+			items[i], items[j] = items[j], items[i]
+		})
+		return items
+	}
+	a, b := shuffled(New(7)), shuffled(New(7))
+	for i := range a {
+		if a[i] != b[i] {
+			t.Fatalf("shuffles differ: %v vs %v", a, b)
+		}
+	}
+}
+
+func TestConcurrentUse(t *testing.T) {
+	source := New(1)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				if n := source.Intn(10); n < 0 || n >= 10 {
+					t.Errorf("Intn out of range: %d", n)
+					return
+				}
+				source.Float64()
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,27 @@
+// Package slack формирует сообщения для входящих вебхуков Slack
+// (и совместимых с ними чатов: Mattermost, Rocket.Chat).
+package slack
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Message тело запроса входящего вебхука
+type Message struct {
+	// Text текст сообщения в разметке mrkdwn
+	Text string `json:"text"`
+}
+
+// Encode возвращает JSON-тело сообщения
+func (m Message) Encode() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+// escaper заменяет символы, которые Slack трактует как разметку ссылок и упоминаний
+var escaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// Escape экранирует управляющие символы разметки Slack (&, <, >) в подставляемых значениях
+func Escape(text string) string {
+	return escaper.Replace(text)
+}
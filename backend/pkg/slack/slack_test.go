@@ -0,0 +1,19 @@
+package slack
+
+import "testing"
+
+func TestEncode(t *testing.T) {
+	body, err := Message{Text: "Review *PR-1*"}.Encode()
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	if string(body) != `{"text":"Review *PR-1*"}` {
+		t.Errorf("unexpected body %s", body)
+	}
+}
+
+func TestEscape(t *testing.T) {
+	if got := Escape("a<b> & <!channel>"); got != "a&lt;b&gt; &amp; &lt;!channel&gt;" {
+		t.Errorf("unexpected escaped text %q", got)
+	}
+}
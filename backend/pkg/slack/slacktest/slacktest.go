@@ -0,0 +1,55 @@
+// Package slacktest содержит локальную замену входящего вебхука Slack для тестов:
+// сервер принимает сообщения так же, как Slack, и сохраняет их для проверки.
+package slacktest
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"test_task_avito/backend/pkg/slack"
+	"test_task_avito/backend/pkg/testserver"
+)
+
+// Server локальный входящий вебхук. FailNext заставляет сервер ответить ошибкой 500 на следующие n сообщений,
+// Messages возвращает принятые сообщения.
+type Server struct {
+	*httptest.Server
+	testserver.Recorder[slack.Message]
+}
+
+// NewServer запускает сервер; адрес вебхука — Server.URL. Сервер нужно остановить через Close.
+func NewServer() *Server {
+	s := &Server{}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// handle отвечает как Slack: 200 "ok" на корректное сообщение, 400 с кодом ошибки на некорректное
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "invalid_method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "invalid_payload", http.StatusBadRequest)
+		return
+	}
+	var message slack.Message
+	if err := json.Unmarshal(body, &message); err != nil {
+		http.Error(w, "invalid_payload", http.StatusBadRequest)
+		return
+	}
+	if message.Text == "" {
+		http.Error(w, "no_text", http.StatusBadRequest)
+		return
+	}
+
+	if !s.Accept(message) {
+		http.Error(w, "internal_error", http.StatusInternalServerError)
+		return
+	}
+	_, _ = w.Write([]byte("ok"))
+}
@@ -0,0 +1,53 @@
+package slacktest
+
+import (
+	"bytes"
+	"net/http"
+	"test_task_avito/backend/pkg/slack"
+	"testing"
+)
+
+func post(t *testing.T, url string, body []byte) int {
+	t.Helper()
+	resp, err := http.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("post: %v", err)
+	}
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+func TestServerRecordsMessages(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	body, _ := slack.Message{Text: "hello"}.Encode()
+	if status := post(t, server.URL, body); status != http.StatusOK {
+		t.Fatalf("expected 200, got %d", status)
+	}
+	if status := post(t, server.URL, []byte(`{"text":""}`)); status != http.StatusBadRequest {
+		t.Fatalf("expected 400 for empty text, got %d", status)
+	}
+
+	messages := server.Messages()
+	if len(messages) != 1 || messages[0].Text != "hello" {
+		t.Fatalf("unexpected messages %+v", messages)
+	}
+}
+
+func TestServerFailNext(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.FailNext(1)
+
+	body, _ := slack.Message{Text: "retry"}.Encode()
+	if status := post(t, server.URL, body); status != http.StatusInternalServerError {
+		t.Fatalf("expected 500, got %d", status)
+	}
+	if status := post(t, server.URL, body); status != http.StatusOK {
+		t.Fatalf("expected 200 after failure, got %d", status)
+	}
+	if len(server.Messages()) != 1 {
+		t.Fatalf("expected one recorded message, got %d", len(server.Messages()))
+	}
+}
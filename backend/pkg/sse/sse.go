@@ -0,0 +1,81 @@
+// Package sse записывает поток Server-Sent Events (text/event-stream) в HTTP-ответ.
+package sse
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LastEventIDHeader заголовок, в котором клиент при переподключении передает ID последнего полученного события
+const LastEventIDHeader = "Last-Event-ID"
+
+// ErrStreamingUnsupported ответ не поддерживает отправку частями
+var ErrStreamingUnsupported = errors.New("sse: streaming is not supported")
+
+// Writer пишет события в ответ и сразу отправляет их клиенту
+type Writer struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+}
+
+// NewWriter устанавливает заголовки потока и отправляет их клиенту
+func NewWriter(w http.ResponseWriter) (*Writer, error) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return nil, ErrStreamingUnsupported
+	}
+
+	header := w.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	// Отключает буферизацию ответа в nginx
+	header.Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	return &Writer{w: w, flusher: flusher}, nil
+}
+
+// Event отправляет событие; пустые id и name не передаются, многострочные данные разбиваются на строки data
+func (s *Writer) Event(id, name string, data []byte) error {
+	if strings.ContainsAny(id, "\r\n\x00") || strings.ContainsAny(name, "\r\n") {
+		return fmt.Errorf("sse: id and event name must be single-line")
+	}
+
+	var b strings.Builder
+	if id != "" {
+		b.WriteString("id: " + id + "\n")
+	}
+	if name != "" {
+		b.WriteString("event: " + name + "\n")
+	}
+	lines := strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n")
+	for _, line := range lines {
+		b.WriteString("data: " + line + "\n")
+	}
+	b.WriteString("\n")
+	return s.write(b.String())
+}
+
+// Comment отправляет комментарий; клиенты его игнорируют, а прокси не закрывают соединение по простою
+func (s *Writer) Comment(text string) error {
+	return s.write(": " + strings.ReplaceAll(text, "\n", " ") + "\n\n")
+}
+
+// Retry сообщает клиенту задержку перед переподключением
+func (s *Writer) Retry(delay time.Duration) error {
+	return s.write("retry: " + strconv.FormatInt(delay.Milliseconds(), 10) + "\n\n")
+}
+
+func (s *Writer) write(chunk string) error {
+	if _, err := s.w.Write([]byte(chunk)); err != nil {
+		return err
+	}
+	s.flusher.Flush()
+	return nil
+}
@@ -0,0 +1,62 @@
+package sse
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWriterFormatsEvents(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	writer, err := NewWriter(recorder)
+	if err != nil {
+		t.Fatalf("NewWriter: %v", err)
+	}
+
+	if err := writer.Retry(3 * time.Second); err != nil {
+		t.Fatalf("Retry: %v", err)
+	}
+	if err := writer.Event("42", "reviewer.assigned", []byte(`{"a":1}`)); err != nil {
+		t.Fatalf("Event: %v", err)
+	}
+	if err := writer.Event("", "", []byte("line one\nline two")); err != nil {
+		t.Fatalf("Event: %v", err)
+	}
+	if err := writer.Comment("keepalive"); err != nil {
+		t.Fatalf("Comment: %v", err)
+	}
+
+	expected := "retry: 3000\n\n" +
+		"id: 42\nevent: reviewer.assigned\ndata: {\"a\":1}\n\n" +
+		"data: line one\ndata: line two\n\n" +
+		": keepalive\n\n"
+	if body := recorder.Body.String(); body != expected {
+		t.Errorf("unexpected stream:\n%q\nexpected:\n%q", body, expected)
+	}
+	if contentType := recorder.Header().Get("Content-Type"); contentType != "text/event-stream" {
+		t.Errorf("unexpected content type %q", contentType)
+	}
+	if !recorder.Flushed {
+		t.Error("expected response to be flushed")
+	}
+}
+
+func TestWriterRejectsMultilineID(t *testing.T) {
+	writer, err := NewWriter(httptest.NewRecorder())
+	if err != nil {
+		t.Fatalf("NewWriter: %v", err)
+	}
+	if err := writer.Event("1\nid: 2", "", nil); err == nil {
+		t.Fatal("expected error for multi-line id")
+	}
+}
+
+// plainWriter ответ без поддержки отправки частями
+type plainWriter struct{ http.ResponseWriter }
+
+func TestNewWriterRequiresFlusher(t *testing.T) {
+	if _, err := NewWriter(plainWriter{httptest.NewRecorder()}); err != ErrStreamingUnsupported {
+		t.Fatalf("expected ErrStreamingUnsupported, got %v", err)
+	}
+}
@@ -0,0 +1,39 @@
+// Package testserver содержит общую основу локальных серверов для тестов (slacktest, mailtest):
+// журнал принятых сообщений с отказами по запросу теста и TCP-сервер, обрывающий сессии при остановке.
+package testserver
+
+import "sync"
+
+// Recorder журнал сообщений, принятых тестовым сервером; безопасен для одновременного использования.
+// Нулевое значение готово к работе.
+type Recorder[T any] struct {
+	mu       sync.Mutex
+	messages []T
+	failures int
+}
+
+// FailNext заставляет сервер отклонить следующие n сообщений
+func (r *Recorder[T]) FailNext(n int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.failures = n
+}
+
+// Messages возвращает принятые сообщения в порядке получения
+func (r *Recorder[T]) Messages() []T {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]T(nil), r.messages...)
+}
+
+// Accept сохраняет сообщение; false — сообщение нужно отклонить из-за отказа, заданного FailNext
+func (r *Recorder[T]) Accept(message T) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.failures > 0 {
+		r.failures--
+		return false
+	}
+	r.messages = append(r.messages, message)
+	return true
+}
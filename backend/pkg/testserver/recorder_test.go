@@ -0,0 +1,34 @@
+package testserver
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRecorderFailNext(t *testing.T) {
+	var recorder Recorder[string]
+	recorder.FailNext(2)
+
+	var accepted []bool
+	for _, message := range []string{"a", "b", "c", "d"} {
+		accepted = append(accepted, recorder.Accept(message))
+	}
+
+	if want := []bool{false, false, true, true}; !slices.Equal(accepted, want) {
+		t.Fatalf("accepted %v, want %v", accepted, want)
+	}
+	if messages := recorder.Messages(); !slices.Equal(messages, []string{"c", "d"}) {
+		t.Fatalf("messages %v, want [c d]", messages)
+	}
+}
+
+func TestRecorderMessagesIsCopy(t *testing.T) {
+	var recorder Recorder[string]
+	recorder.Accept("a")
+
+	messages := recorder.Messages()
+	messages[0] = "changed"
+	if got := recorder.Messages()[0]; got != "a" {
+		t.Fatalf("recorded message changed through the returned slice: %q", got)
+	}
+}
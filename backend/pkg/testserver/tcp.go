@@ -0,0 +1,72 @@
+package testserver
+
+import (
+	"net"
+	"sync"
+)
+
+// TCPServer принимает соединения на свободном порту 127.0.0.1 и обслуживает каждое в отдельной горутине
+type TCPServer struct {
+	// Addr адрес сервера вида 127.0.0.1:port
+	Addr string
+
+	listener net.Listener
+	session  func(net.Conn)
+	wg       sync.WaitGroup
+
+	mu    sync.Mutex
+	conns map[net.Conn]struct{}
+}
+
+// ServeTCP запускает сервер, который передает каждое соединение в session; соединение закрывается после
+// возврата из session. Сервер нужно остановить через Close.
+func ServeTCP(session func(net.Conn)) (*TCPServer, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &TCPServer{
+		Addr:     listener.Addr().String(),
+		listener: listener,
+		session:  session,
+		conns:    make(map[net.Conn]struct{}),
+	}
+	s.wg.Add(1)
+	go s.serve()
+	return s, nil
+}
+
+// Close останавливает сервер, обрывает открытые соединения и ждет завершения сессий
+func (s *TCPServer) Close() {
+	_ = s.listener.Close()
+	s.mu.Lock()
+	for conn := range s.conns {
+		_ = conn.Close()
+	}
+	s.mu.Unlock()
+	s.wg.Wait()
+}
+
+func (s *TCPServer) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		s.conns[conn] = struct{}{}
+		s.mu.Unlock()
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer conn.Close()
+			s.session(conn)
+			s.mu.Lock()
+			delete(s.conns, conn)
+			s.mu.Unlock()
+		}()
+	}
+}
@@ -0,0 +1,66 @@
+package testserver
+
+import (
+	"bufio"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestTCPServerServesSessions(t *testing.T) {
+	server, err := ServeTCP(func(conn net.Conn) {
+		line, err := bufio.NewReader(conn).ReadString('\n')
+		if err == nil {
+			_, _ = conn.Write([]byte("echo " + line))
+		}
+	})
+	if err != nil {
+		t.Fatalf("ServeTCP: %v", err)
+	}
+	defer server.Close()
+
+	conn, err := net.Dial("tcp", server.Addr)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close()
+	if _, err := conn.Write([]byte("hello\n")); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	reply, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil || reply != "echo hello\n" {
+		t.Fatalf("reply %q, err %v", reply, err)
+	}
+}
+
+func TestTCPServerCloseDisconnectsClients(t *testing.T) {
+	server, err := ServeTCP(func(conn net.Conn) {
+		// Сессия ждет клиента, пока соединение не оборвет Close
+		_, _ = bufio.NewReader(conn).ReadString('\n')
+	})
+	if err != nil {
+		t.Fatalf("ServeTCP: %v", err)
+	}
+
+	conn, err := net.Dial("tcp", server.Addr)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close()
+
+	closed := make(chan struct{})
+	go func() {
+		server.Close()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Close did not finish open sessions")
+	}
+
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if _, err := conn.Read(make([]byte, 1)); err == nil {
+		t.Fatal("connection is still open after Close")
+	}
+}
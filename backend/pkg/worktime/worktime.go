@@ -0,0 +1,89 @@
+// Package worktime считает сроки в рабочем времени: рабочими считаются будние дни (понедельник–пятница)
+// в пределах рабочего окна графика, праздничные дни в отсчет не входят.
+package worktime
+
+import "time"
+
+// DateLayout формат дат праздников
+const DateLayout = "2006-01-02"
+
+// MinutesPerDay конец суток в минутах от полуночи
+const MinutesPerDay = 24 * 60
+
+// Schedule рабочий график
+type Schedule struct {
+	// Location часовой пояс графика (nil — UTC)
+	Location *time.Location
+	// StartMinute и EndMinute начало и конец рабочего окна в минутах от местной полуночи (0–1440).
+	// Пустое или некорректное окно считается круглосуточным
+	StartMinute int
+	EndMinute   int
+	// Holidays нерабочие даты местного календаря в формате DateLayout
+	Holidays map[string]bool
+}
+
+// FullDay график, в котором рабочими считаются все часы будних дней по UTC
+var FullDay = Schedule{Location: time.UTC, StartMinute: 0, EndMinute: MinutesPerDay}
+
+// IsWorkday проверяет, что день t по местному времени графика рабочий
+func (s Schedule) IsWorkday(t time.Time) bool {
+	local := t.In(s.location())
+	wd := local.Weekday()
+	if wd == time.Saturday || wd == time.Sunday {
+		return false
+	}
+	return !s.Holidays[local.Format(DateLayout)]
+}
+
+// IsWorkingTime проверяет, что момент t приходится на рабочее окно рабочего дня
+func (s Schedule) IsWorkingTime(t time.Time) bool {
+	if !s.IsWorkday(t) {
+		return false
+	}
+	windowStart, windowEnd := s.window(t.In(s.location()))
+	return !t.Before(windowStart) && t.Before(windowEnd)
+}
+
+// AddWorkingHours возвращает момент, в который от start пройдет hours рабочих часов графика.
+// Старт вне рабочего времени отсчитывается с начала ближайшего рабочего окна.
+// Результат возвращается в часовом поясе start.
+func (s Schedule) AddWorkingHours(start time.Time, hours int) time.Time {
+	loc := s.location()
+	t := start.In(loc)
+	remaining := time.Duration(hours) * time.Hour
+	for {
+		if s.IsWorkday(t) {
+			windowStart, windowEnd := s.window(t)
+			if t.Before(windowStart) {
+				t = windowStart
+			}
+			if t.Before(windowEnd) {
+				left := windowEnd.Sub(t)
+				if remaining <= left {
+					return t.Add(remaining).In(start.Location())
+				}
+				remaining -= left
+			}
+		}
+		y, m, d := t.Date()
+		t = time.Date(y, m, d+1, 0, 0, 0, 0, loc)
+	}
+}
+
+// window возвращает рабочее окно дня, к которому относится local (время в часовом поясе графика)
+func (s Schedule) window(local time.Time) (time.Time, time.Time) {
+	startMinute, endMinute := s.StartMinute, s.EndMinute
+	if startMinute < 0 || endMinute > MinutesPerDay || startMinute >= endMinute {
+		startMinute, endMinute = 0, MinutesPerDay
+	}
+	y, m, d := local.Date()
+	return time.Date(y, m, d, 0, startMinute, 0, 0, local.Location()),
+		time.Date(y, m, d, 0, endMinute, 0, 0, local.Location())
+}
+
+func (s Schedule) location() *time.Location {
+	if s.Location == nil {
+		return time.UTC
+	}
+	return s.Location
+}
@@ -0,0 +1,84 @@
+package worktime
+
+import (
+	"testing"
+	"time"
+)
+
+func date(day, hour int) time.Time {
+	// 2024-01-01 — понедельник
+	return time.Date(2024, time.January, day, hour, 0, 0, 0, time.UTC)
+}
+
+func TestScheduleAddWorkingHours(t *testing.T) {
+	moscow := time.FixedZone("MSK", 3*60*60)
+	office := Schedule{Location: moscow, StartMinute: 9 * 60, EndMinute: 18 * 60}
+	withHoliday := office
+	withHoliday.Holidays = map[string]bool{"2024-01-02": true}
+
+	tests := []struct {
+		name     string
+		schedule Schedule
+		start    time.Time
+		hours    int
+		want     time.Time
+	}{
+		// 10:00 MSK понедельника + 4 ч = 14:00 MSK
+		{"within window", office, date(1, 7), 4, date(1, 11)},
+		// 10:00 MSK + 8 ч: 8 ч до 18:00, остаток 0 — конец рабочего дня
+		{"until end of window", office, date(1, 7), 8, date(1, 15)},
+		// 10:00 MSK + 9 ч: 8 ч в понедельник, 1 ч со вторника 09:00 MSK
+		{"carries to next morning", office, date(1, 7), 9, date(2, 7)},
+		// старт в 20:00 MSK считается с 09:00 MSK следующего дня
+		{"start after hours", office, date(1, 17), 1, date(2, 7)},
+		// вторник — праздник, отсчет продолжается в среду
+		{"skips holiday", withHoliday, date(1, 7), 9, date(3, 7)},
+		// пятница 17:00 MSK + 2 ч: 1 ч в пятницу, 1 ч в понедельник
+		{"skips weekend", office, date(5, 14), 2, date(8, 7)},
+		{"full day within same day", FullDay, date(1, 10), 5, date(1, 15)},
+		{"full day across weekday midnight", FullDay, date(1, 10), 24, date(2, 10)},
+		{"full day friday skips weekend", FullDay, date(5, 10), 24, date(8, 10)},
+		{"full day saturday starts on monday", FullDay, date(6, 15), 24, date(9, 0)},
+		{"full day zero hours on weekday", FullDay, date(3, 12), 0, date(3, 12)},
+		{"full day zero hours on sunday", FullDay, date(7, 12), 0, date(8, 0)},
+		{"full day week ends on friday midnight", FullDay, date(1, 0), 120, date(6, 0)},
+		{"invalid window is round the clock", Schedule{StartMinute: 600, EndMinute: 600}, date(1, 10), 24, date(2, 10)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.schedule.AddWorkingHours(tt.start, tt.hours); !got.Equal(tt.want) {
+				t.Errorf("AddWorkingHours(%v, %d) = %v, want %v", tt.start, tt.hours, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScheduleIsWorkingTime(t *testing.T) {
+	newYork := time.FixedZone("EST", -5*60*60)
+	office := Schedule{Location: newYork, StartMinute: 9 * 60, EndMinute: 17 * 60, Holidays: map[string]bool{"2024-01-03": true}}
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want bool
+	}{
+		{"morning in new york", date(1, 15), true},
+		{"before start in new york", date(1, 13), false},
+		{"end of window is exclusive", date(1, 22), false},
+		// 23:00 UTC понедельника — 18:00 EST, рабочий день уже закончился
+		{"evening utc", date(1, 23), false},
+		{"holiday", date(3, 15), false},
+		// 02:00 UTC субботы — 21:00 EST пятницы, после окончания окна
+		{"friday night local", date(6, 2), false},
+		{"saturday", date(6, 15), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := office.IsWorkingTime(tt.at); got != tt.want {
+				t.Errorf("IsWorkingTime(%v) = %v, want %v", tt.at, got, tt.want)
+			}
+		})
+	}
+}